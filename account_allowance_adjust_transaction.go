@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -327,9 +328,38 @@ func (transaction *AccountAllowanceAdjustTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *AccountAllowanceAdjustTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *AccountAllowanceAdjustTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *AccountAllowanceAdjustTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *AccountAllowanceAdjustTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Deprecated
 func (transaction *AccountAllowanceAdjustTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *AccountAllowanceAdjustTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -356,6 +386,7 @@ func (transaction *AccountAllowanceAdjustTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -414,6 +445,11 @@ func (transaction *AccountAllowanceAdjustTransaction) FreezeWith(client *Client)
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *AccountAllowanceAdjustTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *AccountAllowanceAdjustTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -515,6 +551,12 @@ func (transaction *AccountAllowanceAdjustTransaction) AddSignature(publicKey Pub
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *AccountAllowanceAdjustTransaction) SetRetryPolicy(policy RetryPolicy) *AccountAllowanceAdjustTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *AccountAllowanceAdjustTransaction) SetMaxBackoff(max time.Duration) *AccountAllowanceAdjustTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
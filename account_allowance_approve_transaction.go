@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -410,9 +411,38 @@ func (transaction *AccountAllowanceApproveTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *AccountAllowanceApproveTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *AccountAllowanceApproveTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *AccountAllowanceApproveTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *AccountAllowanceApproveTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *AccountAllowanceApproveTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *AccountAllowanceApproveTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -439,6 +469,7 @@ func (transaction *AccountAllowanceApproveTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -495,6 +526,11 @@ func (transaction *AccountAllowanceApproveTransaction) FreezeWith(client *Client
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *AccountAllowanceApproveTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *AccountAllowanceApproveTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -596,6 +632,12 @@ func (transaction *AccountAllowanceApproveTransaction) AddSignature(publicKey Pu
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *AccountAllowanceApproveTransaction) SetRetryPolicy(policy RetryPolicy) *AccountAllowanceApproveTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *AccountAllowanceApproveTransaction) SetMaxBackoff(max time.Duration) *AccountAllowanceApproveTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -1,6 +1,7 @@
 package hedera
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -247,9 +248,38 @@ func (transaction *AccountAllowanceDeleteTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *AccountAllowanceDeleteTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *AccountAllowanceDeleteTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *AccountAllowanceDeleteTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *AccountAllowanceDeleteTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *AccountAllowanceDeleteTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *AccountAllowanceDeleteTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -276,6 +306,7 @@ func (transaction *AccountAllowanceDeleteTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -332,6 +363,11 @@ func (transaction *AccountAllowanceDeleteTransaction) FreezeWith(client *Client)
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *AccountAllowanceDeleteTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *AccountAllowanceDeleteTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -433,6 +469,12 @@ func (transaction *AccountAllowanceDeleteTransaction) AddSignature(publicKey Pub
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *AccountAllowanceDeleteTransaction) SetRetryPolicy(policy RetryPolicy) *AccountAllowanceDeleteTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *AccountAllowanceDeleteTransaction) SetMaxBackoff(max time.Duration) *AccountAllowanceDeleteTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -126,7 +127,14 @@ func (query *AccountBalanceQuery) _Build() *services.Query_CryptogetAccountBalan
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *AccountBalanceQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -147,6 +155,7 @@ func (query *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
 		Query: pb,
 	}
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountBalanceQueryShouldRetry,
@@ -171,8 +180,8 @@ func (query *AccountBalanceQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _AccountBalanceQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptogetAccountBalance().Header.NodeTransactionPrecheckCode))
+func _AccountBalanceQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetCryptogetAccountBalance().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountBalanceQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -187,7 +196,14 @@ func _AccountBalanceQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *AccountBalanceQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountBalance, error) {
 	if client == nil {
 		return AccountBalance{}, errNoClientProvided
 	}
@@ -210,6 +226,7 @@ func (query *AccountBalanceQuery) Execute(client *Client) (AccountBalance, error
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountBalanceQueryShouldRetry,
@@ -256,6 +273,12 @@ func (query *AccountBalanceQuery) SetMaxRetry(count int) *AccountBalanceQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *AccountBalanceQuery) SetRetryPolicy(policy RetryPolicy) *AccountBalanceQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *AccountBalanceQuery) SetMaxBackoff(max time.Duration) *AccountBalanceQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -318,9 +319,38 @@ func (transaction *AccountCreateTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *AccountCreateTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *AccountCreateTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *AccountCreateTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *AccountCreateTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *AccountCreateTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *AccountCreateTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -351,6 +381,7 @@ func (transaction *AccountCreateTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -407,6 +438,11 @@ func (transaction *AccountCreateTransaction) FreezeWith(client *Client) (*Accoun
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *AccountCreateTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *AccountCreateTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -508,6 +544,12 @@ func (transaction *AccountCreateTransaction) AddSignature(publicKey PublicKey, s
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *AccountCreateTransaction) SetRetryPolicy(policy RetryPolicy) *AccountCreateTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *AccountCreateTransaction) SetMaxBackoff(max time.Duration) *AccountCreateTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
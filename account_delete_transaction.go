@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -218,9 +219,38 @@ func (transaction *AccountDeleteTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *AccountDeleteTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *AccountDeleteTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *AccountDeleteTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *AccountDeleteTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *AccountDeleteTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *AccountDeleteTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -247,6 +277,7 @@ func (transaction *AccountDeleteTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -304,6 +335,11 @@ func (transaction *AccountDeleteTransaction) FreezeWith(client *Client) (*Accoun
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *AccountDeleteTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *AccountDeleteTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -405,6 +441,12 @@ func (transaction *AccountDeleteTransaction) AddSignature(publicKey PublicKey, s
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *AccountDeleteTransaction) SetRetryPolicy(policy RetryPolicy) *AccountDeleteTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *AccountDeleteTransaction) SetMaxBackoff(max time.Duration) *AccountDeleteTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
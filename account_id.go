@@ -21,6 +21,8 @@ package hedera
  */
 
 import (
+	"bytes"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -30,13 +32,16 @@ import (
 	protobuf "google.golang.org/protobuf/proto"
 )
 
-// AccountID is the ID for a Hedera account
+// AccountID is the ID for a Hedera account. An account can also be referred to by an alias instead of its number:
+// either AliasKey, the public key the account was created with, or AliasEvmAddress, the 20 byte EVM address of its
+// ECDSA(secp256k1) key.
 type AccountID struct {
-	Shard    uint64
-	Realm    uint64
-	Account  uint64
-	AliasKey *PublicKey
-	checksum *string
+	Shard           uint64
+	Realm           uint64
+	Account         uint64
+	AliasKey        *PublicKey
+	AliasEvmAddress *[]byte
+	checksum        *string
 }
 
 type _AccountIDs struct { //nolint
@@ -44,20 +49,22 @@ type _AccountIDs struct { //nolint
 }
 
 // AccountIDFromString constructs an AccountID from a string formatted as
-// `Shard.Realm.Account` (for example "0.0.3")
+// `Shard.Realm.Account` (for example "0.0.3"). The account can also be an alias key or a hex encoded EVM address,
+// and a bare EVM address such as "0x7d9d4b4e1d3f...", which is in shard 0 and realm 0, is accepted as well.
 func AccountIDFromString(data string) (AccountID, error) {
-	shard, realm, num, checksum, alias, err := _AccountIDFromString(data)
+	shard, realm, num, checksum, alias, evmAddress, err := _AccountIDFromString(data)
 	if err != nil {
 		return AccountID{}, err
 	}
 
 	if num == -1 {
 		return AccountID{
-			Shard:    uint64(shard),
-			Realm:    uint64(realm),
-			Account:  0,
-			AliasKey: alias,
-			checksum: checksum,
+			Shard:           uint64(shard),
+			Realm:           uint64(realm),
+			Account:         0,
+			AliasKey:        alias,
+			AliasEvmAddress: evmAddress,
+			checksum:        checksum,
 		}, nil
 	}
 
@@ -70,6 +77,21 @@ func AccountIDFromString(data string) (AccountID, error) {
 	}, nil
 }
 
+// AccountIDFromEvmAddress constructs an AccountID referring to an account by the EVM address of its
+// ECDSA(secp256k1) key, hex encoded with or without the 0x prefix.
+func AccountIDFromEvmAddress(shard uint64, realm uint64, evmAddress string) (AccountID, error) {
+	address, err := _EvmAddressFromString(evmAddress)
+	if err != nil {
+		return AccountID{}, err
+	}
+
+	return AccountID{
+		Shard:           shard,
+		Realm:           realm,
+		AliasEvmAddress: &address,
+	}, nil
+}
+
 // AccountIDFromSolidityAddress constructs an AccountID from a string
 // representation of a _Solidity address
 func AccountIDFromSolidityAddress(s string) (AccountID, error) {
@@ -90,6 +112,9 @@ func (id *AccountID) ValidateChecksum(client *Client) error {
 	if id.AliasKey != nil {
 		return errors.New("Account ID contains alias key, unable to validate")
 	}
+	if id.AliasEvmAddress != nil {
+		return errors.New("Account ID contains EVM address, unable to validate")
+	}
 	if !id._IsZero() && client != nil && client.network.ledgerID != nil {
 		var tempChecksum _ParseAddressResult
 		var err error
@@ -121,6 +146,9 @@ func (id *AccountID) Validate(client *Client) error {
 	if id.AliasKey != nil {
 		return errors.New("Account ID contains alias key, unable to validate")
 	}
+	if id.AliasEvmAddress != nil {
+		return errors.New("Account ID contains EVM address, unable to validate")
+	}
 	if !id._IsZero() && client != nil && client.network.ledgerID == nil {
 		tempChecksum, err := _ChecksumParseAddress(client.GetLedgerID(), fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Account))
 		if err != nil {
@@ -145,6 +173,9 @@ func (id AccountID) String() string {
 	if id.AliasKey != nil {
 		return fmt.Sprintf("%d.%d.%s", id.Shard, id.Realm, id.AliasKey.String())
 	}
+	if id.AliasEvmAddress != nil {
+		return fmt.Sprintf("%d.%d.%s", id.Shard, id.Realm, hex.EncodeToString(*id.AliasEvmAddress))
+	}
 
 	return fmt.Sprintf("%d.%d.%d", id.Shard, id.Realm, id.Account)
 }
@@ -153,6 +184,9 @@ func (id AccountID) ToStringWithChecksum(client *Client) (string, error) {
 	if id.AliasKey != nil {
 		return "", errors.New("Account ID contains alias key, unable get checksum")
 	}
+	if id.AliasEvmAddress != nil {
+		return "", errors.New("Account ID contains EVM address, unable get checksum")
+	}
 	if client.GetNetworkName() == nil && client.GetLedgerID() == nil {
 		return "", errNetworkNameMissing
 	}
@@ -183,6 +217,14 @@ func (id AccountID) _ToProtobuf() *services.AccountID {
 		ShardNum: int64(id.Shard),
 		RealmNum: int64(id.Realm),
 	}
+	if id.AliasEvmAddress != nil {
+		resultID.Account = &services.AccountID_Alias{
+			Alias: *id.AliasEvmAddress,
+		}
+
+		return resultID
+	}
+
 	if id.AliasKey == nil {
 		resultID.Account = &services.AccountID_AccountNum{
 			AccountNum: int64(id.Account),
@@ -223,6 +265,13 @@ func _AccountIDFromProtobuf(accountID *services.AccountID) *AccountID {
 
 	switch t := accountID.Account.(type) {
 	case *services.AccountID_Alias:
+		if len(t.Alias) == 20 {
+			// a 20 byte alias is an EVM address rather than a serialized key
+			evmAddress := t.Alias
+			resultAccountID.AliasEvmAddress = &evmAddress
+			return resultAccountID
+		}
+
 		pb := services.Key{}
 		_ = protobuf.Unmarshal(t.Alias, &pb)
 		initialKey, _ := _KeyFromProtobuf(&pb)
@@ -244,7 +293,11 @@ func _AccountIDFromProtobuf(accountID *services.AccountID) *AccountID {
 }
 
 func (id AccountID) _IsZero() bool {
-	return id.Shard == 0 && id.Realm == 0 && id.Account == 0 && id.AliasKey == nil
+	return id.Shard == 0 && id.Realm == 0 && id.Account == 0 && id.AliasKey == nil && id.AliasEvmAddress == nil
+}
+
+func (id AccountID) _HasAlias() bool {
+	return id.AliasKey != nil || id.AliasEvmAddress != nil
 }
 
 func (id AccountID) _Equals(other AccountID) bool {
@@ -254,6 +307,13 @@ func (id AccountID) _Equals(other AccountID) bool {
 		initialAlias = id.AliasKey.String()
 		otherAlias = other.AliasKey.String()
 	}
+	if (id.AliasEvmAddress == nil) != (other.AliasEvmAddress == nil) {
+		return false
+	}
+	if id.AliasEvmAddress != nil && other.AliasEvmAddress != nil {
+		initialAlias = hex.EncodeToString(*id.AliasEvmAddress)
+		otherAlias = hex.EncodeToString(*other.AliasEvmAddress)
+	}
 
 	return id.Shard == other.Shard && id.Realm == other.Realm && id.Account == other.Account && initialAlias == otherAlias
 }
@@ -301,6 +361,20 @@ func (id AccountID) Compare(given AccountID) int {
 		}
 	}
 
+	if (id.AliasEvmAddress == nil) != (given.AliasEvmAddress == nil) {
+		if id.AliasEvmAddress == nil {
+			return -1
+		}
+
+		return 1
+	}
+
+	if id.AliasEvmAddress != nil && given.AliasEvmAddress != nil {
+		if compare := bytes.Compare(*id.AliasEvmAddress, *given.AliasEvmAddress); compare != 0 {
+			return compare
+		}
+	}
+
 	if id.Account > given.Account { //nolint
 		return 1
 	} else if id.Account < given.Account {
@@ -56,6 +56,67 @@ func TestUnitAccountIDFromStringAlias(t *testing.T) {
 	assert.Equal(t, id.String(), id2.String())
 }
 
+func TestUnitAccountIDFromStringEvmAddress(t *testing.T) {
+	for _, s := range []string{
+		"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+		"0.0.f39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+		"0.0.0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266",
+	} {
+		id, err := AccountIDFromString(s)
+		require.NoError(t, err, s)
+		require.NotNil(t, id.AliasEvmAddress, s)
+		assert.Nil(t, id.AliasKey)
+		assert.Equal(t, "0.0.f39fd6e51aad88f6f4ce6ab8827279cfffb92266", id.String())
+	}
+
+	_, err := AccountIDFromString("0xf39fd6e51aad88f6f4ce6ab8827279cfffb922")
+	assert.Error(t, err)
+
+	_, err = AccountIDFromString("0x" + "zz" + "f39fd6e51aad88f6f4ce6ab8827279cfffb922")
+	assert.Error(t, err)
+}
+
+func TestUnitAccountIDFromEvmAddress(t *testing.T) {
+	id, err := AccountIDFromEvmAddress(0, 0, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266")
+	require.NoError(t, err)
+
+	fromBytes, err := AccountIDFromBytes(id.ToBytes())
+	require.NoError(t, err)
+	require.NotNil(t, fromBytes.AliasEvmAddress)
+	assert.Equal(t, *id.AliasEvmAddress, *fromBytes.AliasEvmAddress)
+	assert.Nil(t, fromBytes.AliasKey)
+	assert.True(t, id._Equals(fromBytes))
+	assert.Equal(t, 0, id.Compare(fromBytes))
+
+	other, err := AccountIDFromEvmAddress(0, 0, "70997970c51812dc3a010c7d01b50e0d17dc79c8")
+	require.NoError(t, err)
+	assert.False(t, id._Equals(other))
+	assert.NotEqual(t, 0, id.Compare(other))
+	assert.False(t, id._Equals(AccountID{}))
+	assert.NotEqual(t, 0, id.Compare(AccountID{}))
+
+	assert.Error(t, id.ValidateChecksum(ClientForTestnet()))
+
+	_, err = AccountIDFromEvmAddress(0, 0, "0x1234")
+	assert.Error(t, err)
+}
+
+func TestUnitPublicKeyToEvmAddress(t *testing.T) {
+	key, err := PrivateKeyFromStringECSDA("ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80")
+	require.NoError(t, err)
+
+	evmAddress := key.PublicKey().ToEvmAddress()
+	assert.Equal(t, "f39fd6e51aad88f6f4ce6ab8827279cfffb92266", evmAddress)
+
+	id, err := AccountIDFromEvmAddress(0, 0, evmAddress)
+	require.NoError(t, err)
+	assert.Equal(t, "0.0."+evmAddress, id.String())
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	assert.Equal(t, "", ed25519Key.PublicKey().ToEvmAddress())
+}
+
 func TestUnitChecksum(t *testing.T) {
 	id, err := LedgerIDFromString("01")
 	require.NoError(t, err)
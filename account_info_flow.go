@@ -20,10 +20,19 @@ package hedera
  *
  */
 
+import (
+	"context"
+)
+
 func AccountInfoFlowVerifySignature(client *Client, accountID AccountID, message []byte, signature []byte) (bool, error) {
+	return AccountInfoFlowVerifySignatureWithContext(context.Background(), client, accountID, message, signature)
+}
+
+// AccountInfoFlowVerifySignatureWithContext is AccountInfoFlowVerifySignature, stopping once ctx is done.
+func AccountInfoFlowVerifySignatureWithContext(ctx context.Context, client *Client, accountID AccountID, message []byte, signature []byte) (bool, error) {
 	info, err := NewAccountInfoQuery().
 		SetAccountID(accountID).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return false, err
@@ -37,9 +46,14 @@ func AccountInfoFlowVerifySignature(client *Client, accountID AccountID, message
 }
 
 func AccountInfoFlowVerifyTransaction(client *Client, accountID AccountID, transaction Transaction, signature []byte) (bool, error) {
+	return AccountInfoFlowVerifyTransactionWithContext(context.Background(), client, accountID, transaction, signature)
+}
+
+// AccountInfoFlowVerifyTransactionWithContext is AccountInfoFlowVerifyTransaction, stopping once ctx is done.
+func AccountInfoFlowVerifyTransactionWithContext(ctx context.Context, client *Client, accountID AccountID, transaction Transaction, signature []byte) (bool, error) {
 	info, err := NewAccountInfoQuery().
 		SetAccountID(accountID).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return false, err
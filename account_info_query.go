@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -86,8 +87,8 @@ func (query *AccountInfoQuery) _Build() *services.Query_CryptoGetInfo {
 	return &pb
 }
 
-func _AccountInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetInfo().Header.NodeTransactionPrecheckCode))
+func _AccountInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetCryptoGetInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -102,7 +103,14 @@ func _AccountInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *AccountInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -138,6 +146,7 @@ func (query *AccountInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountInfoQueryShouldRetry,
@@ -189,7 +198,14 @@ func (query *AccountInfoQuery) SetMaxRetry(count int) *AccountInfoQuery {
 	return query
 }
 
+// Execute executes the Query with the provided client
 func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *AccountInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (AccountInfo, error) {
 	if client == nil || client.operator == nil {
 		return AccountInfo{}, errNoClientProvided
 	}
@@ -215,7 +231,7 @@ func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return AccountInfo{}, err
 		}
@@ -252,6 +268,7 @@ func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountInfoQueryShouldRetry,
@@ -275,6 +292,12 @@ func (query *AccountInfoQuery) Execute(client *Client) (AccountInfo, error) {
 	return _AccountInfoFromProtobuf(resp.(*services.Response).GetCryptoGetInfo().AccountInfo)
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *AccountInfoQuery) SetRetryPolicy(policy RetryPolicy) *AccountInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *AccountInfoQuery) SetMaxBackoff(max time.Duration) *AccountInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
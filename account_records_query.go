@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -93,7 +94,14 @@ func (query *AccountRecordsQuery) _Build() *services.Query_CryptoGetAccountRecor
 	return &pb
 }
 
+// GetCost returns the cost of the Query
 func (query *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *AccountRecordsQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -121,6 +129,7 @@ func (query *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountRecordsQueryShouldRetry,
@@ -145,8 +154,8 @@ func (query *AccountRecordsQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _AccountRecordsQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetAccountRecords().Header.NodeTransactionPrecheckCode))
+func _AccountRecordsQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetCryptoGetAccountRecords().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountRecordsQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -161,7 +170,14 @@ func _AccountRecordsQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *AccountRecordsQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TransactionRecord, error) {
 	if client == nil || client.operator == nil {
 		return []TransactionRecord{}, errNoClientProvided
 	}
@@ -187,7 +203,7 @@ func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord,
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []TransactionRecord{}, err
 		}
@@ -229,6 +245,7 @@ func (query *AccountRecordsQuery) Execute(client *Client) ([]TransactionRecord,
 	records := make([]TransactionRecord, 0)
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountRecordsQueryShouldRetry,
@@ -280,6 +297,12 @@ func (query *AccountRecordsQuery) SetMaxRetry(count int) *AccountRecordsQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *AccountRecordsQuery) SetRetryPolicy(policy RetryPolicy) *AccountRecordsQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *AccountRecordsQuery) SetMaxBackoff(max time.Duration) *AccountRecordsQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
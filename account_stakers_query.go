@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -93,7 +94,14 @@ func (query *AccountStakersQuery) _Build() *services.Query_CryptoGetProxyStakers
 	return &pb
 }
 
+// GetCost returns the cost of the Query
 func (query *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *AccountStakersQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -120,6 +128,7 @@ func (query *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountStakersQueryShouldRetry,
@@ -144,8 +153,8 @@ func (query *AccountStakersQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _AccountStakersQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetProxyStakers().Header.NodeTransactionPrecheckCode))
+func _AccountStakersQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetCryptoGetProxyStakers().Header.NodeTransactionPrecheckCode))
 }
 
 func _AccountStakersQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -160,7 +169,14 @@ func _AccountStakersQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *AccountStakersQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]Transfer, error) {
 	if client == nil || client.operator == nil {
 		return []Transfer{}, errNoClientProvided
 	}
@@ -186,7 +202,7 @@ func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []Transfer{}, err
 		}
@@ -224,6 +240,7 @@ func (query *AccountStakersQuery) Execute(client *Client) ([]Transfer, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_AccountStakersQueryShouldRetry,
@@ -287,6 +304,12 @@ func (query *AccountStakersQuery) SetMaxRetry(count int) *AccountStakersQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *AccountStakersQuery) SetRetryPolicy(policy RetryPolicy) *AccountStakersQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *AccountStakersQuery) SetMaxBackoff(max time.Duration) *AccountStakersQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -20,6 +20,16 @@ package hedera
  *
  */
 
+import (
+	"context"
+	"io"
+	"math"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// AddressBookQuery fetches the address book of the consensus nodes from the mirror network.
 type AddressBookQuery struct {
 	attempt     uint64
 	maxAttempts uint64
@@ -27,146 +37,154 @@ type AddressBookQuery struct {
 	limit       int32
 }
 
-// func NewAddressBookQuery() *AddressBookQuery {
-// 	return &AddressBookQuery{
-// 		fileID: nil,
-// 		limit:  0,
-// 	}
-// }
-
-// func (query *AddressBookQuery) SetFileID(id FileID) *AddressBookQuery {
-// 	query.fileID = &id
-// 	return query
-// }
-
-// func (query *AddressBookQuery) GetFileID() FileID {
-// 	if query.fileID == nil {
-// 		return FileID{}
-// 	}
-
-// 	return *query.fileID
-// }
-
-// func (query *AddressBookQuery) SetLimit(limit int32) *AddressBookQuery {
-// 	query.limit = limit
-// 	return query
-// }
-
-// func (query *AddressBookQuery) GetLimit() int32 {
-// 	return query.limit
-// }
-
-// func (query *AddressBookQuery) SetMaxAttempts(maxAttempts uint64) *AddressBookQuery {
-// 	query.maxAttempts = maxAttempts
-// 	return query
-// }
-
-// func (query *AddressBookQuery) GetMaxAttempts() uint64 {
-// 	return query.maxAttempts
-// }
-
-// func (query *AddressBookQuery) _ValidateNetworkOnIDs(client *Client) error {
-// 	if client == nil || !client.autoValidateChecksums {
-// 		return nil
-// 	}
-
-// 	if query.fileID != nil {
-// 		if err := query.fileID.ValidateChecksum(client); err != nil {
-// 			return err
-// 		}
-// 	}
-
-// 	return nil
-// }
-
-// func (query *AddressBookQuery) _Build() *mirror.AddressBookQuery {
-// 	body := &mirror.AddressBookQuery{
-// 		Limit: query.limit,
-// 	}
-// 	if query.fileID != nil {
-// 		body.FileId = query.fileID._ToProtobuf()
-// 	}
-
-// 	return body
-// }
-
-// func (query *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
-// 	var cancel func()
-// 	var ctx context.Context
-
-// 	err := query._ValidateNetworkOnIDs(client)
-// 	if err != nil {
-// 		return NodeAddressBook{}, err
-// 	}
-
-// 	pb := query._Build()
-
-// 	messages := make([]*services.NodeAddress, 0)
-
-// 	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetNetworkServiceClient()
-// 	if err != nil {
-// 		return NodeAddressBook{}, err
-// 	}
-// 	ch := make(chan byte, 1)
-
-// 	go func() {
-// 		var subClient mirror.NetworkService_GetNodesClient
-// 		var err error
-
-// 		for {
-// 			if err != nil {
-// 				cancel()
-
-// 				if grpcErr, ok := status.FromError(err); ok { // nolint
-// 					if query.attempt < query.maxAttempts {
-// 						subClient = nil
-
-// 						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
-// 						time.Sleep(time.Duration(delay) * time.Millisecond)
-// 						query.attempt++
-// 					} else {
-// 						panic(grpcErr.Err())
-// 					}
-// 				} else if err == io.EOF {
-// 					break
-// 				} else {
-// 					panic(err)
-// 				}
-// 			}
-
-// 			if subClient == nil {
-// 				ctx, cancel = context.WithCancel(context.TODO())
-
-// 				subClient, err = (*channel).GetNodes(ctx, pb)
-// 				if err != nil {
-// 					continue
-// 				}
-// 			}
-
-// 			var resp *services.NodeAddress
-// 			resp, err = subClient.Recv()
-
-// 			if err != nil {
-// 				continue
-// 			}
-
-// 			if pb.Limit > 0 {
-// 				pb.Limit--
-// 			}
-
-// 			messages = append(messages, resp)
-// 		}
-// 		ch <- 1
-// 	}()
-// 	<-ch
-
-// 	result := make([]NodeAddress, 0)
-
-// 	for _, k := range messages {
-// 		result = append(result, _NodeAddressFromProtobuf(k))
-// 	}
-
-// 	return NodeAddressBook{
-// 		NodeAddresses: result,
-// 	}, nil
-// }
+func NewAddressBookQuery() *AddressBookQuery {
+	return &AddressBookQuery{
+		maxAttempts: maxAttempts,
+		fileID:      nil,
+		limit:       0,
+	}
+}
+
+// SetFileID sets the address book file to fetch, usually 0.0.101 or 0.0.102.
+func (query *AddressBookQuery) SetFileID(id FileID) *AddressBookQuery {
+	query.fileID = &id
+	return query
+}
+
+func (query *AddressBookQuery) GetFileID() FileID {
+	if query.fileID == nil {
+		return FileID{}
+	}
+
+	return *query.fileID
+}
+
+// SetLimit sets the maximum number of node addresses to receive; zero means all of them.
+func (query *AddressBookQuery) SetLimit(limit int32) *AddressBookQuery {
+	query.limit = limit
+	return query
+}
+
+func (query *AddressBookQuery) GetLimit() int32 {
+	return query.limit
+}
+
+func (query *AddressBookQuery) SetMaxAttempts(maxAttempts uint64) *AddressBookQuery {
+	query.maxAttempts = maxAttempts
+	return query
+}
+
+func (query *AddressBookQuery) GetMaxAttempts() uint64 {
+	return query.maxAttempts
+}
+
+func (query *AddressBookQuery) _ValidateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	if query.fileID != nil {
+		if err := query.fileID.ValidateChecksum(client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (query *AddressBookQuery) _Build() *_MirrorAddressBookQuery {
+	body := &_MirrorAddressBookQuery{
+		Limit: query.limit,
+	}
+	if query.fileID != nil {
+		body.FileID = query.fileID._ToProtobuf()
+	}
+
+	return body
+}
+
+// Execute fetches the address book from the client's mirror network.
+func (query *AddressBookQuery) Execute(client *Client) (NodeAddressBook, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext fetches the address book from the client's mirror network, stopping any
+// in-flight request or backoff once ctx is done.
+// The stream is restarted from the beginning on transient gRPC errors until the query's max
+// attempts are exhausted.
+func (query *AddressBookQuery) ExecuteWithContext(ctx context.Context, client *Client) (NodeAddressBook, error) {
+	if client == nil || client.mirrorNetwork == nil || len(client.mirrorNetwork.nodes) == 0 {
+		return NodeAddressBook{}, errNoMirrorNetwork
+	}
+
+	err := query._ValidateNetworkOnIDs(client)
+	if err != nil {
+		return NodeAddressBook{}, err
+	}
+
+	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetNetworkServiceClient()
+	if err != nil {
+		return NodeAddressBook{}, err
+	}
+
+	pb := query._Build()
+
+	for {
+		messages, err := query._Receive(ctx, channel, pb)
+		if err == nil {
+			result := make([]NodeAddress, 0, len(messages))
+			for _, message := range messages {
+				result = append(result, _NodeAddressFromProtobuf(message))
+			}
+
+			return NodeAddressBook{
+				NodeAddresses: result,
+			}, nil
+		}
+
+		if ctx.Err() != nil {
+			return NodeAddressBook{}, ctx.Err()
+		}
+
+		if query.attempt >= query.maxAttempts || !_DefaultRetryHandler(err) {
+			return NodeAddressBook{}, err
+		}
+
+		delay := time.Duration(math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)) * time.Millisecond
+		query.attempt++
+
+		client.GetLogger().Trace("retrying address book query", "attempt", query.attempt, "delay", delay.String(), "error", err.Error())
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return NodeAddressBook{}, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// _Receive reads the whole address book stream
+func (query *AddressBookQuery) _Receive(ctx context.Context, channel *_MirrorNetworkServiceClient, pb *_MirrorAddressBookQuery) ([]*services.NodeAddress, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := channel.GetNodes(ctx, pb)
+	if err != nil {
+		return nil, err
+	}
+
+	messages := make([]*services.NodeAddress, 0)
+	for {
+		message, err := stream.Recv()
+		if err == io.EOF {
+			return messages, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		messages = append(messages, message)
+	}
+}
@@ -0,0 +1,464 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// CassetteMode is whether a Cassette records the calls a Client makes to nodes, or replays them.
+type CassetteMode uint32
+
+const (
+	// CassetteModeRecord sends requests to the nodes and records them with their responses
+	CassetteModeRecord CassetteMode = iota
+	// CassetteModeReplay answers requests with the recorded responses, without dialing any node
+	CassetteModeReplay
+)
+
+func (mode CassetteMode) String() string {
+	switch mode {
+	case CassetteModeRecord:
+		return "RECORD"
+	case CassetteModeReplay:
+		return "REPLAY"
+	}
+
+	return "UNKNOWN"
+}
+
+// The version of the cassette file format
+const cassetteVersion = 1
+
+// Cassette records every request a Client sends to a node, with the node's response, so a run against a real network
+// can be replayed later without one. Set it on a Client with `Client.SetCassette()`.
+//
+// Requests are replayed in the order they were recorded, and each must match the recorded one once normalized:
+// transaction IDs lose their valid start time and transactions their signatures, since both change from run to run.
+// A request which doesn't match fails with ErrCassetteMismatch. When replaying, requests whose nodes weren't set
+// explicitly are sent to the node they were recorded with, and retries don't back off.
+//
+// Only calls to consensus nodes are recorded; mirror node subscriptions aren't.
+type Cassette struct {
+	mutex        sync.Mutex
+	mode         CassetteMode
+	interactions []_CassetteInteraction
+	position     int
+}
+
+type _CassetteInteraction struct {
+	NodeAccountID string `json:"nodeAccountId"`
+	// Only there for people reading the file; the request type is taken from the request when replaying
+	RequestType string        `json:"requestType"`
+	Query       bool          `json:"query"`
+	Request     []byte        `json:"request"`
+	Response    []byte        `json:"response,omitempty"`
+	Error       *_CassetteErr `json:"error,omitempty"`
+	Time        time.Time     `json:"time"`
+	Latency     time.Duration `json:"latency"`
+}
+
+type _CassetteErr struct {
+	Code    codes.Code `json:"code"`
+	Message string     `json:"message"`
+}
+
+type _CassetteFile struct {
+	Version      int                    `json:"version"`
+	Interactions []_CassetteInteraction `json:"interactions"`
+}
+
+// NewCassette returns an empty Cassette recording the calls made by the Client it's set on.
+func NewCassette() *Cassette {
+	return &Cassette{
+		mode:         CassetteModeRecord,
+		interactions: make([]_CassetteInteraction, 0),
+	}
+}
+
+// CassetteFromBytes returns a Cassette replaying the interactions of a cassette returned by `Cassette.ToBytes()`.
+func CassetteFromBytes(data []byte) (*Cassette, error) {
+	var file _CassetteFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, errors.Wrap(err, "invalid cassette")
+	}
+	if file.Version != cassetteVersion {
+		return nil, errors.Wrapf(errCassetteVersion, "version %d", file.Version)
+	}
+
+	for i, interaction := range file.Interactions {
+		if _, err := AccountIDFromString(interaction.NodeAccountID); err != nil {
+			return nil, errors.Wrapf(err, "invalid node account ID of cassette interaction %d", i)
+		}
+		if _, _, err := interaction._Decode(); err != nil {
+			return nil, errors.Wrapf(err, "invalid cassette interaction %d", i)
+		}
+	}
+
+	return &Cassette{
+		mode:         CassetteModeReplay,
+		interactions: file.Interactions,
+	}, nil
+}
+
+// CassetteFromFile returns a Cassette replaying the interactions of a cassette saved by `Cassette.SaveFile()`.
+func CassetteFromFile(path string) (*Cassette, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return CassetteFromBytes(data)
+}
+
+// ToBytes returns the interactions recorded, as JSON.
+func (cassette *Cassette) ToBytes() ([]byte, error) {
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	return json.MarshalIndent(_CassetteFile{
+		Version:      cassetteVersion,
+		Interactions: cassette.interactions,
+	}, "", "  ")
+}
+
+// SaveFile writes the interactions recorded to a file, as JSON.
+func (cassette *Cassette) SaveFile(path string) error {
+	data, err := cassette.ToBytes()
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, data, 0644) // nolint
+}
+
+// GetMode returns whether the cassette records calls or replays them
+func (cassette *Cassette) GetMode() CassetteMode {
+	return cassette.mode
+}
+
+// GetInteractionCount returns the number of interactions recorded or loaded.
+func (cassette *Cassette) GetInteractionCount() int {
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	return len(cassette.interactions)
+}
+
+// GetRemainingInteractionCount returns the number of interactions left to replay, which is zero once a test made
+// every request that was recorded.
+func (cassette *Cassette) GetRemainingInteractionCount() int {
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	return len(cassette.interactions) - cassette.position
+}
+
+// _IsReplaying returns whether requests are answered by the cassette, which may be nil
+func (cassette *Cassette) _IsReplaying() bool {
+	return cassette != nil && cassette.mode == CassetteModeReplay
+}
+
+// _GetReplayNode returns the node the next interaction was recorded with, when replaying
+func (cassette *Cassette) _GetReplayNode(network *_Network) (*_Node, bool) {
+	if !cassette._IsReplaying() {
+		return nil, false
+	}
+
+	cassette.mutex.Lock()
+	if cassette.position >= len(cassette.interactions) {
+		cassette.mutex.Unlock()
+		return nil, false
+	}
+	nodeAccountID, err := AccountIDFromString(cassette.interactions[cassette.position].NodeAccountID)
+	cassette.mutex.Unlock()
+	if err != nil {
+		return nil, false
+	}
+
+	return network._GetNodeForAccountID(nodeAccountID)
+}
+
+// _Invoker returns the NodeInvoker calling the node through the cassette: recording the call made by invoker, or
+// answering it with the next interaction
+func (cassette *Cassette) _Invoker(invoker NodeInvoker) NodeInvoker {
+	if cassette.mode == CassetteModeReplay {
+		return cassette._Replay
+	}
+
+	return func(ctx context.Context, request *NodeRequest) NodeResponse {
+		start := time.Now()
+		response := invoker(ctx, request)
+		cassette._Record(request, response, start)
+
+		return response
+	}
+}
+
+func (cassette *Cassette) _Record(request *NodeRequest, response NodeResponse, start time.Time) {
+	_, query := request.Request.(*services.Query)
+	requestBytes, err := protobuf.Marshal(request.Request)
+	if err != nil {
+		return
+	}
+
+	interaction := _CassetteInteraction{
+		NodeAccountID: request.NodeAccountID.String(),
+		RequestType:   request.RequestType.String(),
+		Query:         query,
+		Request:       requestBytes,
+		Time:          start.UTC(),
+		Latency:       response.Latency,
+	}
+
+	if response.Err != nil {
+		grpcStatus, _ := status.FromError(response.Err)
+		interaction.Error = &_CassetteErr{
+			Code:    grpcStatus.Code(),
+			Message: grpcStatus.Message(),
+		}
+	} else if response.Response != nil {
+		if interaction.Response, err = protobuf.Marshal(response.Response); err != nil {
+			return
+		}
+	}
+
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	cassette.interactions = append(cassette.interactions, interaction)
+}
+
+func (cassette *Cassette) _Replay(_ context.Context, request *NodeRequest) NodeResponse {
+	cassette.mutex.Lock()
+	defer cassette.mutex.Unlock()
+
+	mismatch := ErrCassetteMismatch{
+		Index:         cassette.position,
+		NodeAccountID: request.NodeAccountID,
+		RequestType:   request.RequestType,
+	}
+
+	if cassette.position >= len(cassette.interactions) {
+		mismatch.Exhausted = true
+		return NodeResponse{Err: mismatch}
+	}
+
+	interaction := cassette.interactions[cassette.position]
+	recordedRequest, response, err := interaction._Decode()
+	if err != nil {
+		return NodeResponse{Err: err}
+	}
+
+	// the interaction was checked when loaded
+	mismatch.ExpectedNodeAccountID, _ = AccountIDFromString(interaction.NodeAccountID)
+	mismatch.ExpectedRequestType = _RequestTypeForProtobuf(recordedRequest)
+	if mismatch.ExpectedNodeAccountID != request.NodeAccountID || mismatch.ExpectedRequestType != request.RequestType {
+		return NodeResponse{Err: mismatch}
+	}
+
+	expected := _CassetteNormalize(recordedRequest)
+	actual := _CassetteNormalize(request.Request)
+	if reflect.TypeOf(expected) != reflect.TypeOf(actual) {
+		return NodeResponse{Err: mismatch}
+	}
+	if !protobuf.Equal(expected, actual) {
+		mismatch.Field = _CassetteDiff(expected.ProtoReflect(), actual.ProtoReflect())
+		return NodeResponse{Err: mismatch}
+	}
+
+	cassette.position++
+
+	if interaction.Error != nil {
+		return NodeResponse{
+			Err:     status.Error(interaction.Error.Code, interaction.Error.Message),
+			Latency: interaction.Latency,
+		}
+	}
+
+	return NodeResponse{
+		Response: response,
+		Latency:  interaction.Latency,
+	}
+}
+
+// _Decode returns the request and response of an interaction
+func (interaction _CassetteInteraction) _Decode() (protobuf.Message, protobuf.Message, error) {
+	var request, response protobuf.Message
+	if interaction.Query {
+		request, response = &services.Query{}, &services.Response{}
+	} else {
+		request, response = &services.Transaction{}, &services.TransactionResponse{}
+	}
+
+	if err := protobuf.Unmarshal(interaction.Request, request); err != nil {
+		return nil, nil, err
+	}
+	if err := protobuf.Unmarshal(interaction.Response, response); err != nil {
+		return nil, nil, err
+	}
+
+	return request, response, nil
+}
+
+// _CassetteNormalize returns a copy of a request without what changes from one run to the next: the valid start time
+// of transaction IDs, and the signatures of transactions, whose bodies are decoded
+func _CassetteNormalize(request protobuf.Message) protobuf.Message {
+	normalized := protobuf.Clone(request)
+	_CassetteNormalizeMessage(normalized.ProtoReflect())
+
+	return normalized
+}
+
+func _CassetteNormalizeMessage(message protoreflect.Message) {
+	switch value := message.Interface().(type) {
+	case *services.TransactionID:
+		value.TransactionValidStart = nil
+	case *services.Transaction:
+		if body := _CassetteTransactionBody(value); body != nil {
+			value.Body = body     // nolint
+			value.BodyBytes = nil // nolint
+			value.SignedTransactionBytes = nil
+		}
+		value.Sigs = nil   // nolint
+		value.SigMap = nil // nolint
+	}
+
+	message.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.Message() == nil || field.IsMap():
+		case field.IsList():
+			for i := 0; i < value.List().Len(); i++ {
+				_CassetteNormalizeMessage(value.List().Get(i).Message())
+			}
+		default:
+			_CassetteNormalizeMessage(value.Message())
+		}
+
+		return true
+	})
+}
+
+// _CassetteTransactionBody returns the body of a transaction, or nil if it can't be decoded
+func _CassetteTransactionBody(transaction *services.Transaction) *services.TransactionBody {
+	bodyBytes := transaction.BodyBytes // nolint
+	if len(transaction.SignedTransactionBytes) > 0 {
+		var signedTransaction services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.SignedTransactionBytes, &signedTransaction); err != nil {
+			return nil
+		}
+		bodyBytes = signedTransaction.BodyBytes
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return nil
+	}
+
+	return &body
+}
+
+// _CassetteDiff returns the path of the first field that differs between two messages of the same type
+func _CassetteDiff(expected, actual protoreflect.Message) string {
+	fields := expected.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := string(field.Name())
+
+		if !expected.Has(field) && !actual.Has(field) {
+			continue
+		}
+		if expected.Has(field) != actual.Has(field) {
+			return name
+		}
+
+		switch {
+		case field.IsMap():
+			if !_CassetteMapEqual(field, expected.Get(field).Map(), actual.Get(field).Map()) {
+				return name
+			}
+		case field.IsList():
+			expectedList, actualList := expected.Get(field).List(), actual.Get(field).List()
+			if expectedList.Len() != actualList.Len() {
+				return name
+			}
+			for j := 0; j < expectedList.Len(); j++ {
+				if path, differs := _CassetteDiffValue(field, expectedList.Get(j), actualList.Get(j)); differs {
+					return fmt.Sprintf("%s[%d]%s", name, j, path)
+				}
+			}
+		default:
+			if path, differs := _CassetteDiffValue(field, expected.Get(field), actual.Get(field)); differs {
+				return name + path
+			}
+		}
+	}
+
+	return ""
+}
+
+// _CassetteDiffValue returns whether two values of a field differ, and the path of what differs within them when they
+// are messages
+func _CassetteDiffValue(field protoreflect.FieldDescriptor, expected, actual protoreflect.Value) (string, bool) {
+	if field.Message() != nil {
+		if !protobuf.Equal(expected.Message().Interface(), actual.Message().Interface()) {
+			if path := _CassetteDiff(expected.Message(), actual.Message()); path != "" {
+				return "." + path, true
+			}
+			return "", true
+		}
+		return "", false
+	}
+
+	return "", !reflect.DeepEqual(expected.Interface(), actual.Interface())
+}
+
+func _CassetteMapEqual(field protoreflect.FieldDescriptor, expected, actual protoreflect.Map) bool {
+	if expected.Len() != actual.Len() {
+		return false
+	}
+
+	equal := true
+	expected.Range(func(key protoreflect.MapKey, value protoreflect.Value) bool {
+		if !actual.Has(key) {
+			equal = false
+		} else {
+			_, differs := _CassetteDiffValue(field.MapValue(), value, actual.Get(key))
+			equal = !differs
+		}
+		return equal
+	})
+
+	return equal
+}
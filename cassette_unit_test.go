@@ -0,0 +1,171 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _MockCassetteReceipt(request *services.Query) *services.Response {
+	return &services.Response{
+		Response: &services.Response_TransactionGetReceipt{
+			TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+				Header: &services.ResponseHeader{
+					NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+					ResponseType:                request.GetTransactionGetReceipt().GetHeader().GetResponseType(),
+				},
+				Receipt: &services.TransactionReceipt{Status: services.ResponseCodeEnum_SUCCESS},
+			},
+		},
+	}
+}
+
+// _RecordTestCassette records a transfer which is retried after BUSY, and its receipt
+func _RecordTestCassette(t *testing.T) []byte {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY},
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+		_MockCassetteReceipt,
+		_MockCassetteReceipt,
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	cassette := NewCassette()
+	client.SetCassette(cassette)
+
+	_TransferTestCassette(t, client, NewHbar(1))
+	assert.Equal(t, CassetteModeRecord, cassette.GetMode())
+	assert.Equal(t, 3, cassette.GetInteractionCount())
+
+	data, err := cassette.ToBytes()
+	require.NoError(t, err)
+
+	return data
+}
+
+func _TransferTestCassette(t *testing.T, client *Client, amount Hbar) {
+	response, err := NewTransferTransaction().
+		AddHbarTransfer(client.GetOperatorAccountID(), amount.Negated()).
+		AddHbarTransfer(AccountID{Account: 1801}, amount).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, AccountID{Account: 3}, response.NodeID)
+
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	assert.Equal(t, StatusSuccess, receipt.Status)
+}
+
+// _NewReplayTestClient returns a client with nodes nothing listens on, replaying a cassette
+func _NewReplayTestClient(t *testing.T, data []byte) (*Client, *Cassette) {
+	cassette, err := CassetteFromBytes(data)
+	require.NoError(t, err)
+	assert.Equal(t, CassetteModeReplay, cassette.GetMode())
+
+	client := _NewClient(map[string]AccountID{
+		"127.0.0.1:1": {Account: 3},
+		"127.0.0.1:2": {Account: 4},
+	}, []string{}, "mainnet")
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	client.SetOperator(AccountID{Account: 1800}, key)
+	client.SetCassette(cassette)
+
+	return client, cassette
+}
+
+func TestUnitCassetteRecordAndReplay(t *testing.T) {
+	data := _RecordTestCassette(t)
+
+	// the requests are sent to the node they were recorded with, which is never dialed
+	for i := 0; i < 5; i++ {
+		client, cassette := _NewReplayTestClient(t, data)
+		_TransferTestCassette(t, client, NewHbar(1))
+		assert.Equal(t, 0, cassette.GetRemainingInteractionCount())
+	}
+}
+
+func TestUnitCassetteReplayMismatch(t *testing.T) {
+	data := _RecordTestCassette(t)
+
+	client, cassette := _NewReplayTestClient(t, data)
+	_, err := NewTransferTransaction().
+		AddHbarTransfer(client.GetOperatorAccountID(), NewHbar(-2)).
+		AddHbarTransfer(AccountID{Account: 1801}, NewHbar(2)).
+		Execute(client)
+	require.Error(t, err)
+
+	mismatch, ok := err.(ErrCassetteMismatch)
+	require.True(t, ok)
+	assert.Equal(t, 0, mismatch.Index)
+	assert.Equal(t, RequestTypeCryptoTransfer, mismatch.ExpectedRequestType)
+	assert.Contains(t, mismatch.Field, "body.cryptoTransfer.transfers.accountAmounts[")
+	assert.Contains(t, mismatch.Field, "].amount")
+	assert.Equal(t, 3, cassette.GetRemainingInteractionCount())
+
+	_, err = NewFileCreateTransaction().
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.Error(t, err)
+
+	mismatch, ok = err.(ErrCassetteMismatch)
+	require.True(t, ok)
+	assert.Equal(t, RequestTypeFileCreate, mismatch.RequestType)
+	assert.Equal(t, RequestTypeCryptoTransfer, mismatch.ExpectedRequestType)
+	assert.Equal(t, "cassette interaction 0 recorded CRYPTO_TRANSFER to node 0.0.3, but got FILE_CREATE to node 0.0.3", mismatch.Error())
+}
+
+func TestUnitCassetteReplayExhausted(t *testing.T) {
+	client, _ := _NewReplayTestClient(t, _RecordTestCassette(t))
+	_TransferTestCassette(t, client, NewHbar(1))
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 4}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.Error(t, err)
+
+	mismatch, ok := err.(ErrCassetteMismatch)
+	require.True(t, ok)
+	assert.True(t, mismatch.Exhausted)
+	assert.Equal(t, 3, mismatch.Index)
+	assert.Equal(t, "cassette has no interaction 3 left to replay CRYPTO_GET_ACCOUNT_BALANCE to node 0.0.4", mismatch.Error())
+}
+
+func TestUnitCassetteFromBytesInvalid(t *testing.T) {
+	_, err := CassetteFromBytes([]byte("not json"))
+	assert.Error(t, err)
+
+	_, err = CassetteFromBytes([]byte(`{"version":2,"interactions":[]}`))
+	assert.Error(t, err)
+
+	_, err = CassetteFromBytes([]byte(`{"version":1,"interactions":[{"nodeAccountId":"node","request":""}]}`))
+	assert.Error(t, err)
+}
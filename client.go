@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -41,8 +42,8 @@ type Client struct {
 
 	operator *_Operator
 
-	network _Network
-	// mirrorNetwork                   *_MirrorNetwork
+	network                         _Network
+	mirrorNetwork                   *_MirrorNetwork
 	autoValidateChecksums           bool
 	defaultRegenerateTransactionIDs bool
 	maxAttempts                     *int
@@ -51,8 +52,45 @@ type Client struct {
 	minBackoff time.Duration
 
 	requestTimeout *time.Duration
+	retryPolicy    RetryPolicy
+	interceptors   []Interceptor
+	cassette       *Cassette
+	logger         Logger
+	tracer         Tracer
+	meter          Meter
+
+	addressBookSource   AddressBookSource
+	networkUpdatePeriod time.Duration
+	networkUpdateCancel context.CancelFunc
+
+	feeCache *_FeeCache
+}
+
+// AddressBookSource is where the Client fetches the node address book from when updating its network.
+type AddressBookSource uint32
+
+const (
+	// AddressBookSourceMirror fetches file 0.0.102 through the mirror network's address book API
+	AddressBookSourceMirror AddressBookSource = iota
+	// AddressBookSourceFile fetches file 0.0.102 with a FileContentsQuery paid by the operator
+	AddressBookSourceFile
+)
+
+func (source AddressBookSource) String() string {
+	switch source {
+	case AddressBookSourceMirror:
+		return "MIRROR"
+	case AddressBookSourceFile:
+		return "FILE"
+	}
+
+	return "UNKNOWN"
 }
 
+// Nodes removed by an address book update are closed after this delay, unless the client has a
+// longer request timeout, so requests already sent to them can complete
+const addressBookNodeCloseDelay = 2 * time.Minute
+
 // TransactionSigner is a closure or function that defines how transactions will be signed
 type TransactionSigner func(message []byte) []byte
 
@@ -246,19 +284,21 @@ func ClientForPreviewnet() *Client {
 // and returns a Client instance which can be used to
 func _NewClient(network map[string]AccountID, mirrorNetwork []string, name NetworkName) *Client {
 	client := Client{
-		maxQueryPayment:   defaultMaxQueryPayment,
-		maxTransactionFee: defaultMaxTransactionFee,
-		network:           _NewNetwork(),
-		// mirrorNetwork:                   _NewMirrorNetwork(),
+		maxQueryPayment:                 defaultMaxQueryPayment,
+		maxTransactionFee:               defaultMaxTransactionFee,
+		network:                         _NewNetwork(),
+		mirrorNetwork:                   _NewMirrorNetwork(),
 		autoValidateChecksums:           false,
 		maxAttempts:                     nil,
 		minBackoff:                      250 * time.Millisecond,
 		maxBackoff:                      8 * time.Second,
 		defaultRegenerateTransactionIDs: true,
+		logger:                          _NewDefaultLogger(),
+		feeCache:                        &_FeeCache{},
 	}
 
 	_ = client.SetNetwork(network)
-	// client.SetMirrorNetwork(mirrorNetwork)
+	client.SetMirrorNetwork(mirrorNetwork)
 	client.network._SetNetworkName(name)
 
 	return &client
@@ -355,10 +395,15 @@ func ClientFromConfig(jsonBytes []byte) (*Client, error) {
 				client = _NewClient(network, testnetMirror, NetworkNameTestnet)
 			}
 		}
+	case nil:
 	default:
 		return client, errors.New("mirrorNetwork is expected to be either string or an array of strings")
 	}
 
+	if client == nil {
+		client = _NewClient(network, []string{}, NetworkNameMainnet)
+	}
+
 	// if the _Operator is not provided, finish here
 	if clientConfig.Operator == nil {
 		return client, nil
@@ -409,14 +454,16 @@ func ClientFromConfigFile(filename string) (*Client, error) {
 
 // Close is used to disconnect the Client from the _Network
 func (client *Client) Close() error {
+	client._StopNetworkUpdate()
+
 	err := client.network._Close()
 	if err != nil {
 		return err
 	}
-	// err = client.mirrorNetwork._Close()
-	// if err != nil {
-	// 	return err
-	// }
+	err = client.mirrorNetwork._Close()
+	if err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -425,6 +472,164 @@ func (client *Client) SetNetwork(network map[string]AccountID) error {
 	return client.network.SetNetwork(network)
 }
 
+// SetAddressBookSource sets where UpdateNetwork fetches the node address book from.
+// Defaults to AddressBookSourceMirror.
+func (client *Client) SetAddressBookSource(source AddressBookSource) *Client {
+	client.addressBookSource = source
+	return client
+}
+
+func (client *Client) GetAddressBookSource() AddressBookSource {
+	return client.addressBookSource
+}
+
+// SetNetworkUpdatePeriod starts a background task updating the client's network from the node
+// address book every period, replacing any previously running one. A zero period, the default,
+// disables automatic updates. Failed updates are logged and retried at the next period.
+func (client *Client) SetNetworkUpdatePeriod(period time.Duration) *Client {
+	client._StopNetworkUpdate()
+	client.networkUpdatePeriod = period
+
+	if period > 0 {
+		ctx, cancel := context.WithCancel(context.Background())
+		client.networkUpdateCancel = cancel
+		go client._ScheduleNetworkUpdate(ctx, period)
+	}
+
+	return client
+}
+
+func (client *Client) GetNetworkUpdatePeriod() time.Duration {
+	return client.networkUpdatePeriod
+}
+
+// UpdateNetwork fetches the node address book and updates the client's network to match it.
+// Unchanged nodes keep their connections, new nodes are added and nodes no longer in the address
+// book are removed once requests already sent to them had time to complete.
+func (client *Client) UpdateNetwork() error {
+	return client.UpdateNetworkWithContext(context.Background())
+}
+
+// UpdateNetworkWithContext is UpdateNetwork, giving up once ctx is done.
+func (client *Client) UpdateNetworkWithContext(ctx context.Context) error {
+	book, err := client._FetchAddressBook(ctx)
+	if err != nil {
+		return err
+	}
+
+	closeDelay := addressBookNodeCloseDelay
+	if client.requestTimeout != nil && *client.requestTimeout > closeDelay {
+		closeDelay = *client.requestTimeout
+	}
+
+	if err = client.network._SetAddressBook(book, closeDelay); err != nil {
+		return err
+	}
+
+	client.GetLogger().Debug("updated network from address book", "nodes", len(book.NodeAddresses), "source", client.addressBookSource.String())
+	return nil
+}
+
+func (client *Client) _FetchAddressBook(ctx context.Context) (NodeAddressBook, error) {
+	fileID := FileID{File: 102}
+
+	if client.addressBookSource == AddressBookSourceFile {
+		contents, err := NewFileContentsQuery().
+			SetFileID(fileID).
+			ExecuteWithContext(ctx, client)
+		if err != nil {
+			return NodeAddressBook{}, err
+		}
+
+		return NodeAddressBookFromBytes(contents)
+	}
+
+	return NewAddressBookQuery().
+		SetFileID(fileID).
+		ExecuteWithContext(ctx, client)
+}
+
+func (client *Client) _ScheduleNetworkUpdate(ctx context.Context, period time.Duration) {
+	ticker := time.NewTicker(period)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := client.UpdateNetworkWithContext(ctx); err != nil && ctx.Err() == nil {
+				client.GetLogger().Warn("failed to update network from address book", "error", err.Error())
+			}
+		}
+	}
+}
+
+func (client *Client) _StopNetworkUpdate() {
+	if client.networkUpdateCancel != nil {
+		client.networkUpdateCancel()
+		client.networkUpdateCancel = nil
+	}
+}
+
+// GetFeeSchedules returns the network's current and next fee schedules, read from file 0.0.111 with a
+// FileContentsQuery paid by the operator. They are cached until the current fee schedule expires.
+func (client *Client) GetFeeSchedules() (FeeSchedules, error) {
+	return client.GetFeeSchedulesWithContext(context.Background())
+}
+
+// GetFeeSchedulesWithContext is GetFeeSchedules, giving up once ctx is done.
+func (client *Client) GetFeeSchedulesWithContext(ctx context.Context) (FeeSchedules, error) {
+	if feeSchedules, ok := client.feeCache._GetFeeSchedules(time.Now()); ok {
+		return feeSchedules, nil
+	}
+
+	contents, err := NewFileContentsQuery().
+		SetFileID(FileIDForFeeSchedule()).
+		ExecuteWithContext(ctx, client)
+	if err != nil {
+		return FeeSchedules{}, err
+	}
+
+	feeSchedules, err := FeeSchedulesFromBytes(contents)
+	if err != nil {
+		return FeeSchedules{}, err
+	}
+
+	client.feeCache._SetFeeSchedules(feeSchedules)
+	return feeSchedules, nil
+}
+
+// GetExchangeRates returns the network's current and next exchange rates of hbars to cents, read from file 0.0.112
+// with a FileContentsQuery paid by the operator. They are cached until the current rate expires, and updated from
+// the rates carried by the receipts and records the client queries, so the file is only read when no recent
+// receipt had them.
+func (client *Client) GetExchangeRates() (ExchangeRates, error) {
+	return client.GetExchangeRatesWithContext(context.Background())
+}
+
+// GetExchangeRatesWithContext is GetExchangeRates, giving up once ctx is done.
+func (client *Client) GetExchangeRatesWithContext(ctx context.Context) (ExchangeRates, error) {
+	if exchangeRates, ok := client.feeCache._GetExchangeRates(time.Now()); ok {
+		return exchangeRates, nil
+	}
+
+	contents, err := NewFileContentsQuery().
+		SetFileID(FileIDForExchangeRate()).
+		ExecuteWithContext(ctx, client)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	exchangeRates, err := ExchangeRatesFromBytes(contents)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	client.feeCache._UpdateExchangeRates(exchangeRates)
+	return exchangeRates, nil
+}
+
 func (client *Client) GetNetwork() map[string]AccountID {
 	return client.network._GetNetwork()
 }
@@ -471,6 +676,133 @@ func (client *Client) GetMinBackoff() time.Duration {
 	return client.minBackoff
 }
 
+// SetRetryPolicy sets the RetryPolicy used by every transaction and query executed with this
+// Client which doesn't set its own. A nil policy restores the SDK's default behavior.
+func (client *Client) SetRetryPolicy(policy RetryPolicy) *Client {
+	client.retryPolicy = policy
+	return client
+}
+
+func (client *Client) GetRetryPolicy() RetryPolicy {
+	return client.retryPolicy
+}
+
+// AddInterceptor appends an Interceptor to the chain wrapping every call this Client makes to a
+// node. Interceptors run in the order they were added, the first one being outermost.
+func (client *Client) AddInterceptor(interceptor Interceptor) *Client {
+	client.interceptors = append(client.interceptors, interceptor)
+	return client
+}
+
+// SetInterceptors replaces the Client's interceptor chain.
+func (client *Client) SetInterceptors(interceptors ...Interceptor) *Client {
+	client.interceptors = append([]Interceptor(nil), interceptors...)
+	return client
+}
+
+func (client *Client) GetInterceptors() []Interceptor {
+	return client.interceptors
+}
+
+// SetCassette sets the Cassette recording every call this Client makes to a node, or replaying recorded calls
+// instead of making them. Interceptors wrap the cassette, so they see replayed calls too. A nil cassette makes the
+// Client call the nodes again.
+func (client *Client) SetCassette(cassette *Cassette) *Client {
+	client.cassette = cassette
+	return client
+}
+
+// GetCassette returns the Cassette set with SetCassette, or nil if the Client calls the nodes
+func (client *Client) GetCassette() *Cassette {
+	return client.cassette
+}
+
+// SetLogger sets the Logger all of the SDK's diagnostics for this Client are written to.
+// A nil logger silences them.
+func (client *Client) SetLogger(logger Logger) *Client {
+	client.logger = logger
+	return client
+}
+
+// GetLogger returns the Client's Logger, which is never nil.
+func (client *Client) GetLogger() Logger {
+	if client == nil || client.logger == nil {
+		return _NoopLogger{}
+	}
+
+	return client.logger
+}
+
+// SetTracer sets the Tracer used to emit a span for every `Execute` and a child span for every
+// attempt against a node. A nil tracer disables tracing.
+func (client *Client) SetTracer(tracer Tracer) *Client {
+	client.tracer = tracer
+	return client
+}
+
+// GetTracer returns the Client's Tracer, which is never nil.
+func (client *Client) GetTracer() Tracer {
+	if client == nil || client.tracer == nil {
+		return _NoopTracer{}
+	}
+
+	return client.tracer
+}
+
+// SetMeter sets the Meter used to record request latency, retries, node backoff and node
+// readmission. A nil meter disables metrics.
+func (client *Client) SetMeter(meter Meter) *Client {
+	client.meter = meter
+	client.network._SetMeter(client.GetMeter())
+	if client.mirrorNetwork != nil {
+		client.mirrorNetwork._SetMeter(client.GetMeter())
+	}
+	return client
+}
+
+// GetMeter returns the Client's Meter, which is never nil.
+func (client *Client) GetMeter() Meter {
+	if client == nil || client.meter == nil {
+		return _NoopMeter{}
+	}
+
+	return client.meter
+}
+
+// SetNodeSelector sets the strategy choosing which nodes requests are sent to when they don't
+// have node account IDs set explicitly. A nil selector restores the default RandomNodeSelector.
+func (client *Client) SetNodeSelector(selector NodeSelector) *Client {
+	client.network._SetNodeSelector(selector)
+	return client
+}
+
+func (client *Client) GetNodeSelector() NodeSelector {
+	return client.network._GetNodeSelector()
+}
+
+// SetPinnedNodeAccountIDs restricts the nodes the NodeSelector may choose from to the given
+// account IDs. An empty list allows every node in the network.
+// Node account IDs set explicitly on a transaction or query are not affected.
+func (client *Client) SetPinnedNodeAccountIDs(accountIDs []AccountID) *Client {
+	client.network._SetPinnedNodes(accountIDs)
+	return client
+}
+
+func (client *Client) GetPinnedNodeAccountIDs() []AccountID {
+	return client.network._GetPinnedNodes()
+}
+
+// SetExcludedNodeAccountIDs prevents the NodeSelector from choosing the given node account IDs.
+// Node account IDs set explicitly on a transaction or query are not affected.
+func (client *Client) SetExcludedNodeAccountIDs(accountIDs []AccountID) *Client {
+	client.network._SetExcludedNodes(accountIDs)
+	return client
+}
+
+func (client *Client) GetExcludedNodeAccountIDs() []AccountID {
+	return client.network._GetExcludedNodes()
+}
+
 func (client *Client) SetMaxAttempts(max int) {
 	client.maxAttempts = &max
 }
@@ -521,19 +853,20 @@ func (client *Client) SetMaxNodesPerTransaction(max int) {
 	client.network._SetMaxNodesPerTransaction(max)
 }
 
-// SetNetwork replaces all _Nodes in the Client with a new set of _Nodes.
-// (e.g. for an Address Book update).
-// func (client *Client) SetMirrorNetwork(mirrorNetwork []string) {
-// 	_ = client.mirrorNetwork._SetNetwork(mirrorNetwork)
-// }
+// SetMirrorNetwork replaces all mirror nodes in the Client with a new set of
+// mirror node addresses.
+func (client *Client) SetMirrorNetwork(mirrorNetwork []string) {
+	_ = client.mirrorNetwork._SetNetwork(mirrorNetwork)
+}
 
-// func (client *Client) GetMirrorNetwork() []string {
-// 	return client.mirrorNetwork._GetNetwork()
-// }
+// GetMirrorNetwork returns the addresses of the mirror nodes used by the Client.
+func (client *Client) GetMirrorNetwork() []string {
+	return client.mirrorNetwork._GetNetwork()
+}
 
 func (client *Client) SetTransportSecurity(tls bool) *Client {
 	client.network._SetTransportSecurity(tls)
-	// client.mirrorNetwork._SetTransportSecurity(tls)
+	client.mirrorNetwork._SetTransportSecurity(tls)
 
 	return client
 }
@@ -25,6 +25,7 @@ package hedera
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -70,43 +71,43 @@ func TestUnitClientFromConfigWrongType(t *testing.T) {
 func TestUnitClientSetNetworkExtensive(t *testing.T) {
 	client := ClientForTestnet()
 	nodes := make(map[string]AccountID, 2)
-	nodes["0.testnet.hedera.com:50211"] = AccountID{0, 0, 3, nil, nil}
-	nodes["1.testnet.hedera.com:50211"] = AccountID{0, 0, 4, nil, nil}
+	nodes["0.testnet.hedera.com:50211"] = AccountID{Account: 3}
+	nodes["1.testnet.hedera.com:50211"] = AccountID{Account: 4}
 
 	err := client.SetNetwork(nodes)
 	require.NoError(t, err)
 	network := client.GetNetwork()
 	assert.Equal(t, 2, len(network))
-	assert.Equal(t, network["0.testnet.hedera.com:50211"], AccountID{0, 0, 3, nil, nil})
-	assert.Equal(t, network["1.testnet.hedera.com:50211"], AccountID{0, 0, 4, nil, nil})
+	assert.Equal(t, network["0.testnet.hedera.com:50211"], AccountID{Account: 3})
+	assert.Equal(t, network["1.testnet.hedera.com:50211"], AccountID{Account: 4})
 
 	nodes = make(map[string]AccountID, 2)
-	nodes["0.testnet.hedera.com:50211"] = AccountID{0, 0, 3, nil, nil}
-	nodes["1.testnet.hedera.com:50211"] = AccountID{0, 0, 4, nil, nil}
-	nodes["2.testnet.hedera.com:50211"] = AccountID{0, 0, 5, nil, nil}
+	nodes["0.testnet.hedera.com:50211"] = AccountID{Account: 3}
+	nodes["1.testnet.hedera.com:50211"] = AccountID{Account: 4}
+	nodes["2.testnet.hedera.com:50211"] = AccountID{Account: 5}
 
 	err = client.SetNetwork(nodes)
 	require.NoError(t, err)
 	network = client.GetNetwork()
 	assert.Equal(t, 3, len(network))
-	assert.Equal(t, network["0.testnet.hedera.com:50211"], AccountID{0, 0, 3, nil, nil})
-	assert.Equal(t, network["1.testnet.hedera.com:50211"], AccountID{0, 0, 4, nil, nil})
-	assert.Equal(t, network["2.testnet.hedera.com:50211"], AccountID{0, 0, 5, nil, nil})
+	assert.Equal(t, network["0.testnet.hedera.com:50211"], AccountID{Account: 3})
+	assert.Equal(t, network["1.testnet.hedera.com:50211"], AccountID{Account: 4})
+	assert.Equal(t, network["2.testnet.hedera.com:50211"], AccountID{Account: 5})
 
 	nodes = make(map[string]AccountID, 1)
-	nodes["2.testnet.hedera.com:50211"] = AccountID{0, 0, 5, nil, nil}
+	nodes["2.testnet.hedera.com:50211"] = AccountID{Account: 5}
 
 	err = client.SetNetwork(nodes)
 	require.NoError(t, err)
 	network = client.GetNetwork()
 	assert.Equal(t, 1, len(network))
-	assert.Equal(t, network["2.testnet.hedera.com:50211"], AccountID{0, 0, 5, nil, nil})
+	assert.Equal(t, network["2.testnet.hedera.com:50211"], AccountID{Account: 5})
 
 	client.SetTransportSecurity(true)
 	client.SetCertificateVerification(true)
 	network = client.GetNetwork()
 	networkTLSMirror := client.GetMirrorNetwork()
-	assert.Equal(t, network["2.testnet.hedera.com:50212"], AccountID{0, 0, 5, nil, nil})
+	assert.Equal(t, network["2.testnet.hedera.com:50212"], AccountID{Account: 5})
 	assert.Equal(t, networkTLSMirror[0], "hcs.testnet.mirrornode.hedera.com:443")
 
 	err = client.Close()
@@ -169,15 +170,15 @@ func contains(s []string, e string) bool {
 func TestUnitClientSetMultipleNetwork(t *testing.T) {
 	client := ClientForTestnet()
 	nodes := make(map[string]AccountID, 8)
-	nodes["0.testnet.hedera.com:50211"] = AccountID{0, 0, 3, nil, nil}
-	nodes["34.94.106.61:50211"] = AccountID{0, 0, 3, nil, nil}
-	nodes["50.18.132.211:50211"] = AccountID{0, 0, 3, nil, nil}
-	nodes["138.91.142.219:50211"] = AccountID{0, 0, 3, nil, nil}
+	nodes["0.testnet.hedera.com:50211"] = AccountID{Account: 3}
+	nodes["34.94.106.61:50211"] = AccountID{Account: 3}
+	nodes["50.18.132.211:50211"] = AccountID{Account: 3}
+	nodes["138.91.142.219:50211"] = AccountID{Account: 3}
 
-	nodes["1.testnet.hedera.com:50211"] = AccountID{0, 0, 4, nil, nil}
-	nodes["35.237.119.55:50211"] = AccountID{0, 0, 4, nil, nil}
-	nodes["3.212.6.13:50211"] = AccountID{0, 0, 4, nil, nil}
-	nodes["52.168.76.241:50211"] = AccountID{0, 0, 4, nil, nil}
+	nodes["1.testnet.hedera.com:50211"] = AccountID{Account: 4}
+	nodes["35.237.119.55:50211"] = AccountID{Account: 4}
+	nodes["3.212.6.13:50211"] = AccountID{Account: 4}
+	nodes["52.168.76.241:50211"] = AccountID{Account: 4}
 
 	err := client.SetNetwork(nodes)
 	require.NoError(t, err)
@@ -200,3 +201,45 @@ func TestUnitClientSetMultipleNetwork(t *testing.T) {
 	}
 
 }
+
+func TestUnitClientUpdateNetwork(t *testing.T) {
+	responses := [][]interface{}{{
+		_MockNodeAddress(3, []byte{1}, []byte{1, 2, 3, 4}),
+		_MockNodeAddress(5, []byte{2}, []byte{1, 2, 3, 6}),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	require.Equal(t, AddressBookSourceMirror, client.GetAddressBookSource())
+
+	err := client.UpdateNetwork()
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]AccountID{
+		"1.2.3.4:50211": {Account: 3},
+		"1.2.3.6:50211": {Account: 5},
+	}, client.GetNetwork())
+}
+
+func TestUnitClientNetworkUpdatePeriod(t *testing.T) {
+	responses := [][]interface{}{{
+		_MockNodeAddress(7, nil, []byte{1, 2, 3, 4}),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	require.Equal(t, time.Duration(0), client.GetNetworkUpdatePeriod())
+
+	client.SetNetworkUpdatePeriod(10 * time.Millisecond)
+	require.Equal(t, 10*time.Millisecond, client.GetNetworkUpdatePeriod())
+
+	require.Eventually(t, func() bool {
+		_, ok := client.network._GetNodeForAccountID(AccountID{Account: 7})
+		return ok
+	}, 5*time.Second, 10*time.Millisecond)
+
+	client.SetNetworkUpdatePeriod(0)
+	require.Nil(t, client.networkUpdateCancel)
+}
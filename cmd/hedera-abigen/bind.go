@@ -0,0 +1,499 @@
+package main
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"go/format"
+	"go/token"
+	"sort"
+	"strings"
+	"text/template"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+)
+
+// names used by the generated code, which a parameter can't shadow
+var reservedNames = map[string]bool{
+	"contract":    true,
+	"contractABI": true,
+	"err":         true,
+	"hedera":      true,
+	"abi":         true,
+	"big":         true,
+	"common":      true,
+}
+
+type bindArgument struct {
+	Name    string
+	Field   string
+	RawName string
+	Type    string
+}
+
+type bindMethod struct {
+	Name     string
+	RawName  string
+	Sig      string
+	Constant bool
+	Inputs   []bindArgument
+	Outputs  []bindArgument
+}
+
+type bindEvent struct {
+	Name    string
+	RawName string
+	Sig     string
+	Fields  []bindArgument
+}
+
+type bindStruct struct {
+	Name   string
+	Fields []bindArgument
+}
+
+type bindContract struct {
+	Package     string
+	Type        string
+	ABI         string
+	Bytecode    string
+	Constructor []bindArgument
+	Methods     []bindMethod
+	Events      []bindEvent
+	Structs     []bindStruct
+}
+
+type binder struct {
+	typeName string
+	structs  map[string]*bindStruct
+	order    []string
+}
+
+// Bind generates the Go source of a package named pkg with bindings of type typeName for the contract described by
+// abiJSON. The bytecode, hex encoded, is optional; the deploy helper is only generated when it is given.
+func Bind(abiJSON []byte, bytecode string, pkg string, typeName string) ([]byte, error) {
+	contractABI, err := abi.JSON(bytes.NewReader(abiJSON))
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid ABI")
+	}
+
+	bytecode = strings.TrimPrefix(strings.TrimSpace(bytecode), "0x")
+	if _, err := hex.DecodeString(bytecode); err != nil {
+		return nil, errors.Wrap(err, "invalid bytecode")
+	}
+
+	if !token.IsIdentifier(pkg) {
+		return nil, errors.Errorf("invalid package name %q", pkg)
+	}
+
+	if !token.IsIdentifier(typeName) || !token.IsExported(typeName) {
+		return nil, errors.Errorf("invalid type name %q, an exported identifier is required", typeName)
+	}
+
+	b := &binder{typeName: typeName, structs: make(map[string]*bindStruct)}
+	contract := bindContract{
+		Package:     pkg,
+		Type:        typeName,
+		ABI:         string(abiJSON),
+		Bytecode:    bytecode,
+		Constructor: b.inputs(contractABI.Constructor.Inputs),
+	}
+
+	methodNames := make([]string, 0, len(contractABI.Methods))
+	for name := range contractABI.Methods {
+		methodNames = append(methodNames, name)
+	}
+	sort.Strings(methodNames)
+
+	for _, name := range methodNames {
+		method := contractABI.Methods[name]
+		contract.Methods = append(contract.Methods, bindMethod{
+			Name:     abi.ToCamelCase(method.Name),
+			RawName:  method.Name,
+			Sig:      method.Sig,
+			Constant: method.IsConstant(),
+			Inputs:   b.inputs(method.Inputs),
+			Outputs:  b.outputs(method.Outputs),
+		})
+	}
+
+	eventNames := make([]string, 0, len(contractABI.Events))
+	for name := range contractABI.Events {
+		eventNames = append(eventNames, name)
+	}
+	sort.Strings(eventNames)
+
+	for _, name := range eventNames {
+		event := contractABI.Events[name]
+		if event.Anonymous {
+			continue
+		}
+
+		contract.Events = append(contract.Events, bindEvent{
+			Name:    abi.ToCamelCase(event.Name),
+			RawName: event.Name,
+			Sig:     event.Sig,
+			Fields:  b.eventFields(event.Inputs),
+		})
+	}
+
+	for _, key := range b.order {
+		contract.Structs = append(contract.Structs, *b.structs[key])
+	}
+
+	var source bytes.Buffer
+	if err := bindTemplate.Execute(&source, contract); err != nil {
+		return nil, err
+	}
+
+	formatted, err := format.Source(source.Bytes())
+	if err != nil {
+		return nil, errors.Wrapf(err, "generated invalid source\n%s", source.String())
+	}
+
+	return formatted, nil
+}
+
+func (b *binder) inputs(arguments abi.Arguments) []bindArgument {
+	result := make([]bindArgument, len(arguments))
+	used := make(map[string]bool)
+
+	for i, argument := range arguments {
+		name := parameterName(argument.Name, i)
+		for used[name] {
+			name += "_"
+		}
+		used[name] = true
+
+		result[i] = bindArgument{Name: name, RawName: argument.Name, Type: b.goType(argument.Type)}
+	}
+
+	return result
+}
+
+func (b *binder) outputs(arguments abi.Arguments) []bindArgument {
+	result := make([]bindArgument, len(arguments))
+	for i, argument := range arguments {
+		result[i] = bindArgument{Field: fieldName(argument.Name, i), RawName: argument.Name, Type: b.goType(argument.Type)}
+	}
+
+	return result
+}
+
+func (b *binder) eventFields(arguments abi.Arguments) []bindArgument {
+	result := make([]bindArgument, len(arguments))
+	for i, argument := range arguments {
+		typ := b.goType(argument.Type)
+		// the topics of indexed dynamic values only hold their hash
+		if argument.Indexed && isDynamicOrComposite(argument.Type) {
+			typ = "common.Hash"
+		}
+
+		result[i] = bindArgument{Field: fieldName(argument.Name, i), RawName: argument.Name, Type: typ}
+	}
+
+	return result
+}
+
+func isDynamicOrComposite(typ abi.Type) bool {
+	switch typ.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+		return true
+	}
+
+	return false
+}
+
+// goType returns the Go type go-ethereum encodes and decodes the ABI type as
+func (b *binder) goType(typ abi.Type) string {
+	switch typ.T {
+	case abi.IntTy, abi.UintTy:
+		prefix := "int"
+		if typ.T == abi.UintTy {
+			prefix = "uint"
+		}
+
+		switch typ.Size {
+		case 8, 16, 32, 64:
+			return fmt.Sprintf("%s%d", prefix, typ.Size)
+		}
+
+		return "*big.Int"
+	case abi.BoolTy:
+		return "bool"
+	case abi.StringTy:
+		return "string"
+	case abi.AddressTy:
+		return "common.Address"
+	case abi.HashTy:
+		return "common.Hash"
+	case abi.BytesTy:
+		return "[]byte"
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", typ.Size)
+	case abi.FunctionTy:
+		return "[24]byte"
+	case abi.SliceTy:
+		return "[]" + b.goType(*typ.Elem)
+	case abi.ArrayTy:
+		return fmt.Sprintf("[%d]%s", typ.Size, b.goType(*typ.Elem))
+	case abi.TupleTy:
+		return b.tupleType(typ)
+	}
+
+	return "interface{}"
+}
+
+func (b *binder) tupleType(typ abi.Type) string {
+	key := typ.TupleRawName + typ.String()
+	if existing, ok := b.structs[key]; ok {
+		return existing.Name
+	}
+
+	name := exportedName(typ.TupleRawName)
+	if name == "" {
+		name = fmt.Sprintf("%sTuple%d", b.typeName, len(b.order))
+	}
+
+	bound := &bindStruct{Name: name}
+	b.structs[key] = bound
+	b.order = append(b.order, key)
+
+	for i, elem := range typ.TupleElems {
+		bound.Fields = append(bound.Fields, bindArgument{
+			Field:   fieldName(typ.TupleRawNames[i], i),
+			RawName: typ.TupleRawNames[i],
+			Type:    b.goType(*elem),
+		})
+	}
+
+	return name
+}
+
+// fieldName returns the name go-ethereum expects for the struct field holding the named value
+func fieldName(name string, index int) string {
+	if name == "" {
+		return fmt.Sprintf("Arg%d", index)
+	}
+
+	return abi.ToCamelCase(name)
+}
+
+func parameterName(name string, index int) string {
+	name = exportedName(name)
+	if name == "" {
+		return fmt.Sprintf("arg%d", index)
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	name = string(runes)
+
+	if token.Lookup(name).IsKeyword() || reservedNames[name] {
+		name += "_"
+	}
+
+	return name
+}
+
+func exportedName(name string) string {
+	name = strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return -1
+	}, name)
+
+	return strings.TrimLeft(abi.ToCamelCase(name), "0123456789_")
+}
+
+func joinArguments(arguments []bindArgument, withTypes bool) string {
+	parts := make([]string, len(arguments))
+	for i, argument := range arguments {
+		parts[i] = argument.Name
+		if withTypes {
+			parts[i] += " " + argument.Type
+		}
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+var bindTemplate = template.Must(template.New("bind").Funcs(template.FuncMap{
+	"params": func(arguments []bindArgument) string { return joinArguments(arguments, true) },
+	"args": func(arguments []bindArgument) string {
+		if len(arguments) == 0 {
+			return ""
+		}
+		return ", " + joinArguments(arguments, false)
+	},
+	"backquote": func(s string) string { return "`" + strings.ReplaceAll(s, "`", "` + \"`\" + `") + "`" },
+}).Parse(bindSource))
+
+const bindSource = `// Code generated by hedera-abigen. DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"math/big"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ = big.NewInt
+	_ = abi.ConvertType
+	_ = common.BytesToAddress
+)
+
+// {{.Type}}ABI is the ABI of {{.Type}}
+const {{.Type}}ABI = {{backquote .ABI}}
+{{if .Bytecode}}
+// {{.Type}}Bytecode is the hex encoded bytecode deployed by Deploy{{.Type}}
+const {{.Type}}Bytecode = "{{.Bytecode}}"
+{{end}}
+{{range .Structs}}
+// {{.Name}} is a tuple of the {{$.Type}} ABI
+type {{.Name}} struct {
+{{- range .Fields}}
+	{{.Field}} {{.Type}}
+{{- end}}
+}
+{{end}}
+// {{.Type}} builds the calls to a deployed {{.Type}} contract and decodes their results and events
+type {{.Type}} struct {
+	ContractID hedera.ContractID
+	abi        *hedera.ContractABI
+}
+
+// New{{.Type}} returns the bindings of the {{.Type}} contract deployed as contractID
+func New{{.Type}}(contractID hedera.ContractID) (*{{.Type}}, error) {
+	contractABI, err := hedera.ContractABIFromJSON([]byte({{.Type}}ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	return &{{.Type}}{ContractID: contractID, abi: contractABI}, nil
+}
+
+// ABI returns the parsed ABI of the contract
+func (contract *{{.Type}}) ABI() *hedera.ContractABI {
+	return contract.abi
+}
+{{if .Bytecode}}
+// Deploy{{.Type}} returns a ContractCreateFlow deploying {{.Type}} with the given constructor arguments
+func Deploy{{.Type}}({{params .Constructor}}) (*hedera.ContractCreateFlow, error) {
+	contractABI, err := hedera.ContractABIFromJSON([]byte({{.Type}}ABI))
+	if err != nil {
+		return nil, err
+	}
+
+	return hedera.NewContractCreateFlow().
+		SetBytecodeWithString({{.Type}}Bytecode).
+		SetConstructorParametersWithABI(contractABI{{args .Constructor}})
+}
+{{end}}
+{{- range .Methods}}
+{{- if .Constant}}
+// {{.Name}} returns a ContractCallQuery calling {{.Sig}}
+func (contract *{{$.Type}}) {{.Name}}({{params .Inputs}}) (*hedera.ContractCallQuery, error) {
+	return hedera.NewContractCallQuery().
+		SetContractID(contract.ContractID).
+		SetFunctionWithABI(contract.abi, "{{.RawName}}"{{args .Inputs}})
+}
+{{- else}}
+// {{.Name}} returns a ContractExecuteTransaction calling {{.Sig}}
+func (contract *{{$.Type}}) {{.Name}}({{params .Inputs}}) (*hedera.ContractExecuteTransaction, error) {
+	return hedera.NewContractExecuteTransaction().
+		SetContractID(contract.ContractID).
+		SetFunctionWithABI(contract.abi, "{{.RawName}}"{{args .Inputs}})
+}
+{{- end}}
+{{if eq (len .Outputs) 1}}
+// Decode{{.Name}} decodes the result of {{.Sig}}
+func (contract *{{$.Type}}) Decode{{.Name}}(result hedera.ContractFunctionResult) ({{(index .Outputs 0).Type}}, error) {
+	var out {{(index .Outputs 0).Type}}
+
+	values, err := result.Unpack(contract.abi, "{{.RawName}}")
+	if err != nil {
+		return out, err
+	}
+
+	out = *abi.ConvertType(values[0], new({{(index .Outputs 0).Type}})).(*{{(index .Outputs 0).Type}})
+	return out, nil
+}
+{{else if .Outputs}}
+// {{$.Type}}{{.Name}}Output holds the outputs of {{.Sig}}
+type {{$.Type}}{{.Name}}Output struct {
+{{- range .Outputs}}
+	{{.Field}} {{.Type}}
+{{- end}}
+}
+
+// Decode{{.Name}} decodes the result of {{.Sig}}
+func (contract *{{$.Type}}) Decode{{.Name}}(result hedera.ContractFunctionResult) ({{$.Type}}{{.Name}}Output, error) {
+	var out {{$.Type}}{{.Name}}Output
+
+	values, err := result.Unpack(contract.abi, "{{.RawName}}")
+	if err != nil {
+		return out, err
+	}
+{{range $i, $output := .Outputs}}
+	out.{{$output.Field}} = *abi.ConvertType(values[{{$i}}], new({{$output.Type}})).(*{{$output.Type}})
+{{- end}}
+	return out, nil
+}
+{{end}}
+{{- end}}
+{{- range .Events}}
+// {{$.Type}}{{.Name}} is a {{.Sig}} event emitted by {{$.Type}}
+type {{$.Type}}{{.Name}} struct {
+{{- range .Fields}}
+	{{.Field}} {{.Type}}
+{{- end}}
+	Raw hedera.ContractLogInfo
+}
+
+// Parse{{.Name}} decodes a {{.Sig}} event from log
+func (contract *{{$.Type}}) Parse{{.Name}}(log hedera.ContractLogInfo) (*{{$.Type}}{{.Name}}, error) {
+	event, err := contract.abi.Event("{{.RawName}}")
+	if err != nil {
+		return nil, err
+	}
+
+	values, err := event.Decode(log)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &{{$.Type}}{{.Name}}{Raw: log}
+{{- range .Fields}}
+	out.{{.Field}} = *abi.ConvertType(values["{{.RawName}}"], new({{.Type}})).(*{{.Type}})
+{{- end}}
+	return out, nil
+}
+
+// Filter{{.Name}} decodes the {{.Sig}} events among logs, skipping the other logs
+func (contract *{{$.Type}}) Filter{{.Name}}(logs []hedera.ContractLogInfo) ([]*{{$.Type}}{{.Name}}, error) {
+	event, err := contract.abi.Event("{{.RawName}}")
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*{{$.Type}}{{.Name}}
+	for _, log := range event.Filter(logs) {
+		decoded, err := contract.Parse{{.Name}}(log)
+		if err != nil {
+			return nil, err
+		}
+
+		out = append(out, decoded)
+	}
+
+	return out, nil
+}
+{{end}}`
@@ -0,0 +1,228 @@
+//go:build all || unit
+// +build all unit
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testBindABI = `[
+	{"type":"constructor","inputs":[{"name":"name","type":"string"},{"name":"decimals","type":"uint8"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"}],
+		"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"echo","stateMutability":"pure",
+		"inputs":[
+			{"name":"order","type":"tuple","internalType":"struct Token.Order","components":[{"name":"amount","type":"uint256"},{"name":"recipients","type":"address[]"}]},
+			{"name":"type","type":"int24[2]"}],
+		"outputs":[
+			{"name":"order","type":"tuple","internalType":"struct Token.Order","components":[{"name":"amount","type":"uint256"},{"name":"recipients","type":"address[]"}]},
+			{"name":"","type":"bytes32"}]},
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Renamed","anonymous":false,"inputs":[
+		{"name":"name","type":"string","indexed":true}]}
+]`
+
+func _BindTestABI(t *testing.T, bytecode string) string {
+	source, err := Bind([]byte(testBindABI), bytecode, "token", "Token")
+	require.NoError(t, err)
+
+	_, err = parser.ParseFile(token.NewFileSet(), "token.go", source, parser.AllErrors)
+	require.NoError(t, err)
+
+	return string(source)
+}
+
+func TestUnitBindMethods(t *testing.T) {
+	source := _BindTestABI(t, "")
+
+	assert.Contains(t, source, "func NewToken(contractID hedera.ContractID) (*Token, error)")
+	assert.Contains(t, source, "func (contract *Token) Transfer(to common.Address, amount *big.Int) (*hedera.ContractExecuteTransaction, error)")
+	assert.Contains(t, source, "func (contract *Token) DecodeTransfer(result hedera.ContractFunctionResult) (bool, error)")
+	assert.Contains(t, source, "func (contract *Token) BalanceOf(owner common.Address) (*hedera.ContractCallQuery, error)")
+	assert.Contains(t, source, "func (contract *Token) DecodeBalanceOf(result hedera.ContractFunctionResult) (*big.Int, error)")
+	assert.Contains(t, source, "func (contract *Token) Echo(order TokenOrder, type_ [2]*big.Int) (*hedera.ContractCallQuery, error)")
+	assert.Contains(t, source, "func (contract *Token) DecodeEcho(result hedera.ContractFunctionResult) (TokenEchoOutput, error)")
+	assert.Regexp(t, `type TokenOrder struct \{\s+Amount\s+\*big\.Int\s+Recipients\s+\[\]common\.Address\s+\}`, source)
+	assert.Regexp(t, `type TokenEchoOutput struct \{\s+Order\s+TokenOrder\s+Arg1\s+\[32\]byte\s+\}`, source)
+	assert.NotContains(t, source, "DeployToken")
+}
+
+func TestUnitBindEvents(t *testing.T) {
+	source := _BindTestABI(t, "")
+
+	assert.Regexp(t, `type TokenTransfer struct \{\s+From\s+common\.Address\s+To\s+common\.Address\s+Value\s+\*big\.Int\s+Raw\s+hedera\.ContractLogInfo\s+\}`, source)
+	assert.Contains(t, source, "func (contract *Token) ParseTransfer(log hedera.ContractLogInfo) (*TokenTransfer, error)")
+	assert.Contains(t, source, "func (contract *Token) FilterTransfer(logs []hedera.ContractLogInfo) ([]*TokenTransfer, error)")
+	// indexed strings are only available as their hash
+	assert.Regexp(t, `type TokenRenamed struct \{\s+Name\s+common\.Hash`, source)
+}
+
+func TestUnitBindDeploy(t *testing.T) {
+	source := _BindTestABI(t, "0x6080604052\n")
+
+	assert.Contains(t, source, `const TokenBytecode = "6080604052"`)
+	assert.Contains(t, source, "func DeployToken(name string, decimals uint8) (*hedera.ContractCreateFlow, error)")
+	assert.Contains(t, source, "SetConstructorParametersWithABI(contractABI, name, decimals)")
+}
+
+func TestUnitBindInvalid(t *testing.T) {
+	_, err := Bind([]byte("{"), "", "token", "Token")
+	assert.Error(t, err)
+
+	_, err = Bind([]byte(testBindABI), "60zz", "token", "Token")
+	assert.Error(t, err)
+
+	_, err = Bind([]byte(testBindABI), "", "token", "token")
+	assert.Error(t, err)
+
+	_, err = Bind([]byte(testBindABI), "", "to-ken", "Token")
+	assert.Error(t, err)
+}
+
+// the decoding of a balanceOf result, an echo result and a Transfer log, as a node returns them, through the bindings
+const testBindMain = `package main
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func main() {
+	contract, err := NewToken(hedera.ContractID{Contract: 1001})
+	if err != nil {
+		panic(err)
+	}
+
+	parsed, err := abi.JSON(strings.NewReader(TokenABI))
+	if err != nil {
+		panic(err)
+	}
+
+	from := common.HexToAddress("0x00000000000000000000000000000000000003e9")
+	to := common.HexToAddress("0x00000000000000000000000000000000000003ea")
+
+	balanceOf := _Result(common.LeftPadBytes(big.NewInt(1234).Bytes(), 32), nil)
+	balance, err := contract.DecodeBalanceOf(balanceOf)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(balance)
+
+	echoOutput, err := parsed.Methods["echo"].Outputs.Pack(TokenOrder{Amount: big.NewInt(7), Recipients: []common.Address{from, to}}, [32]byte{1})
+	if err != nil {
+		panic(err)
+	}
+	echo, err := contract.DecodeEcho(_Result(echoOutput, nil))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(echo.Order.Amount, echo.Order.Recipients[1].Hex(), echo.Arg1[0])
+
+	transferLog := &services.ContractLoginfo{
+		ContractID: &services.ContractID{Contract: &services.ContractID_ContractNum{ContractNum: 1001}},
+		Topic: [][]byte{
+			crypto.Keccak256([]byte("Transfer(address,address,uint256)")),
+			common.LeftPadBytes(from.Bytes(), 32),
+			common.LeftPadBytes(to.Bytes(), 32),
+		},
+		Data: common.LeftPadBytes(big.NewInt(99).Bytes(), 32),
+	}
+	transfers, err := contract.FilterTransfer(_Result(nil, transferLog).LogInfo)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(len(transfers), transfers[0].From.Hex(), transfers[0].To.Hex(), transfers[0].Value)
+}
+
+func _Result(callResult []byte, log *services.ContractLoginfo) hedera.ContractFunctionResult {
+	pb := &services.ContractFunctionResult{
+		ContractID:         &services.ContractID{Contract: &services.ContractID_ContractNum{ContractNum: 1001}},
+		ContractCallResult: callResult,
+	}
+	if log != nil {
+		pb.LogInfo = []*services.ContractLoginfo{log}
+	}
+
+	data, err := protobuf.Marshal(pb)
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := hedera.ContractFunctionResultFromBytes(data)
+	if err != nil {
+		panic(err)
+	}
+
+	return result
+}
+`
+
+// _WriteTestPackage writes the files into a new package of the module, under testdata so ./... skips it, for the go
+// command to build
+func _WriteTestPackage(t *testing.T, files map[string][]byte) string {
+	if _, err := exec.LookPath("go"); err != nil {
+		t.Skip("the go command isn't available")
+	}
+
+	require.NoError(t, os.MkdirAll("testdata", 0755))
+	dir, err := ioutil.TempDir("testdata", "bind")
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		_ = os.RemoveAll(dir)
+		_ = os.Remove("testdata")
+	})
+
+	for name, source := range files {
+		require.NoError(t, ioutil.WriteFile(filepath.Join(dir, name), source, 0644))
+	}
+
+	return "./" + filepath.ToSlash(dir)
+}
+
+func TestUnitBindTypeChecks(t *testing.T) {
+	source, err := Bind([]byte(testBindABI), "6080604052", "token", "Token")
+	require.NoError(t, err)
+
+	pkg := _WriteTestPackage(t, map[string][]byte{"token.go": source})
+
+	output, err := exec.Command("go", "vet", pkg).CombinedOutput()
+	require.NoError(t, err, string(output))
+}
+
+func TestUnitBindDecodesResult(t *testing.T) {
+	source, err := Bind([]byte(testBindABI), "", "main", "Token")
+	require.NoError(t, err)
+
+	pkg := _WriteTestPackage(t, map[string][]byte{"token.go": source, "main.go": []byte(testBindMain)})
+
+	output, err := exec.Command("go", "run", pkg).CombinedOutput()
+	require.NoError(t, err, string(output))
+	assert.Equal(t, "1234\n"+
+		"7 0x00000000000000000000000000000000000003EA 1\n"+
+		"1 0x00000000000000000000000000000000000003e9 0x00000000000000000000000000000000000003EA 99\n", string(output))
+}
@@ -0,0 +1,69 @@
+// Command hedera-abigen generates a Go package with typed bindings for a Solidity contract, from its ABI and,
+// optionally, its bytecode:
+//
+//	hedera-abigen -abi Token.abi -bin Token.bin -pkg token -type Token -out token.go
+//
+// The bindings build ContractExecuteTransactions and ContractCallQuerys calling the contract's functions, decode
+// their ContractFunctionResults and the contract's events, and, given the bytecode, deploy the contract with a
+// ContractCreateFlow.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+func main() {
+	abiPath := flag.String("abi", "", "path to the Solidity ABI JSON of the contract")
+	binPath := flag.String("bin", "", "path to the hex encoded bytecode of the contract, generating a deploy helper")
+	pkg := flag.String("pkg", "", "name of the generated package")
+	typeName := flag.String("type", "", "name of the generated binding type, defaulting to the ABI file name")
+	out := flag.String("out", "", "path of the generated file, defaulting to stdout")
+	flag.Parse()
+
+	if err := run(*abiPath, *binPath, *pkg, *typeName, *out); err != nil {
+		fmt.Fprintln(os.Stderr, "hedera-abigen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(abiPath string, binPath string, pkg string, typeName string, out string) error {
+	if abiPath == "" || pkg == "" {
+		flag.Usage()
+		return errors.New("-abi and -pkg are required")
+	}
+
+	abiJSON, err := ioutil.ReadFile(abiPath)
+	if err != nil {
+		return err
+	}
+
+	var bytecode []byte
+	if binPath != "" {
+		if bytecode, err = ioutil.ReadFile(binPath); err != nil {
+			return err
+		}
+	}
+
+	if typeName == "" {
+		typeName = exportedName(strings.TrimSuffix(filepath.Base(abiPath), filepath.Ext(abiPath)))
+	}
+
+	source, err := Bind(abiJSON, string(bytecode), pkg, typeName)
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(source)
+		return err
+	}
+
+	return ioutil.WriteFile(out, source, 0644)
+}
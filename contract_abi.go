@@ -0,0 +1,229 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ContractABI is a Solidity contract ABI, loaded from the JSON produced by the Solidity compiler. It encodes function
+// parameters and decodes results by function name, instead of building them argument by argument with
+// ContractFunctionParameters and ContractFunctionResult.
+//
+// Arguments use the Go types of go-ethereum's ABI encoder: *big.Int for integers wider than 64 bits, structs for
+// tuples and slices or arrays for Solidity arrays. For convenience, address arguments may also be given as an
+// AccountID, ContractID or hex encoded EVM address, and integer arguments as any Go integer that fits.
+type ContractABI struct {
+	abi abi.ABI
+}
+
+// ContractABIFromJSON parses the JSON ABI of a contract
+func ContractABIFromJSON(data []byte) (*ContractABI, error) {
+	parsed, err := abi.JSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ContractABI{abi: parsed}, nil
+}
+
+// Pack encodes a call to the named function: its selector followed by its arguments. An empty name encodes the
+// arguments of the constructor, without a selector.
+func (contractABI *ContractABI) Pack(name string, args ...interface{}) ([]byte, error) {
+	inputs := contractABI.abi.Constructor.Inputs
+	if name != "" {
+		method, ok := contractABI.abi.Methods[name]
+		if !ok {
+			return nil, errors.Wrapf(errAbiMethodNotFound, "%q", name)
+		}
+
+		inputs = method.Inputs
+	}
+
+	converted := make([]interface{}, len(args))
+	for i, arg := range args {
+		converted[i] = arg
+		if i < len(inputs) {
+			value, err := _AbiConvertArgument(inputs[i].Type, arg)
+			if err != nil {
+				return nil, errors.Wrapf(err, "argument %d of %q", i, name)
+			}
+
+			converted[i] = value
+		}
+	}
+
+	return contractABI.abi.Pack(name, converted...)
+}
+
+// Unpack decodes the outputs of the named function
+func (contractABI *ContractABI) Unpack(name string, data []byte) ([]interface{}, error) {
+	return contractABI.abi.Unpack(name, data)
+}
+
+// UnpackInto decodes the outputs of the named function into v, a pointer to a struct with a field per output or,
+// for functions with a single output, a pointer to a value of its type
+func (contractABI *ContractABI) UnpackInto(v interface{}, name string, data []byte) error {
+	return contractABI.abi.UnpackIntoInterface(v, name, data)
+}
+
+func _AbiConvertArgument(abiType abi.Type, arg interface{}) (interface{}, error) {
+	switch abiType.T {
+	case abi.AddressTy:
+		return _AbiAddress(arg)
+	case abi.IntTy, abi.UintTy:
+		return _AbiInteger(abiType, arg)
+	case abi.SliceTy, abi.ArrayTy:
+		return _AbiArray(abiType, arg)
+	}
+
+	return arg, nil
+}
+
+func _AbiAddress(arg interface{}) (interface{}, error) {
+	switch address := arg.(type) {
+	case AccountID:
+		if address.AliasEvmAddress != nil {
+			return common.BytesToAddress(*address.AliasEvmAddress), nil
+		}
+
+		return common.HexToAddress(address.ToSolidityAddress()), nil
+	case *AccountID:
+		return _AbiAddress(*address)
+	case ContractID:
+		if address.EvmAddress != nil {
+			return common.BytesToAddress(address.EvmAddress), nil
+		}
+
+		return common.HexToAddress(address.ToSolidityAddress()), nil
+	case *ContractID:
+		return _AbiAddress(*address)
+	case string:
+		evmAddress, err := _EvmAddressFromString(address)
+		if err != nil {
+			return nil, err
+		}
+
+		return common.BytesToAddress(evmAddress), nil
+	case []byte:
+		if len(address) != 20 {
+			return nil, errors.Wrapf(errInvalidEvmAddress, "%q", hex.EncodeToString(address))
+		}
+
+		return common.BytesToAddress(address), nil
+	}
+
+	return arg, nil
+}
+
+var _AbiBigIntType = reflect.TypeOf((*big.Int)(nil))
+
+func _AbiInteger(abiType abi.Type, arg interface{}) (interface{}, error) {
+	target := abiType.GetType()
+	value := reflect.ValueOf(arg)
+	if !value.IsValid() {
+		return arg, nil
+	}
+
+	var integer *big.Int
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		integer = big.NewInt(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		integer = new(big.Int).SetUint64(value.Uint())
+	default:
+		if value.Type() != _AbiBigIntType || value.IsNil() {
+			return arg, nil
+		}
+		integer = arg.(*big.Int)
+	}
+
+	if !_AbiIntegerFits(abiType, integer) {
+		return nil, errors.Wrapf(errAbiIntegerOverflow, "%v does not fit in %v", integer, abiType)
+	}
+
+	if value.Type() == target {
+		return arg, nil
+	}
+
+	if target == _AbiBigIntType {
+		return integer, nil
+	}
+
+	result := reflect.New(target).Elem()
+	if abiType.T == abi.IntTy {
+		result.SetInt(integer.Int64())
+	} else {
+		result.SetUint(integer.Uint64())
+	}
+
+	return result.Interface(), nil
+}
+
+func _AbiIntegerFits(abiType abi.Type, integer *big.Int) bool {
+	return _IntegerFits(abiType.Size, abiType.T == abi.IntTy, integer)
+}
+
+func _AbiArray(abiType abi.Type, arg interface{}) (interface{}, error) {
+	value := reflect.ValueOf(arg)
+	if !value.IsValid() || (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) {
+		return arg, nil
+	}
+
+	target := abiType.GetType()
+	if value.Type() == target {
+		return arg, nil
+	}
+
+	var result reflect.Value
+	if abiType.T == abi.SliceTy {
+		result = reflect.MakeSlice(target, value.Len(), value.Len())
+	} else {
+		if value.Len() != abiType.Size {
+			return arg, nil
+		}
+		result = reflect.New(target).Elem()
+	}
+
+	for i := 0; i < value.Len(); i++ {
+		element, err := _AbiConvertArgument(*abiType.Elem, value.Index(i).Interface())
+		if err != nil {
+			return nil, err
+		}
+
+		elementValue := reflect.ValueOf(element)
+		if !elementValue.IsValid() || !elementValue.Type().AssignableTo(target.Elem()) {
+			// leave it to the encoder to report the mismatch
+			return arg, nil
+		}
+
+		result.Index(i).Set(elementValue)
+	}
+
+	return result.Interface(), nil
+}
@@ -0,0 +1,164 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const testContractABI = `[
+	{"type":"constructor","inputs":[{"name":"name","type":"string"},{"name":"decimals","type":"uint8"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable",
+		"inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],
+		"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view",
+		"inputs":[{"name":"owner","type":"address"}],
+		"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"echo","stateMutability":"pure",
+		"inputs":[
+			{"name":"order","type":"tuple","components":[{"name":"amount","type":"uint256"},{"name":"recipients","type":"address[]"}]},
+			{"name":"matrix","type":"int64[][]"}],
+		"outputs":[
+			{"name":"order","type":"tuple","components":[{"name":"amount","type":"uint256"},{"name":"recipients","type":"address[]"}]},
+			{"name":"matrix","type":"int64[][]"}]}
+]`
+
+func _NewTestContractABI(t *testing.T) *ContractABI {
+	contractABI, err := ContractABIFromJSON([]byte(testContractABI))
+	require.NoError(t, err)
+
+	return contractABI
+}
+
+func TestUnitContractABIPack(t *testing.T) {
+	contractABI := _NewTestContractABI(t)
+
+	packed, err := contractABI.Pack("transfer", AccountID{Account: 5}, 100)
+	require.NoError(t, err)
+
+	params, err := NewContractFunctionParameters().AddAddress(AccountID{Account: 5}.ToSolidityAddress())
+	require.NoError(t, err)
+	name := "transfer"
+	expected := params.AddUint256(common.LeftPadBytes(big.NewInt(100).Bytes(), 32))._Build(&name)
+	require.Equal(t, expected, packed)
+
+	evmAddress, err := AccountIDFromEvmAddress(0, 0, "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	require.NoError(t, err)
+	fromAccountID, err := contractABI.Pack("balanceOf", evmAddress)
+	require.NoError(t, err)
+	fromString, err := contractABI.Pack("balanceOf", "0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	require.NoError(t, err)
+	require.Equal(t, fromString, fromAccountID)
+
+	constructor, err := contractABI.Pack("", "token", 18)
+	require.NoError(t, err)
+	require.Len(t, constructor, 3*32+32)
+
+	_, err = contractABI.Pack("", "token", 256)
+	require.Error(t, err)
+
+	_, err = contractABI.Pack("transfer", AccountID{Account: 5}, -1)
+	require.Error(t, err)
+
+	_, err = contractABI.Pack("mint", AccountID{Account: 5})
+	require.Error(t, err)
+}
+
+func TestUnitContractABIUnpack(t *testing.T) {
+	contractABI := _NewTestContractABI(t)
+
+	amount, ok := new(big.Int).SetString("123456789012345678901234567890", 10)
+	require.True(t, ok)
+
+	result := ContractFunctionResult{ContractCallResult: common.LeftPadBytes(amount.Bytes(), 32)}
+
+	values, err := result.Unpack(contractABI, "balanceOf")
+	require.NoError(t, err)
+	require.Len(t, values, 1)
+	require.Equal(t, 0, amount.Cmp(values[0].(*big.Int)))
+
+	var balance *big.Int
+	require.NoError(t, result.UnpackInto(&balance, contractABI, "balanceOf"))
+	require.Equal(t, 0, amount.Cmp(balance))
+}
+
+func TestUnitContractABITuplesAndNestedArrays(t *testing.T) {
+	contractABI := _NewTestContractABI(t)
+
+	type order struct {
+		Amount     *big.Int
+		Recipients []common.Address
+	}
+
+	input := order{
+		Amount:     big.NewInt(42),
+		Recipients: []common.Address{common.HexToAddress("0x0000000000000000000000000000000000000005")},
+	}
+	matrix := [][]int{{1, -2}, {}, {3}}
+
+	packed, err := contractABI.Pack("echo", input, matrix)
+	require.NoError(t, err)
+
+	// echo returns its arguments, so its result has the same encoding as its parameters
+	var output struct {
+		Order  order
+		Matrix [][]int64
+	}
+	require.NoError(t, contractABI.UnpackInto(&output, "echo", packed[4:]))
+	require.Equal(t, input, output.Order)
+	require.Equal(t, [][]int64{{1, -2}, {}, {3}}, output.Matrix)
+}
+
+func TestUnitContractABISetters(t *testing.T) {
+	contractABI := _NewTestContractABI(t)
+
+	expected, err := contractABI.Pack("transfer", AccountID{Account: 5}, 100)
+	require.NoError(t, err)
+
+	transaction, err := NewContractExecuteTransaction().SetFunctionWithABI(contractABI, "transfer", AccountID{Account: 5}, 100)
+	require.NoError(t, err)
+	require.Equal(t, expected, transaction.GetFunctionParameters())
+
+	query, err := NewContractCallQuery().SetFunctionWithABI(contractABI, "transfer", AccountID{Account: 5}, 100)
+	require.NoError(t, err)
+	require.Equal(t, expected, query.GetFunctionParameters())
+
+	constructor, err := contractABI.Pack("", "token", 18)
+	require.NoError(t, err)
+
+	flow, err := NewContractCreateFlow().SetConstructorParametersWithABI(contractABI, "token", 18)
+	require.NoError(t, err)
+	require.Equal(t, constructor, flow.GetConstructorParameters())
+
+	create, err := NewContractCreateTransaction().SetConstructorParametersWithABI(contractABI, "token", 18)
+	require.NoError(t, err)
+	require.Equal(t, constructor, create.GetConstructorParameters())
+
+	_, err = NewContractExecuteTransaction().SetFunctionWithABI(contractABI, "transfer", "not an address", 100)
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -89,7 +90,14 @@ func (query *ContractBytecodeQuery) _Build() *services.Query_ContractGetBytecode
 	return &pb
 }
 
+// GetCost returns the cost of the Query
 func (query *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *ContractBytecodeQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -117,6 +125,7 @@ func (query *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractBytecodeQueryShouldRetry,
@@ -141,8 +150,8 @@ func (query *ContractBytecodeQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _ContractBytecodeQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetContractGetBytecodeResponse().Header.NodeTransactionPrecheckCode))
+func _ContractBytecodeQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetContractGetBytecodeResponse().Header.NodeTransactionPrecheckCode))
 }
 
 func _ContractBytecodeQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -157,7 +166,14 @@ func _ContractBytecodeQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *ContractBytecodeQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]byte, error) {
 	if client == nil || client.operator == nil {
 		return make([]byte, 0), errNoClientProvided
 	}
@@ -183,7 +199,7 @@ func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []byte{}, err
 		}
@@ -223,6 +239,7 @@ func (query *ContractBytecodeQuery) Execute(client *Client) ([]byte, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractBytecodeQueryShouldRetry,
@@ -269,6 +286,12 @@ func (query *ContractBytecodeQuery) SetMaxRetry(count int) *ContractBytecodeQuer
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *ContractBytecodeQuery) SetRetryPolicy(policy RetryPolicy) *ContractBytecodeQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *ContractBytecodeQuery) SetMaxBackoff(max time.Duration) *ContractBytecodeQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -109,6 +110,17 @@ func (query *ContractCallQuery) GetFunctionParameters() []byte {
 	return query.functionParameters
 }
 
+// SetFunctionWithABI sets which function to call and its arguments, encoded with the contract's ABI
+func (query *ContractCallQuery) SetFunctionWithABI(contractABI *ContractABI, name string, args ...interface{}) (*ContractCallQuery, error) {
+	params, err := contractABI.Pack(name, args...)
+	if err != nil {
+		return query, err
+	}
+
+	query.functionParameters = params
+	return query, nil
+}
+
 func (query *ContractCallQuery) _ValidateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -142,7 +154,14 @@ func (query *ContractCallQuery) _Build() *services.Query_ContractCallLocal {
 	return &pb
 }
 
+// GetCost returns the cost of the Query
 func (query *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *ContractCallQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -170,6 +189,7 @@ func (query *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractCallQueryShouldRetry,
@@ -194,13 +214,16 @@ func (query *ContractCallQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _ContractCallQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetContractCallLocal().Header.NodeTransactionPrecheckCode))
+func _ContractCallQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetContractCallLocal().Header.NodeTransactionPrecheckCode))
 }
 
 func _ContractCallQueryMapStatusError(_ interface{}, response interface{}) error {
+	result := response.(*services.Response).GetContractCallLocal().GetFunctionResult()
+
 	return ErrHederaPreCheckStatus{
-		Status: Status(response.(*services.Response).GetContractCallLocal().Header.NodeTransactionPrecheckCode),
+		Status:       Status(response.(*services.Response).GetContractCallLocal().Header.NodeTransactionPrecheckCode),
+		RevertReason: DecodeRevertReason(_RevertData(result.GetErrorMessage(), result.GetContractCallResult())),
 	}
 }
 
@@ -210,7 +233,14 @@ func _ContractCallQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *ContractCallQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractFunctionResult, error) {
 	if client == nil || client.operator == nil {
 		return ContractFunctionResult{}, errNoClientProvided
 	}
@@ -236,7 +266,7 @@ func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult,
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return ContractFunctionResult{}, err
 		}
@@ -274,6 +304,7 @@ func (query *ContractCallQuery) Execute(client *Client) (ContractFunctionResult,
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractCallQueryShouldRetry,
@@ -320,6 +351,12 @@ func (query *ContractCallQuery) SetMaxRetry(count int) *ContractCallQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *ContractCallQuery) SetRetryPolicy(policy RetryPolicy) *ContractCallQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *ContractCallQuery) SetMaxBackoff(max time.Duration) *ContractCallQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"encoding/hex"
 	"time"
 
@@ -144,6 +145,19 @@ func (transaction *ContractCreateFlow) SetConstructorParametersRaw(params []byte
 	return transaction
 }
 
+// SetConstructorParametersWithABI sets the constructor parameters, encoded with the contract's ABI
+func (transaction *ContractCreateFlow) SetConstructorParametersWithABI(contractABI *ContractABI, args ...interface{}) (*ContractCreateFlow, error) {
+	transaction._RequireNotFrozen()
+
+	params, err := contractABI.Pack("", args...)
+	if err != nil {
+		return transaction, err
+	}
+
+	transaction.parameters = params
+	return transaction, nil
+}
+
 func (transaction *ContractCreateFlow) GetConstructorParameters() []byte {
 	return transaction.parameters
 }
@@ -231,16 +245,23 @@ func (transaction *ContractCreateFlow) _CreateTransactionReceiptQuery(response T
 		SetTransactionID(response.TransactionID)
 }
 
+// Execute executes the Transaction with the provided client
 func (transaction *ContractCreateFlow) Execute(client *Client) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *ContractCreateFlow) ExecuteWithContext(ctx context.Context, client *Client) (TransactionResponse, error) {
 	transaction._SplitBytecode()
 
 	fileCreateResponse, err := transaction._CreateFileCreateTransaction(client).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
 	fileCreateReceipt, err := transaction._CreateTransactionReceiptQuery(fileCreateResponse).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -251,24 +272,24 @@ func (transaction *ContractCreateFlow) Execute(client *Client) (TransactionRespo
 
 	if len(transaction.appendBytecode) > 0 {
 		fileAppendResponse, err := transaction._CreateFileAppendTransaction(fileID).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
 		_, err = transaction._CreateTransactionReceiptQuery(fileAppendResponse).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return TransactionResponse{}, err
 		}
 	}
 
 	contractCreateResponse, err := transaction._CreateContractCreateTransaction(fileID).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
 	_, err = transaction._CreateTransactionReceiptQuery(contractCreateResponse).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 	if err != nil {
 		return TransactionResponse{}, err
 	}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -178,6 +179,19 @@ func (transaction *ContractCreateTransaction) SetConstructorParametersRaw(params
 	return transaction
 }
 
+// SetConstructorParametersWithABI sets the constructor parameters, encoded with the contract's ABI
+func (transaction *ContractCreateTransaction) SetConstructorParametersWithABI(contractABI *ContractABI, args ...interface{}) (*ContractCreateTransaction, error) {
+	transaction._RequireNotFrozen()
+
+	params, err := contractABI.Pack("", args...)
+	if err != nil {
+		return transaction, err
+	}
+
+	transaction.parameters = params
+	return transaction, nil
+}
+
 func (transaction *ContractCreateTransaction) GetConstructorParameters() []byte {
 	return transaction.parameters
 }
@@ -345,9 +359,38 @@ func (transaction *ContractCreateTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *ContractCreateTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *ContractCreateTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *ContractCreateTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *ContractCreateTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *ContractCreateTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *ContractCreateTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -374,6 +417,7 @@ func (transaction *ContractCreateTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -430,6 +474,11 @@ func (transaction *ContractCreateTransaction) FreezeWith(client *Client) (*Contr
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *ContractCreateTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *ContractCreateTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -531,6 +580,12 @@ func (transaction *ContractCreateTransaction) AddSignature(publicKey PublicKey,
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *ContractCreateTransaction) SetRetryPolicy(policy RetryPolicy) *ContractCreateTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *ContractCreateTransaction) SetMaxBackoff(max time.Duration) *ContractCreateTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -0,0 +1,256 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// ContractEvent is a Solidity event, taken from a ContractABI or parsed from its signature, which decodes the
+// ContractLogInfo entries emitted for it.
+//
+// Indexed inputs are decoded from the log's topics and the others from its data. Indexed strings, bytes, arrays and
+// tuples are stored in topics as their keccak256 hash, so only that hash, a common.Hash, can be recovered for them.
+type ContractEvent struct {
+	event abi.Event
+	// guessIndexed is set for events parsed from a signature without `indexed` keywords, where the leading inputs
+	// are taken to be indexed, as many as the log has topics
+	guessIndexed bool
+}
+
+// Event returns the named event of the ABI
+func (contractABI *ContractABI) Event(name string) (*ContractEvent, error) {
+	event, ok := contractABI.abi.Events[name]
+	if !ok {
+		return nil, errors.Wrapf(errAbiEventNotFound, "%q", name)
+	}
+
+	return &ContractEvent{event: event}, nil
+}
+
+// DecodeLog finds the event of the ABI which emitted the log and decodes its inputs
+func (contractABI *ContractABI) DecodeLog(log ContractLogInfo) (string, map[string]interface{}, error) {
+	if len(log.Topics) == 0 {
+		return "", nil, errAbiEventNotFound
+	}
+
+	event, err := contractABI.abi.EventByID(common.BytesToHash(log.Topics[0]))
+	if err != nil {
+		return "", nil, errors.Wrapf(errAbiEventNotFound, "topic %x", log.Topics[0])
+	}
+
+	values, err := (&ContractEvent{event: *event}).Decode(log)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return event.Name, values, nil
+}
+
+// ContractEventFromSignature parses an event signature, either the canonical form `Transfer(address,address,uint256)`
+// or a declaration such as `event Transfer(address indexed from, address indexed to, uint256 value)`.
+//
+// Inputs without names are named arg0, arg1, and so on. When no input is marked `indexed`, the leading inputs are
+// taken to be indexed, as many as the log has topics besides the event's own, which is how ERC-20 and ERC-721
+// declare their events. Tuple inputs are not supported.
+func ContractEventFromSignature(signature string) (*ContractEvent, error) {
+	signature = strings.TrimSuffix(strings.TrimSpace(signature), ";")
+	signature = strings.TrimSpace(strings.TrimPrefix(signature, "event "))
+
+	open := strings.Index(signature, "(")
+	if open <= 0 || !strings.HasSuffix(signature, ")") {
+		return nil, errors.Wrapf(errInvalidEventSignature, "%q", signature)
+	}
+
+	name := strings.TrimSpace(signature[:open])
+	params := strings.TrimSpace(signature[open+1 : len(signature)-1])
+
+	inputs := abi.Arguments{}
+	anyIndexed := false
+	if params != "" {
+		for _, param := range strings.Split(params, ",") {
+			fields := strings.Fields(param)
+			if len(fields) == 0 || len(fields) > 3 || strings.ContainsAny(param, "()") {
+				return nil, errors.Wrapf(errInvalidEventSignature, "%q", signature)
+			}
+
+			typ, err := abi.NewType(fields[0], "", nil)
+			if err != nil {
+				return nil, errors.Wrapf(errInvalidEventSignature, "%q: %v", signature, err)
+			}
+
+			input := abi.Argument{Type: typ}
+			rest := fields[1:]
+			if len(rest) > 0 && rest[0] == "indexed" {
+				input.Indexed = true
+				anyIndexed = true
+				rest = rest[1:]
+			}
+			if len(rest) > 1 {
+				return nil, errors.Wrapf(errInvalidEventSignature, "%q", signature)
+			}
+			if len(rest) == 1 {
+				input.Name = rest[0]
+			}
+
+			inputs = append(inputs, input)
+		}
+	}
+
+	return &ContractEvent{
+		event:        abi.NewEvent(name, name, false, inputs),
+		guessIndexed: !anyIndexed,
+	}, nil
+}
+
+// GetName returns the name of the event
+func (event *ContractEvent) GetName() string {
+	return event.event.Name
+}
+
+// GetSignature returns the canonical signature of the event, e.g. `Transfer(address,address,uint256)`
+func (event *ContractEvent) GetSignature() string {
+	return event.event.Sig
+}
+
+// GetTopic returns the keccak256 hash of the event's signature, which its logs carry as their first topic
+func (event *ContractEvent) GetTopic() []byte {
+	return event.event.ID.Bytes()
+}
+
+// Matches reports whether the log was emitted for this event. Anonymous events don't identify their logs, so they
+// never match.
+func (event *ContractEvent) Matches(log ContractLogInfo) bool {
+	return !event.event.Anonymous && len(log.Topics) > 0 && bytes.Equal(log.Topics[0], event.GetTopic())
+}
+
+// Filter returns the logs emitted for this event
+func (event *ContractEvent) Filter(logs []ContractLogInfo) []ContractLogInfo {
+	result := make([]ContractLogInfo, 0)
+	for _, log := range logs {
+		if event.Matches(log) {
+			result = append(result, log)
+		}
+	}
+
+	return result
+}
+
+// Decode decodes the inputs of the event from the log into a map keyed by input name
+func (event *ContractEvent) Decode(log ContractLogInfo) (map[string]interface{}, error) {
+	inputs, topics, err := event._Inputs(log)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]interface{})
+	if err = inputs.NonIndexed().UnpackIntoMap(values, log.Data); err != nil {
+		return nil, err
+	}
+
+	if err = abi.ParseTopicsIntoMap(values, _IndexedArguments(inputs), topics); err != nil {
+		return nil, err
+	}
+
+	return values, nil
+}
+
+// DecodeInto decodes the inputs of the event from the log into v, a pointer to a struct with a field per input named
+// after it in CamelCase
+func (event *ContractEvent) DecodeInto(v interface{}, log ContractLogInfo) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Ptr || target.Elem().Kind() != reflect.Struct {
+		return errors.Errorf("cannot decode event into %T, a pointer to a struct is required", v)
+	}
+
+	values, err := event.Decode(log)
+	if err != nil {
+		return err
+	}
+
+	for name, value := range values {
+		field := target.Elem().FieldByName(abi.ToCamelCase(name))
+		if !field.IsValid() {
+			continue
+		}
+
+		if !reflect.TypeOf(value).AssignableTo(field.Type()) {
+			return errors.Errorf("cannot decode %v of type %T into field of type %v", name, value, field.Type())
+		}
+
+		field.Set(reflect.ValueOf(value))
+	}
+
+	return nil
+}
+
+// _Inputs checks the log belongs to the event and returns the event's inputs, with the indexed ones marked, along
+// with the topics holding them
+func (event *ContractEvent) _Inputs(log ContractLogInfo) (abi.Arguments, []common.Hash, error) {
+	topics := make([]common.Hash, 0, len(log.Topics))
+	for _, topic := range log.Topics {
+		topics = append(topics, common.BytesToHash(topic))
+	}
+
+	if !event.event.Anonymous {
+		if !event.Matches(log) {
+			return nil, nil, errors.Wrapf(errEventLogMismatch, "%v", event.event.Sig)
+		}
+
+		topics = topics[1:]
+	}
+
+	inputs := event.event.Inputs
+	if event.guessIndexed {
+		if len(topics) > len(inputs) {
+			return nil, nil, errors.Wrapf(errEventLogMismatch, "%v has %d topics", event.event.Sig, len(topics))
+		}
+
+		inputs = make(abi.Arguments, len(event.event.Inputs))
+		copy(inputs, event.event.Inputs)
+		for i := range topics {
+			inputs[i].Indexed = true
+		}
+	}
+
+	if len(_IndexedArguments(inputs)) != len(topics) {
+		return nil, nil, errors.Wrapf(errEventLogMismatch, "%v has %d topics", event.event.Sig, len(topics))
+	}
+
+	return inputs, topics, nil
+}
+
+func _IndexedArguments(arguments abi.Arguments) abi.Arguments {
+	indexed := make(abi.Arguments, 0)
+	for _, argument := range arguments {
+		if argument.Indexed {
+			indexed = append(indexed, argument)
+		}
+	}
+
+	return indexed
+}
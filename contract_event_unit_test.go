@@ -0,0 +1,151 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testEventABI = `[
+	{"type":"event","name":"Transfer","anonymous":false,"inputs":[
+		{"name":"from","type":"address","indexed":true},
+		{"name":"to","type":"address","indexed":true},
+		{"name":"value","type":"uint256","indexed":false}]},
+	{"type":"event","name":"Memo","anonymous":false,"inputs":[
+		{"name":"sender","type":"address","indexed":true},
+		{"name":"text","type":"string","indexed":false}]}
+]`
+
+var testEventFrom = common.HexToAddress("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+var testEventTo = common.HexToAddress("0x70997970c51812dc3a010c7d01b50e0d17dc79c8")
+
+func _NewTransferLog(value int64) ContractLogInfo {
+	return ContractLogInfo{
+		ContractID: ContractID{Contract: 1001},
+		Topics: [][]byte{
+			crypto.Keccak256([]byte("Transfer(address,address,uint256)")),
+			common.LeftPadBytes(testEventFrom.Bytes(), 32),
+			common.LeftPadBytes(testEventTo.Bytes(), 32),
+		},
+		Data: common.LeftPadBytes(big.NewInt(value).Bytes(), 32),
+	}
+}
+
+func TestUnitContractEventFromABI(t *testing.T) {
+	contractABI, err := ContractABIFromJSON([]byte(testEventABI))
+	require.NoError(t, err)
+
+	event, err := contractABI.Event("Transfer")
+	require.NoError(t, err)
+	require.Equal(t, "Transfer(address,address,uint256)", event.GetSignature())
+
+	values, err := event.Decode(_NewTransferLog(500))
+	require.NoError(t, err)
+	require.Equal(t, testEventFrom, values["from"])
+	require.Equal(t, testEventTo, values["to"])
+	require.Equal(t, int64(500), values["value"].(*big.Int).Int64())
+
+	var transfer struct {
+		From  common.Address
+		To    common.Address
+		Value *big.Int
+	}
+	require.NoError(t, event.DecodeInto(&transfer, _NewTransferLog(500)))
+	require.Equal(t, testEventFrom, transfer.From)
+	require.Equal(t, testEventTo, transfer.To)
+	require.Equal(t, int64(500), transfer.Value.Int64())
+
+	name, values, err := contractABI.DecodeLog(_NewTransferLog(7))
+	require.NoError(t, err)
+	require.Equal(t, "Transfer", name)
+	require.Equal(t, int64(7), values["value"].(*big.Int).Int64())
+
+	memo, err := contractABI.Event("Memo")
+	require.NoError(t, err)
+	_, err = memo.Decode(_NewTransferLog(7))
+	require.Error(t, err)
+
+	_, err = contractABI.Event("Approval")
+	require.Error(t, err)
+}
+
+func TestUnitContractEventFromSignature(t *testing.T) {
+	for _, signature := range []string{
+		"Transfer(address,address,uint256)",
+		"event Transfer(address indexed from, address indexed to, uint256 value);",
+	} {
+		event, err := ContractEventFromSignature(signature)
+		require.NoError(t, err, signature)
+		require.Equal(t, "Transfer", event.GetName())
+		require.Equal(t, crypto.Keccak256([]byte("Transfer(address,address,uint256)")), event.GetTopic())
+
+		values, err := event.Decode(_NewTransferLog(500))
+		require.NoError(t, err, signature)
+		require.Len(t, values, 3)
+		for _, value := range values {
+			switch v := value.(type) {
+			case common.Address:
+				require.Contains(t, []common.Address{testEventFrom, testEventTo}, v)
+			case *big.Int:
+				require.Equal(t, int64(500), v.Int64())
+			default:
+				t.Fatalf("unexpected value %v", value)
+			}
+		}
+	}
+
+	// an ERC-721 transfer indexes all three inputs
+	event, err := ContractEventFromSignature("Transfer(address,address,uint256)")
+	require.NoError(t, err)
+	log := _NewTransferLog(0)
+	log.Topics = append(log.Topics, common.LeftPadBytes(big.NewInt(42).Bytes(), 32))
+	log.Data = nil
+	values, err := event.Decode(log)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), values["arg2"].(*big.Int).Int64())
+
+	for _, signature := range []string{"Transfer", "(address)", "Transfer(foo)", "Transfer((address,uint256))", "Transfer(address indexed from to)"} {
+		_, err = ContractEventFromSignature(signature)
+		require.Error(t, err, signature)
+	}
+}
+
+func TestUnitContractEventFilter(t *testing.T) {
+	event, err := ContractEventFromSignature("Transfer(address indexed from, address indexed to, uint256 value)")
+	require.NoError(t, err)
+
+	other := ContractLogInfo{Topics: [][]byte{crypto.Keccak256([]byte("Approval(address,address,uint256)"))}}
+	logs := []ContractLogInfo{_NewTransferLog(1), other, {}, _NewTransferLog(2)}
+
+	filtered := event.Filter(logs)
+	require.Len(t, filtered, 2)
+
+	_, err = event.Decode(other)
+	require.Error(t, err)
+}
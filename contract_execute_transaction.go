@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -128,6 +129,19 @@ func (transaction *ContractExecuteTransaction) SetFunction(name string, params *
 	return transaction
 }
 
+// SetFunctionWithABI sets which function to call and its arguments, encoded with the contract's ABI
+func (transaction *ContractExecuteTransaction) SetFunctionWithABI(contractABI *ContractABI, name string, args ...interface{}) (*ContractExecuteTransaction, error) {
+	transaction._RequireNotFrozen()
+
+	params, err := contractABI.Pack(name, args...)
+	if err != nil {
+		return transaction, err
+	}
+
+	transaction.parameters = params
+	return transaction, nil
+}
+
 func (transaction *ContractExecuteTransaction) _ValidateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -246,9 +260,38 @@ func (transaction *ContractExecuteTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *ContractExecuteTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *ContractExecuteTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *ContractExecuteTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *ContractExecuteTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *ContractExecuteTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *ContractExecuteTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -275,6 +318,7 @@ func (transaction *ContractExecuteTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -331,6 +375,11 @@ func (transaction *ContractExecuteTransaction) FreezeWith(client *Client) (*Cont
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *ContractExecuteTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *ContractExecuteTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -432,6 +481,12 @@ func (transaction *ContractExecuteTransaction) AddSignature(publicKey PublicKey,
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *ContractExecuteTransaction) SetRetryPolicy(policy RetryPolicy) *ContractExecuteTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *ContractExecuteTransaction) SetMaxBackoff(max time.Duration) *ContractExecuteTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
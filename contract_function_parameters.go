@@ -23,8 +23,10 @@ package hedera
 import (
 	"encoding/binary"
 	"encoding/hex"
-	"errors"
 	"fmt"
+	"math/big"
+
+	"github.com/pkg/errors"
 )
 
 type ContractFunctionParameters struct {
@@ -789,6 +791,326 @@ func (contract *ContractFunctionParameters) AddUint256(value []byte) *ContractFu
 	return contract
 }
 
+// AddInt8BigInt adds a int8, returning an error if value is nil or doesn't fit in 8 bits
+func (contract *ContractFunctionParameters) AddInt8BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt8, 8, true, value)
+}
+
+// AddInt16BigInt adds a int16, returning an error if value is nil or doesn't fit in 16 bits
+func (contract *ContractFunctionParameters) AddInt16BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt16, 16, true, value)
+}
+
+// AddInt24BigInt adds a int24, returning an error if value is nil or doesn't fit in 24 bits
+func (contract *ContractFunctionParameters) AddInt24BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt24, 24, true, value)
+}
+
+// AddInt32BigInt adds a int32, returning an error if value is nil or doesn't fit in 32 bits
+func (contract *ContractFunctionParameters) AddInt32BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt32, 32, true, value)
+}
+
+// AddInt40BigInt adds a int40, returning an error if value is nil or doesn't fit in 40 bits
+func (contract *ContractFunctionParameters) AddInt40BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt40, 40, true, value)
+}
+
+// AddInt48BigInt adds a int48, returning an error if value is nil or doesn't fit in 48 bits
+func (contract *ContractFunctionParameters) AddInt48BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt48, 48, true, value)
+}
+
+// AddInt56BigInt adds a int56, returning an error if value is nil or doesn't fit in 56 bits
+func (contract *ContractFunctionParameters) AddInt56BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt56, 56, true, value)
+}
+
+// AddInt64BigInt adds a int64, returning an error if value is nil or doesn't fit in 64 bits
+func (contract *ContractFunctionParameters) AddInt64BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt64, 64, true, value)
+}
+
+// AddInt72BigInt adds a int72, returning an error if value is nil or doesn't fit in 72 bits
+func (contract *ContractFunctionParameters) AddInt72BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt72, 72, true, value)
+}
+
+// AddInt80BigInt adds a int80, returning an error if value is nil or doesn't fit in 80 bits
+func (contract *ContractFunctionParameters) AddInt80BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt80, 80, true, value)
+}
+
+// AddInt88BigInt adds a int88, returning an error if value is nil or doesn't fit in 88 bits
+func (contract *ContractFunctionParameters) AddInt88BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt88, 88, true, value)
+}
+
+// AddInt96BigInt adds a int96, returning an error if value is nil or doesn't fit in 96 bits
+func (contract *ContractFunctionParameters) AddInt96BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt96, 96, true, value)
+}
+
+// AddInt104BigInt adds a int104, returning an error if value is nil or doesn't fit in 104 bits
+func (contract *ContractFunctionParameters) AddInt104BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt104, 104, true, value)
+}
+
+// AddInt112BigInt adds a int112, returning an error if value is nil or doesn't fit in 112 bits
+func (contract *ContractFunctionParameters) AddInt112BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt112, 112, true, value)
+}
+
+// AddInt120BigInt adds a int120, returning an error if value is nil or doesn't fit in 120 bits
+func (contract *ContractFunctionParameters) AddInt120BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt120, 120, true, value)
+}
+
+// AddInt128BigInt adds a int128, returning an error if value is nil or doesn't fit in 128 bits
+func (contract *ContractFunctionParameters) AddInt128BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt128, 128, true, value)
+}
+
+// AddInt136BigInt adds a int136, returning an error if value is nil or doesn't fit in 136 bits
+func (contract *ContractFunctionParameters) AddInt136BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt136, 136, true, value)
+}
+
+// AddInt144BigInt adds a int144, returning an error if value is nil or doesn't fit in 144 bits
+func (contract *ContractFunctionParameters) AddInt144BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt144, 144, true, value)
+}
+
+// AddInt152BigInt adds a int152, returning an error if value is nil or doesn't fit in 152 bits
+func (contract *ContractFunctionParameters) AddInt152BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt152, 152, true, value)
+}
+
+// AddInt160BigInt adds a int160, returning an error if value is nil or doesn't fit in 160 bits
+func (contract *ContractFunctionParameters) AddInt160BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt160, 160, true, value)
+}
+
+// AddInt168BigInt adds a int168, returning an error if value is nil or doesn't fit in 168 bits
+func (contract *ContractFunctionParameters) AddInt168BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt168, 168, true, value)
+}
+
+// AddInt176BigInt adds a int176, returning an error if value is nil or doesn't fit in 176 bits
+func (contract *ContractFunctionParameters) AddInt176BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt176, 176, true, value)
+}
+
+// AddInt184BigInt adds a int184, returning an error if value is nil or doesn't fit in 184 bits
+func (contract *ContractFunctionParameters) AddInt184BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt184, 184, true, value)
+}
+
+// AddInt192BigInt adds a int192, returning an error if value is nil or doesn't fit in 192 bits
+func (contract *ContractFunctionParameters) AddInt192BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt192, 192, true, value)
+}
+
+// AddInt200BigInt adds a int200, returning an error if value is nil or doesn't fit in 200 bits
+func (contract *ContractFunctionParameters) AddInt200BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt200, 200, true, value)
+}
+
+// AddInt208BigInt adds a int208, returning an error if value is nil or doesn't fit in 208 bits
+func (contract *ContractFunctionParameters) AddInt208BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt208, 208, true, value)
+}
+
+// AddInt216BigInt adds a int216, returning an error if value is nil or doesn't fit in 216 bits
+func (contract *ContractFunctionParameters) AddInt216BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt216, 216, true, value)
+}
+
+// AddInt224BigInt adds a int224, returning an error if value is nil or doesn't fit in 224 bits
+func (contract *ContractFunctionParameters) AddInt224BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt224, 224, true, value)
+}
+
+// AddInt232BigInt adds a int232, returning an error if value is nil or doesn't fit in 232 bits
+func (contract *ContractFunctionParameters) AddInt232BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt232, 232, true, value)
+}
+
+// AddInt240BigInt adds a int240, returning an error if value is nil or doesn't fit in 240 bits
+func (contract *ContractFunctionParameters) AddInt240BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt240, 240, true, value)
+}
+
+// AddInt248BigInt adds a int248, returning an error if value is nil or doesn't fit in 248 bits
+func (contract *ContractFunctionParameters) AddInt248BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt248, 248, true, value)
+}
+
+// AddInt256BigInt adds a int256, returning an error if value is nil or doesn't fit in 256 bits
+func (contract *ContractFunctionParameters) AddInt256BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aInt256, 256, true, value)
+}
+
+// AddUint8BigInt adds a uint8, returning an error if value is nil or doesn't fit in 8 bits
+func (contract *ContractFunctionParameters) AddUint8BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint8, 8, false, value)
+}
+
+// AddUint16BigInt adds a uint16, returning an error if value is nil or doesn't fit in 16 bits
+func (contract *ContractFunctionParameters) AddUint16BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint16, 16, false, value)
+}
+
+// AddUint24BigInt adds a uint24, returning an error if value is nil or doesn't fit in 24 bits
+func (contract *ContractFunctionParameters) AddUint24BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint24, 24, false, value)
+}
+
+// AddUint32BigInt adds a uint32, returning an error if value is nil or doesn't fit in 32 bits
+func (contract *ContractFunctionParameters) AddUint32BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint32, 32, false, value)
+}
+
+// AddUint40BigInt adds a uint40, returning an error if value is nil or doesn't fit in 40 bits
+func (contract *ContractFunctionParameters) AddUint40BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint40, 40, false, value)
+}
+
+// AddUint48BigInt adds a uint48, returning an error if value is nil or doesn't fit in 48 bits
+func (contract *ContractFunctionParameters) AddUint48BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint48, 48, false, value)
+}
+
+// AddUint56BigInt adds a uint56, returning an error if value is nil or doesn't fit in 56 bits
+func (contract *ContractFunctionParameters) AddUint56BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint56, 56, false, value)
+}
+
+// AddUint64BigInt adds a uint64, returning an error if value is nil or doesn't fit in 64 bits
+func (contract *ContractFunctionParameters) AddUint64BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint64, 64, false, value)
+}
+
+// AddUint72BigInt adds a uint72, returning an error if value is nil or doesn't fit in 72 bits
+func (contract *ContractFunctionParameters) AddUint72BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint72, 72, false, value)
+}
+
+// AddUint80BigInt adds a uint80, returning an error if value is nil or doesn't fit in 80 bits
+func (contract *ContractFunctionParameters) AddUint80BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint80, 80, false, value)
+}
+
+// AddUint88BigInt adds a uint88, returning an error if value is nil or doesn't fit in 88 bits
+func (contract *ContractFunctionParameters) AddUint88BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint88, 88, false, value)
+}
+
+// AddUint96BigInt adds a uint96, returning an error if value is nil or doesn't fit in 96 bits
+func (contract *ContractFunctionParameters) AddUint96BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint96, 96, false, value)
+}
+
+// AddUint104BigInt adds a uint104, returning an error if value is nil or doesn't fit in 104 bits
+func (contract *ContractFunctionParameters) AddUint104BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint104, 104, false, value)
+}
+
+// AddUint112BigInt adds a uint112, returning an error if value is nil or doesn't fit in 112 bits
+func (contract *ContractFunctionParameters) AddUint112BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint112, 112, false, value)
+}
+
+// AddUint120BigInt adds a uint120, returning an error if value is nil or doesn't fit in 120 bits
+func (contract *ContractFunctionParameters) AddUint120BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint120, 120, false, value)
+}
+
+// AddUint128BigInt adds a uint128, returning an error if value is nil or doesn't fit in 128 bits
+func (contract *ContractFunctionParameters) AddUint128BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint128, 128, false, value)
+}
+
+// AddUint136BigInt adds a uint136, returning an error if value is nil or doesn't fit in 136 bits
+func (contract *ContractFunctionParameters) AddUint136BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint136, 136, false, value)
+}
+
+// AddUint144BigInt adds a uint144, returning an error if value is nil or doesn't fit in 144 bits
+func (contract *ContractFunctionParameters) AddUint144BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint144, 144, false, value)
+}
+
+// AddUint152BigInt adds a uint152, returning an error if value is nil or doesn't fit in 152 bits
+func (contract *ContractFunctionParameters) AddUint152BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint152, 152, false, value)
+}
+
+// AddUint160BigInt adds a uint160, returning an error if value is nil or doesn't fit in 160 bits
+func (contract *ContractFunctionParameters) AddUint160BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint160, 160, false, value)
+}
+
+// AddUint168BigInt adds a uint168, returning an error if value is nil or doesn't fit in 168 bits
+func (contract *ContractFunctionParameters) AddUint168BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint168, 168, false, value)
+}
+
+// AddUint176BigInt adds a uint176, returning an error if value is nil or doesn't fit in 176 bits
+func (contract *ContractFunctionParameters) AddUint176BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint176, 176, false, value)
+}
+
+// AddUint184BigInt adds a uint184, returning an error if value is nil or doesn't fit in 184 bits
+func (contract *ContractFunctionParameters) AddUint184BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint184, 184, false, value)
+}
+
+// AddUint192BigInt adds a uint192, returning an error if value is nil or doesn't fit in 192 bits
+func (contract *ContractFunctionParameters) AddUint192BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint192, 192, false, value)
+}
+
+// AddUint200BigInt adds a uint200, returning an error if value is nil or doesn't fit in 200 bits
+func (contract *ContractFunctionParameters) AddUint200BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint200, 200, false, value)
+}
+
+// AddUint208BigInt adds a uint208, returning an error if value is nil or doesn't fit in 208 bits
+func (contract *ContractFunctionParameters) AddUint208BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint208, 208, false, value)
+}
+
+// AddUint216BigInt adds a uint216, returning an error if value is nil or doesn't fit in 216 bits
+func (contract *ContractFunctionParameters) AddUint216BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint216, 216, false, value)
+}
+
+// AddUint224BigInt adds a uint224, returning an error if value is nil or doesn't fit in 224 bits
+func (contract *ContractFunctionParameters) AddUint224BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint224, 224, false, value)
+}
+
+// AddUint232BigInt adds a uint232, returning an error if value is nil or doesn't fit in 232 bits
+func (contract *ContractFunctionParameters) AddUint232BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint232, 232, false, value)
+}
+
+// AddUint240BigInt adds a uint240, returning an error if value is nil or doesn't fit in 240 bits
+func (contract *ContractFunctionParameters) AddUint240BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint240, 240, false, value)
+}
+
+// AddUint248BigInt adds a uint248, returning an error if value is nil or doesn't fit in 248 bits
+func (contract *ContractFunctionParameters) AddUint248BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint248, 248, false, value)
+}
+
+// AddUint256BigInt adds a uint256, returning an error if value is nil or doesn't fit in 256 bits
+func (contract *ContractFunctionParameters) AddUint256BigInt(value *big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigInt(aUint256, 256, false, value)
+}
+
 func (contract *ContractFunctionParameters) AddInt8Array(value []int8) *ContractFunctionParameters {
 	argument := _NewArgument()
 	argument.dynamic = true
@@ -960,6 +1282,326 @@ func (contract *ContractFunctionParameters) AddUint256Array(value [][32]byte) *C
 	return contract
 }
 
+// AddInt8BigIntArray adds a int8[], returning an error if a value is nil or doesn't fit in 8 bits
+func (contract *ContractFunctionParameters) AddInt8BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt8, 8, true, value)
+}
+
+// AddInt16BigIntArray adds a int16[], returning an error if a value is nil or doesn't fit in 16 bits
+func (contract *ContractFunctionParameters) AddInt16BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt16, 16, true, value)
+}
+
+// AddInt24BigIntArray adds a int24[], returning an error if a value is nil or doesn't fit in 24 bits
+func (contract *ContractFunctionParameters) AddInt24BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt24, 24, true, value)
+}
+
+// AddInt32BigIntArray adds a int32[], returning an error if a value is nil or doesn't fit in 32 bits
+func (contract *ContractFunctionParameters) AddInt32BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt32, 32, true, value)
+}
+
+// AddInt40BigIntArray adds a int40[], returning an error if a value is nil or doesn't fit in 40 bits
+func (contract *ContractFunctionParameters) AddInt40BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt40, 40, true, value)
+}
+
+// AddInt48BigIntArray adds a int48[], returning an error if a value is nil or doesn't fit in 48 bits
+func (contract *ContractFunctionParameters) AddInt48BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt48, 48, true, value)
+}
+
+// AddInt56BigIntArray adds a int56[], returning an error if a value is nil or doesn't fit in 56 bits
+func (contract *ContractFunctionParameters) AddInt56BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt56, 56, true, value)
+}
+
+// AddInt64BigIntArray adds a int64[], returning an error if a value is nil or doesn't fit in 64 bits
+func (contract *ContractFunctionParameters) AddInt64BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt64, 64, true, value)
+}
+
+// AddInt72BigIntArray adds a int72[], returning an error if a value is nil or doesn't fit in 72 bits
+func (contract *ContractFunctionParameters) AddInt72BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt72, 72, true, value)
+}
+
+// AddInt80BigIntArray adds a int80[], returning an error if a value is nil or doesn't fit in 80 bits
+func (contract *ContractFunctionParameters) AddInt80BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt80, 80, true, value)
+}
+
+// AddInt88BigIntArray adds a int88[], returning an error if a value is nil or doesn't fit in 88 bits
+func (contract *ContractFunctionParameters) AddInt88BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt88, 88, true, value)
+}
+
+// AddInt96BigIntArray adds a int96[], returning an error if a value is nil or doesn't fit in 96 bits
+func (contract *ContractFunctionParameters) AddInt96BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt96, 96, true, value)
+}
+
+// AddInt104BigIntArray adds a int104[], returning an error if a value is nil or doesn't fit in 104 bits
+func (contract *ContractFunctionParameters) AddInt104BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt104, 104, true, value)
+}
+
+// AddInt112BigIntArray adds a int112[], returning an error if a value is nil or doesn't fit in 112 bits
+func (contract *ContractFunctionParameters) AddInt112BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt112, 112, true, value)
+}
+
+// AddInt120BigIntArray adds a int120[], returning an error if a value is nil or doesn't fit in 120 bits
+func (contract *ContractFunctionParameters) AddInt120BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt120, 120, true, value)
+}
+
+// AddInt128BigIntArray adds a int128[], returning an error if a value is nil or doesn't fit in 128 bits
+func (contract *ContractFunctionParameters) AddInt128BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt128, 128, true, value)
+}
+
+// AddInt136BigIntArray adds a int136[], returning an error if a value is nil or doesn't fit in 136 bits
+func (contract *ContractFunctionParameters) AddInt136BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt136, 136, true, value)
+}
+
+// AddInt144BigIntArray adds a int144[], returning an error if a value is nil or doesn't fit in 144 bits
+func (contract *ContractFunctionParameters) AddInt144BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt144, 144, true, value)
+}
+
+// AddInt152BigIntArray adds a int152[], returning an error if a value is nil or doesn't fit in 152 bits
+func (contract *ContractFunctionParameters) AddInt152BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt152, 152, true, value)
+}
+
+// AddInt160BigIntArray adds a int160[], returning an error if a value is nil or doesn't fit in 160 bits
+func (contract *ContractFunctionParameters) AddInt160BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt160, 160, true, value)
+}
+
+// AddInt168BigIntArray adds a int168[], returning an error if a value is nil or doesn't fit in 168 bits
+func (contract *ContractFunctionParameters) AddInt168BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt168, 168, true, value)
+}
+
+// AddInt176BigIntArray adds a int176[], returning an error if a value is nil or doesn't fit in 176 bits
+func (contract *ContractFunctionParameters) AddInt176BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt176, 176, true, value)
+}
+
+// AddInt184BigIntArray adds a int184[], returning an error if a value is nil or doesn't fit in 184 bits
+func (contract *ContractFunctionParameters) AddInt184BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt184, 184, true, value)
+}
+
+// AddInt192BigIntArray adds a int192[], returning an error if a value is nil or doesn't fit in 192 bits
+func (contract *ContractFunctionParameters) AddInt192BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt192, 192, true, value)
+}
+
+// AddInt200BigIntArray adds a int200[], returning an error if a value is nil or doesn't fit in 200 bits
+func (contract *ContractFunctionParameters) AddInt200BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt200, 200, true, value)
+}
+
+// AddInt208BigIntArray adds a int208[], returning an error if a value is nil or doesn't fit in 208 bits
+func (contract *ContractFunctionParameters) AddInt208BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt208, 208, true, value)
+}
+
+// AddInt216BigIntArray adds a int216[], returning an error if a value is nil or doesn't fit in 216 bits
+func (contract *ContractFunctionParameters) AddInt216BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt216, 216, true, value)
+}
+
+// AddInt224BigIntArray adds a int224[], returning an error if a value is nil or doesn't fit in 224 bits
+func (contract *ContractFunctionParameters) AddInt224BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt224, 224, true, value)
+}
+
+// AddInt232BigIntArray adds a int232[], returning an error if a value is nil or doesn't fit in 232 bits
+func (contract *ContractFunctionParameters) AddInt232BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt232, 232, true, value)
+}
+
+// AddInt240BigIntArray adds a int240[], returning an error if a value is nil or doesn't fit in 240 bits
+func (contract *ContractFunctionParameters) AddInt240BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt240, 240, true, value)
+}
+
+// AddInt248BigIntArray adds a int248[], returning an error if a value is nil or doesn't fit in 248 bits
+func (contract *ContractFunctionParameters) AddInt248BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt248, 248, true, value)
+}
+
+// AddInt256BigIntArray adds a int256[], returning an error if a value is nil or doesn't fit in 256 bits
+func (contract *ContractFunctionParameters) AddInt256BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aInt256, 256, true, value)
+}
+
+// AddUint8BigIntArray adds a uint8[], returning an error if a value is nil or doesn't fit in 8 bits
+func (contract *ContractFunctionParameters) AddUint8BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint8, 8, false, value)
+}
+
+// AddUint16BigIntArray adds a uint16[], returning an error if a value is nil or doesn't fit in 16 bits
+func (contract *ContractFunctionParameters) AddUint16BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint16, 16, false, value)
+}
+
+// AddUint24BigIntArray adds a uint24[], returning an error if a value is nil or doesn't fit in 24 bits
+func (contract *ContractFunctionParameters) AddUint24BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint24, 24, false, value)
+}
+
+// AddUint32BigIntArray adds a uint32[], returning an error if a value is nil or doesn't fit in 32 bits
+func (contract *ContractFunctionParameters) AddUint32BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint32, 32, false, value)
+}
+
+// AddUint40BigIntArray adds a uint40[], returning an error if a value is nil or doesn't fit in 40 bits
+func (contract *ContractFunctionParameters) AddUint40BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint40, 40, false, value)
+}
+
+// AddUint48BigIntArray adds a uint48[], returning an error if a value is nil or doesn't fit in 48 bits
+func (contract *ContractFunctionParameters) AddUint48BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint48, 48, false, value)
+}
+
+// AddUint56BigIntArray adds a uint56[], returning an error if a value is nil or doesn't fit in 56 bits
+func (contract *ContractFunctionParameters) AddUint56BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint56, 56, false, value)
+}
+
+// AddUint64BigIntArray adds a uint64[], returning an error if a value is nil or doesn't fit in 64 bits
+func (contract *ContractFunctionParameters) AddUint64BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint64, 64, false, value)
+}
+
+// AddUint72BigIntArray adds a uint72[], returning an error if a value is nil or doesn't fit in 72 bits
+func (contract *ContractFunctionParameters) AddUint72BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint72, 72, false, value)
+}
+
+// AddUint80BigIntArray adds a uint80[], returning an error if a value is nil or doesn't fit in 80 bits
+func (contract *ContractFunctionParameters) AddUint80BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint80, 80, false, value)
+}
+
+// AddUint88BigIntArray adds a uint88[], returning an error if a value is nil or doesn't fit in 88 bits
+func (contract *ContractFunctionParameters) AddUint88BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint88, 88, false, value)
+}
+
+// AddUint96BigIntArray adds a uint96[], returning an error if a value is nil or doesn't fit in 96 bits
+func (contract *ContractFunctionParameters) AddUint96BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint96, 96, false, value)
+}
+
+// AddUint104BigIntArray adds a uint104[], returning an error if a value is nil or doesn't fit in 104 bits
+func (contract *ContractFunctionParameters) AddUint104BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint104, 104, false, value)
+}
+
+// AddUint112BigIntArray adds a uint112[], returning an error if a value is nil or doesn't fit in 112 bits
+func (contract *ContractFunctionParameters) AddUint112BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint112, 112, false, value)
+}
+
+// AddUint120BigIntArray adds a uint120[], returning an error if a value is nil or doesn't fit in 120 bits
+func (contract *ContractFunctionParameters) AddUint120BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint120, 120, false, value)
+}
+
+// AddUint128BigIntArray adds a uint128[], returning an error if a value is nil or doesn't fit in 128 bits
+func (contract *ContractFunctionParameters) AddUint128BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint128, 128, false, value)
+}
+
+// AddUint136BigIntArray adds a uint136[], returning an error if a value is nil or doesn't fit in 136 bits
+func (contract *ContractFunctionParameters) AddUint136BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint136, 136, false, value)
+}
+
+// AddUint144BigIntArray adds a uint144[], returning an error if a value is nil or doesn't fit in 144 bits
+func (contract *ContractFunctionParameters) AddUint144BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint144, 144, false, value)
+}
+
+// AddUint152BigIntArray adds a uint152[], returning an error if a value is nil or doesn't fit in 152 bits
+func (contract *ContractFunctionParameters) AddUint152BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint152, 152, false, value)
+}
+
+// AddUint160BigIntArray adds a uint160[], returning an error if a value is nil or doesn't fit in 160 bits
+func (contract *ContractFunctionParameters) AddUint160BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint160, 160, false, value)
+}
+
+// AddUint168BigIntArray adds a uint168[], returning an error if a value is nil or doesn't fit in 168 bits
+func (contract *ContractFunctionParameters) AddUint168BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint168, 168, false, value)
+}
+
+// AddUint176BigIntArray adds a uint176[], returning an error if a value is nil or doesn't fit in 176 bits
+func (contract *ContractFunctionParameters) AddUint176BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint176, 176, false, value)
+}
+
+// AddUint184BigIntArray adds a uint184[], returning an error if a value is nil or doesn't fit in 184 bits
+func (contract *ContractFunctionParameters) AddUint184BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint184, 184, false, value)
+}
+
+// AddUint192BigIntArray adds a uint192[], returning an error if a value is nil or doesn't fit in 192 bits
+func (contract *ContractFunctionParameters) AddUint192BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint192, 192, false, value)
+}
+
+// AddUint200BigIntArray adds a uint200[], returning an error if a value is nil or doesn't fit in 200 bits
+func (contract *ContractFunctionParameters) AddUint200BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint200, 200, false, value)
+}
+
+// AddUint208BigIntArray adds a uint208[], returning an error if a value is nil or doesn't fit in 208 bits
+func (contract *ContractFunctionParameters) AddUint208BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint208, 208, false, value)
+}
+
+// AddUint216BigIntArray adds a uint216[], returning an error if a value is nil or doesn't fit in 216 bits
+func (contract *ContractFunctionParameters) AddUint216BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint216, 216, false, value)
+}
+
+// AddUint224BigIntArray adds a uint224[], returning an error if a value is nil or doesn't fit in 224 bits
+func (contract *ContractFunctionParameters) AddUint224BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint224, 224, false, value)
+}
+
+// AddUint232BigIntArray adds a uint232[], returning an error if a value is nil or doesn't fit in 232 bits
+func (contract *ContractFunctionParameters) AddUint232BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint232, 232, false, value)
+}
+
+// AddUint240BigIntArray adds a uint240[], returning an error if a value is nil or doesn't fit in 240 bits
+func (contract *ContractFunctionParameters) AddUint240BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint240, 240, false, value)
+}
+
+// AddUint248BigIntArray adds a uint248[], returning an error if a value is nil or doesn't fit in 248 bits
+func (contract *ContractFunctionParameters) AddUint248BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint248, 248, false, value)
+}
+
+// AddUint256BigIntArray adds a uint256[], returning an error if a value is nil or doesn't fit in 256 bits
+func (contract *ContractFunctionParameters) AddUint256BigIntArray(value []*big.Int) (*ContractFunctionParameters, error) {
+	return contract._AddBigIntArray(aUint256, 256, false, value)
+}
+
 func (contract *ContractFunctionParameters) AddAddressArray(value []string) (*ContractFunctionParameters, error) {
 	argument := _NewArgument()
 	argument.dynamic = true
@@ -1130,6 +1772,93 @@ func (contract *ContractFunctionParameters) _Build(functionName *string) []byte
 	return result
 }
 
+func (contract *ContractFunctionParameters) _AddBigInt(ty argument, bits int, signed bool, value *big.Int) (*ContractFunctionParameters, error) {
+	word, err := _BigIntToWord(ty, bits, signed, value)
+	if err != nil {
+		return contract, err
+	}
+
+	argument := _NewArgument()
+	argument.value = word
+
+	contract.function._AddParam(_Solidity{
+		ty:    ty,
+		array: false,
+	})
+	contract.arguments = append(contract.arguments, argument)
+	return contract, nil
+}
+
+func (contract *ContractFunctionParameters) _AddBigIntArray(ty argument, bits int, signed bool, value []*big.Int) (*ContractFunctionParameters, error) {
+	result := make([]byte, len(value)*32+32)
+
+	binary.BigEndian.PutUint64(result[24:32], uint64(len(value)))
+
+	for i, v := range value {
+		word, err := _BigIntToWord(ty, bits, signed, v)
+		if err != nil {
+			return contract, err
+		}
+
+		copy(result[i*32+32:i*32+32+32], word)
+	}
+
+	argument := _NewArgument()
+	argument.dynamic = true
+	argument.value = result
+
+	contract.function._AddParam(_Solidity{
+		ty:    ty,
+		array: true,
+	})
+	contract.arguments = append(contract.arguments, argument)
+	return contract, nil
+}
+
+var _Two256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// _IntegerFits returns whether value fits in a solidity integer of the given bits
+func _IntegerFits(bits int, signed bool, value *big.Int) bool {
+	if !signed {
+		return value.Sign() >= 0 && value.BitLen() <= bits
+	}
+
+	// a signed integer of n bits holds -2^(n-1) through 2^(n-1)-1
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	return value.Cmp(new(big.Int).Neg(limit)) >= 0 && value.Cmp(limit) < 0
+}
+
+// _BigIntToWord encodes value as a 32 byte word, in two's complement when negative
+func _BigIntToWord(ty argument, bits int, signed bool, value *big.Int) ([]byte, error) {
+	if value == nil {
+		return nil, errors.Errorf("%s value is nil", ty)
+	}
+
+	if !_IntegerFits(bits, signed, value) {
+		return nil, errors.Wrapf(errAbiIntegerOverflow, "%v doesn't fit in %s", value, ty)
+	}
+
+	word := value
+	if value.Sign() < 0 {
+		word = new(big.Int).Add(value, _Two256)
+	}
+
+	bytes := word.Bytes()
+	result := make([]byte, 32)
+	copy(result[32-len(bytes):], bytes)
+	return result, nil
+}
+
+// _BigIntFromWord decodes a 32 byte word, in two's complement when signed
+func _BigIntFromWord(word []byte, signed bool) *big.Int {
+	value := new(big.Int).SetBytes(word)
+	if signed && word[0]&0x80 != 0 {
+		value.Sub(value, _Two256)
+	}
+
+	return value
+}
+
 func _NewArgument() Argument {
 	return Argument{
 		value:   make([]byte, 32),
@@ -0,0 +1,151 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _PackTestArguments(t *testing.T, types []string, values ...interface{}) []byte {
+	arguments := make(abi.Arguments, len(types))
+	for i, typ := range types {
+		abiType, err := abi.NewType(typ, "", nil)
+		require.NoError(t, err)
+		arguments[i] = abi.Argument{Type: abiType}
+	}
+
+	packed, err := arguments.Pack(values...)
+	require.NoError(t, err)
+
+	return packed
+}
+
+func TestUnitContractFunctionParametersBigInt(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	params := NewContractFunctionParameters()
+	_, err := params.AddInt72BigInt(big.NewInt(-1))
+	require.NoError(t, err)
+	_, err = params.AddUint256BigInt(maxUint256)
+	require.NoError(t, err)
+	_, err = params.AddInt8BigInt(big.NewInt(-128))
+	require.NoError(t, err)
+	_, err = params.AddUint40BigInt(big.NewInt(1099511627775))
+	require.NoError(t, err)
+
+	expected := _PackTestArguments(t, []string{"int72", "uint256", "int8", "uint40"},
+		big.NewInt(-1), maxUint256, int8(-128), big.NewInt(1099511627775))
+	assert.Equal(t, hex.EncodeToString(expected), hex.EncodeToString(params._Build(nil)))
+
+	name := "f"
+	assert.Len(t, params._Build(&name), len(expected)+4)
+	assert.Equal(t, "f(int72,uint256,int8,uint40)", params.function.String())
+}
+
+func TestUnitContractFunctionParametersBigIntOutOfRange(t *testing.T) {
+	params := NewContractFunctionParameters()
+
+	_, err := params.AddInt8BigInt(big.NewInt(-129))
+	assert.Error(t, err)
+	_, err = params.AddInt8BigInt(big.NewInt(128))
+	assert.Error(t, err)
+	_, err = params.AddUint8BigInt(big.NewInt(256))
+	assert.Error(t, err)
+	_, err = params.AddUint256BigInt(big.NewInt(-1))
+	assert.Error(t, err)
+	_, err = params.AddUint256BigInt(new(big.Int).Lsh(big.NewInt(1), 256))
+	assert.Error(t, err)
+	_, err = params.AddInt136BigInt(nil)
+	assert.Error(t, err)
+	_, err = params.AddUint64BigIntArray([]*big.Int{big.NewInt(1), big.NewInt(-1)})
+	assert.Error(t, err)
+
+	// nothing was added
+	assert.Empty(t, params.arguments)
+	assert.Equal(t, "()", params.function.String())
+}
+
+func TestUnitContractFunctionParametersBigIntArray(t *testing.T) {
+	params := NewContractFunctionParameters()
+	_, err := params.AddInt24BigIntArray([]*big.Int{big.NewInt(-5), big.NewInt(7)})
+	require.NoError(t, err)
+	params.AddBool(true)
+	_, err = params.AddUint128BigIntArray([]*big.Int{big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 100)})
+	require.NoError(t, err)
+
+	expected := _PackTestArguments(t, []string{"int24[]", "bool", "uint128[]"},
+		[]*big.Int{big.NewInt(-5), big.NewInt(7)}, true, []*big.Int{big.NewInt(1), new(big.Int).Lsh(big.NewInt(1), 100)})
+	assert.Equal(t, hex.EncodeToString(expected), hex.EncodeToString(params._Build(nil)))
+	assert.Equal(t, "(int24[],bool,uint128[])", params.function.String())
+}
+
+func TestUnitContractFunctionResultBigInt(t *testing.T) {
+	maxUint256 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	minInt256 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+
+	result := ContractFunctionResult{
+		ContractCallResult: _PackTestArguments(t, []string{"int72", "uint256", "int256", "int16[]", "uint256[]"},
+			big.NewInt(-42), maxUint256, minInt256, []int16{-1, 300}, []*big.Int{maxUint256, big.NewInt(0)}),
+	}
+
+	assert.Equal(t, big.NewInt(-42), result.GetBigInt(0))
+	assert.Equal(t, maxUint256, result.GetBigUint(1))
+	assert.Equal(t, big.NewInt(-1), result.GetBigInt(1))
+	assert.Equal(t, minInt256, result.GetBigInt(2))
+	assert.Equal(t, []*big.Int{big.NewInt(-1), big.NewInt(300)}, result.GetBigIntArray(3))
+	values := result.GetBigUintArray(4)
+	require.Len(t, values, 2)
+	assert.Equal(t, maxUint256, values[0])
+	assert.Equal(t, 0, values[1].Sign())
+}
+
+func TestUnitContractFunctionResultBigIntArrayOutOfRange(t *testing.T) {
+	maxLength := make([]byte, 64)
+	maxLength[31] = 32
+	for i := 32; i < 64; i++ {
+		maxLength[i] = 0xff
+	}
+
+	// a length which would need far more memory than the result holds panics before allocating
+	assert.PanicsWithValue(t, "array length exceeds the contract call result", func() {
+		ContractFunctionResult{ContractCallResult: maxLength}.GetBigIntArray(0)
+	})
+
+	truncated := _PackTestArguments(t, []string{"uint256[]"}, []*big.Int{big.NewInt(1), big.NewInt(2)})
+	assert.PanicsWithValue(t, "array length exceeds the contract call result", func() {
+		ContractFunctionResult{ContractCallResult: truncated[:len(truncated)-1]}.GetBigUintArray(0)
+	})
+
+	outside := make([]byte, 32)
+	outside[31] = 64
+	assert.PanicsWithValue(t, "array offset is outside the contract call result", func() {
+		ContractFunctionResult{ContractCallResult: outside}.GetBigIntArray(0)
+	})
+}
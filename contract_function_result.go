@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"encoding/binary"
+	"math/big"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	protobuf "google.golang.org/protobuf/proto"
@@ -50,6 +51,9 @@ type ContractFunctionResult struct {
 	GasAvailable         int64
 	Amount               Hbar
 	FunctionParameters   []byte
+	// RevertReason is the decoded reason the call reverted, nil if it didn't revert or returned no revert data.
+	// Custom errors are only decoded by DecodeRevertReason, given the contract's ABI.
+	RevertReason *RevertReason
 }
 
 // GetBool gets a _Solidity bool from the result at the given index
@@ -57,6 +61,23 @@ func (result ContractFunctionResult) GetBool(index uint64) bool {
 	return result.GetUint32(index) == 1
 }
 
+// DecodeRevertReason decodes the reason the call reverted with the contract's ABI, which also covers its custom
+// errors. It returns nil if the call didn't revert or returned no revert data.
+func (result ContractFunctionResult) DecodeRevertReason(contractABI *ContractABI) *RevertReason {
+	return contractABI.DecodeRevertReason(_RevertData(result.ErrorMessage, result.ContractCallResult))
+}
+
+// Unpack decodes the result as the outputs of the named function of contractABI
+func (result ContractFunctionResult) Unpack(contractABI *ContractABI, name string) ([]interface{}, error) {
+	return contractABI.Unpack(name, result.ContractCallResult)
+}
+
+// UnpackInto decodes the result as the outputs of the named function of contractABI into v. See
+// ContractABI.UnpackInto.
+func (result ContractFunctionResult) UnpackInto(v interface{}, contractABI *ContractABI, name string) error {
+	return contractABI.UnpackInto(v, name, result.ContractCallResult)
+}
+
 // GetAddress gets a _Solidity address from the result at the given index
 func (result ContractFunctionResult) GetAddress(index uint64) []byte {
 	return result.ContractCallResult[(index*32)+12 : (index*32)+32]
@@ -326,6 +347,48 @@ func (result ContractFunctionResult) GetUint256(index uint64) []byte {
 	return result.ContractCallResult[index*32 : index*32+32]
 }
 
+// GetBigInt gets a _Solidity intN of any width from the result at the given index
+func (result ContractFunctionResult) GetBigInt(index uint64) *big.Int {
+	return _BigIntFromWord(result.ContractCallResult[index*32:index*32+32], true)
+}
+
+// GetBigUint gets a _Solidity uintN of any width from the result at the given index
+func (result ContractFunctionResult) GetBigUint(index uint64) *big.Int {
+	return _BigIntFromWord(result.ContractCallResult[index*32:index*32+32], false)
+}
+
+// GetBigIntArray gets a _Solidity intN[] of any width from the result at the given index
+func (result ContractFunctionResult) GetBigIntArray(index uint64) []*big.Int {
+	return result._GetBigIntArray(index, true)
+}
+
+// GetBigUintArray gets a _Solidity uintN[] of any width from the result at the given index
+func (result ContractFunctionResult) GetBigUintArray(index uint64) []*big.Int {
+	return result._GetBigIntArray(index, false)
+}
+
+func (result ContractFunctionResult) _GetBigIntArray(index uint64, signed bool) []*big.Int {
+	offset := result.GetUint64(index)
+	size := uint64(len(result.ContractCallResult))
+	if offset > size || size-offset < 32 {
+		panic("array offset is outside the contract call result")
+	}
+
+	// the length comes from the result, so it's checked against what's left before allocating, without overflowing
+	length := binary.BigEndian.Uint64(result.ContractCallResult[offset+24 : offset+32])
+	if length > (size-offset-32)/32 {
+		panic("array length exceeds the contract call result")
+	}
+
+	values := make([]*big.Int, length)
+	for i := range values {
+		start := offset + 32 + uint64(i)*32
+		values[i] = _BigIntFromWord(result.ContractCallResult[start:start+32], signed)
+	}
+
+	return values
+}
+
 // GetBytes32 gets a _Solidity bytes32 from the result at the given index
 func (result ContractFunctionResult) GetBytes32(index uint64) []byte {
 	return result.ContractCallResult[index*32 : index*32+32]
@@ -397,6 +460,8 @@ func _ContractFunctionResultFromProtobuf(pb *services.ContractFunctionResult) Co
 		result.ContractID = _ContractIDFromProtobuf(pb.ContractID)
 	}
 
+	result.RevertReason = DecodeRevertReason(_RevertData(pb.ErrorMessage, pb.ContractCallResult))
+
 	return result
 }
 
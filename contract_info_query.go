@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -94,7 +95,14 @@ func (query *ContractInfoQuery) _Build() *services.Query_ContractGetInfo {
 	return &pb
 }
 
+// GetCost returns the cost of the Query
 func (query *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *ContractInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -122,6 +130,7 @@ func (query *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractInfoQueryShouldRetry,
@@ -150,8 +159,8 @@ func (query *ContractInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _ContractInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetContractGetInfo().Header.NodeTransactionPrecheckCode))
+func _ContractInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetContractGetInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _ContractInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -166,7 +175,14 @@ func _ContractInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *ContractInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (ContractInfo, error) {
 	if client == nil || client.operator == nil {
 		return ContractInfo{}, errNoClientProvided
 	}
@@ -192,7 +208,7 @@ func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return ContractInfo{}, err
 		}
@@ -234,6 +250,7 @@ func (query *ContractInfoQuery) Execute(client *Client) (ContractInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ContractInfoQueryShouldRetry,
@@ -285,6 +302,12 @@ func (query *ContractInfoQuery) SetMaxRetry(count int) *ContractInfoQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *ContractInfoQuery) SetRetryPolicy(policy RetryPolicy) *ContractInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *ContractInfoQuery) SetMaxBackoff(max time.Duration) *ContractInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -0,0 +1,172 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Solidity encodes `revert("message")` and failed `require`s as a call to Error(string), and failed `assert`s and
+// runtime errors such as overflows as a call to Panic(uint256).
+var revertErrorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+var revertPanicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+var revertPanicDescriptions = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic overflow or underflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on an empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to an uninitialized internal function",
+}
+
+// RevertReason is the reason a contract call reverted, decoded from the revert data the contract returned
+type RevertReason struct {
+	// Name is "Error" for a revert or failed require with a message, "Panic" for a failed assert or runtime error,
+	// or the name of a custom error. It is empty when the revert data couldn't be decoded.
+	Name string
+	// Message is the message of an Error, or the description of a Panic
+	Message string
+	// PanicCode is the code of a Panic
+	PanicCode uint64
+	// Arguments holds the arguments of a custom error by name
+	Arguments map[string]interface{}
+	// Data is the raw revert data
+	Data []byte
+}
+
+// DecodeRevertReason decodes revert data as an Error(string) or a Panic(uint256). Any other data, such as a custom
+// error, gives a RevertReason with only Data set; use ContractABI.DecodeRevertReason to decode custom errors. It
+// returns nil for empty data.
+func DecodeRevertReason(data []byte) *RevertReason {
+	if len(data) == 0 {
+		return nil
+	}
+
+	reason := &RevertReason{Data: data}
+	if len(data) < 4 {
+		return reason
+	}
+
+	switch {
+	case bytes.Equal(data[:4], revertErrorSelector):
+		values, err := _RevertArguments("string").Unpack(data[4:])
+		if err == nil {
+			reason.Name = "Error"
+			reason.Message = values[0].(string)
+		}
+	case bytes.Equal(data[:4], revertPanicSelector):
+		values, err := _RevertArguments("uint256").Unpack(data[4:])
+		if err == nil && values[0].(*big.Int).IsUint64() {
+			reason.Name = "Panic"
+			reason.PanicCode = values[0].(*big.Int).Uint64()
+			reason.Message = revertPanicDescriptions[reason.PanicCode]
+			if reason.Message == "" {
+				reason.Message = "unknown panic"
+			}
+		}
+	}
+
+	return reason
+}
+
+// DecodeRevertReason decodes revert data as one of the custom errors of the ABI, falling back to Error(string) and
+// Panic(uint256). It returns nil for empty data.
+func (contractABI *ContractABI) DecodeRevertReason(data []byte) *RevertReason {
+	if len(data) >= 4 {
+		for _, abiError := range contractABI.abi.Errors {
+			if !bytes.Equal(data[:4], abiError.ID.Bytes()[:4]) {
+				continue
+			}
+
+			arguments := make(map[string]interface{})
+			if err := abiError.Inputs.UnpackIntoMap(arguments, data[4:]); err != nil {
+				continue
+			}
+
+			return &RevertReason{
+				Name:      abiError.Name,
+				Arguments: arguments,
+				Data:      data,
+			}
+		}
+	}
+
+	return DecodeRevertReason(data)
+}
+
+// String returns the reason as it would be written in Solidity, e.g. `Error("not the owner")`,
+// `Panic(0x11): arithmetic overflow or underflow` or `InsufficientBalance(available=1, required=2)`
+func (reason RevertReason) String() string {
+	switch reason.Name {
+	case "":
+		return "0x" + hex.EncodeToString(reason.Data)
+	case "Error":
+		return fmt.Sprintf("Error(%q)", reason.Message)
+	case "Panic":
+		return fmt.Sprintf("Panic(0x%x): %s", reason.PanicCode, reason.Message)
+	}
+
+	names := make([]string, 0, len(reason.Arguments))
+	for name := range reason.Arguments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	arguments := make([]string, len(names))
+	for i, name := range names {
+		arguments[i] = fmt.Sprintf("%s=%v", name, reason.Arguments[name])
+	}
+
+	return fmt.Sprintf("%s(%s)", reason.Name, strings.Join(arguments, ", "))
+}
+
+func _RevertArguments(typ string) abi.Arguments {
+	abiType, _ := abi.NewType(typ, "", nil)
+	return abi.Arguments{{Type: abiType}}
+}
+
+// _RevertData returns the revert data of a failed contract call, which the network reports as a hex encoded error
+// message and, on newer versions, as the call result as well
+func _RevertData(errorMessage string, callResult []byte) []byte {
+	if errorMessage == "" {
+		return nil
+	}
+
+	if strings.HasPrefix(errorMessage, "0x") {
+		if data, err := hex.DecodeString(errorMessage[2:]); err == nil && len(data) > 0 {
+			return data
+		}
+	}
+
+	return callResult
+}
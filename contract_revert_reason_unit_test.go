@@ -0,0 +1,167 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRevertABI = `[
+	{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}
+]`
+
+// revert("not the owner")
+const testRevertErrorData = "08c379a0" +
+	"0000000000000000000000000000000000000000000000000000000000000020" +
+	"000000000000000000000000000000000000000000000000000000000000000d" +
+	"6e6f7420746865206f776e657200000000000000000000000000000000000000"
+
+// an overflow in checked arithmetic
+const testRevertPanicData = "4e487b71" +
+	"0000000000000000000000000000000000000000000000000000000000000011"
+
+// InsufficientBalance(1, 2)
+const testRevertCustomData = "cf479181" +
+	"0000000000000000000000000000000000000000000000000000000000000001" +
+	"0000000000000000000000000000000000000000000000000000000000000002"
+
+func _TestRevertData(t *testing.T, data string) []byte {
+	bytes, err := hex.DecodeString(data)
+	require.NoError(t, err)
+
+	return bytes
+}
+
+func TestUnitDecodeRevertReasonError(t *testing.T) {
+	reason := DecodeRevertReason(_TestRevertData(t, testRevertErrorData))
+	require.NotNil(t, reason)
+
+	assert.Equal(t, "Error", reason.Name)
+	assert.Equal(t, "not the owner", reason.Message)
+	assert.Equal(t, `Error("not the owner")`, reason.String())
+}
+
+func TestUnitDecodeRevertReasonPanic(t *testing.T) {
+	reason := DecodeRevertReason(_TestRevertData(t, testRevertPanicData))
+	require.NotNil(t, reason)
+
+	assert.Equal(t, "Panic", reason.Name)
+	assert.Equal(t, uint64(0x11), reason.PanicCode)
+	assert.Equal(t, "arithmetic overflow or underflow", reason.Message)
+	assert.Equal(t, "Panic(0x11): arithmetic overflow or underflow", reason.String())
+}
+
+func TestUnitDecodeRevertReasonUnknown(t *testing.T) {
+	assert.Nil(t, DecodeRevertReason(nil))
+
+	data := _TestRevertData(t, testRevertCustomData)
+	reason := DecodeRevertReason(data)
+	require.NotNil(t, reason)
+
+	assert.Equal(t, "", reason.Name)
+	assert.Equal(t, data, reason.Data)
+	assert.Equal(t, "0x"+testRevertCustomData, reason.String())
+}
+
+func TestUnitContractABIDecodeRevertReason(t *testing.T) {
+	contractABI, err := ContractABIFromJSON([]byte(testRevertABI))
+	require.NoError(t, err)
+
+	reason := contractABI.DecodeRevertReason(_TestRevertData(t, testRevertCustomData))
+	require.NotNil(t, reason)
+
+	assert.Equal(t, "InsufficientBalance", reason.Name)
+	assert.Equal(t, big.NewInt(1), reason.Arguments["available"])
+	assert.Equal(t, big.NewInt(2), reason.Arguments["required"])
+	assert.Equal(t, "InsufficientBalance(available=1, required=2)", reason.String())
+
+	reason = contractABI.DecodeRevertReason(_TestRevertData(t, testRevertErrorData))
+	require.NotNil(t, reason)
+	assert.Equal(t, "not the owner", reason.Message)
+}
+
+func TestUnitContractFunctionResultRevertReason(t *testing.T) {
+	contractABI, err := ContractABIFromJSON([]byte(testRevertABI))
+	require.NoError(t, err)
+
+	result := _ContractFunctionResultFromProtobuf(&services.ContractFunctionResult{
+		ErrorMessage: "0x" + testRevertCustomData,
+	})
+	require.NotNil(t, result.RevertReason)
+	assert.Equal(t, "", result.RevertReason.Name)
+
+	reason := result.DecodeRevertReason(contractABI)
+	require.NotNil(t, reason)
+	assert.Equal(t, "InsufficientBalance", reason.Name)
+
+	result = _ContractFunctionResultFromProtobuf(&services.ContractFunctionResult{
+		ErrorMessage:       "CONTRACT_REVERT_EXECUTED",
+		ContractCallResult: _TestRevertData(t, testRevertErrorData),
+	})
+	require.NotNil(t, result.RevertReason)
+	assert.Equal(t, "not the owner", result.RevertReason.Message)
+
+	result = _ContractFunctionResultFromProtobuf(&services.ContractFunctionResult{})
+	assert.Nil(t, result.RevertReason)
+}
+
+func TestUnitMockContractCallQueryRevertReason(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_ContractCallLocal{
+				ContractCallLocal: &services.ContractCallLocalResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_CONTRACT_REVERT_EXECUTED, ResponseType: services.ResponseType_ANSWER_ONLY},
+					FunctionResult: &services.ContractFunctionResult{
+						ErrorMessage: "0x" + testRevertPanicData,
+					},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	_, err := NewContractCallQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContractID(ContractID{Contract: 123}).
+		SetQueryPayment(NewHbar(1)).
+		SetGas(100000).
+		SetFunction("add", nil).
+		Execute(client)
+	require.Error(t, err)
+
+	precheckErr, ok := err.(ErrHederaPreCheckStatus)
+	require.True(t, ok)
+	assert.Equal(t, StatusContractRevertExecuted, precheckErr.Status)
+	require.NotNil(t, precheckErr.RevertReason)
+	assert.Equal(t, uint64(0x11), precheckErr.RevertReason.PanicCode)
+	assert.Equal(t, "exceptional precheck status CONTRACT_REVERT_EXECUTED, reverted with Panic(0x11): arithmetic overflow or underflow", err.Error())
+}
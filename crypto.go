@@ -26,6 +26,7 @@ import (
 	"encoding/binary"
 	"encoding/hex"
 	"io"
+	"strconv"
 	"strings"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -36,6 +37,9 @@ import (
 const ed25519PrivateKeyPrefix = "302e020100300506032b657004220420"
 const ed25519PubKeyPrefix = "302a300506032b6570032100"
 
+// _HardenedIndex is the first child index of hardened derivation; indices at or above it are hardened.
+const _HardenedIndex uint32 = 0x80000000
+
 const _ECDSAPrivateKeyPrefix = "3030020100300706052b8104000a04220420"
 const _ECDSAPubKeyPrefix = "302f300706052b8104000a0324000421"
 
@@ -253,6 +257,19 @@ func PrivateKeyFromMnemonic(mnemonic Mnemonic, passPhrase string) (PrivateKey, e
 	}, nil
 }
 
+// PrivateKeyFromSeedECDSAsecp256k1 creates the BIP-32 master ECDSA(secp256k1) key for a seed, such as the one
+// produced from a BIP-39 mnemonic. Use Derive or DerivePath to obtain child keys from it.
+func PrivateKeyFromSeedECDSAsecp256k1(seed []byte) (PrivateKey, error) {
+	key, err := _ECDSAPrivateKeyFromSeed(seed)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return PrivateKey{
+		ecdsaPrivateKey: key,
+	}, nil
+}
+
 // The use of raw bytes for a Ed25519 private key is deprecated; use PrivateKeyFromStringEd25519() instead.
 func PrivateKeyFromString(s string) (PrivateKey, error) {
 	byt, err := hex.DecodeString(s)
@@ -414,6 +431,36 @@ func _DeriveChildKey(parentKey []byte, chainCode []byte, index uint32) ([]byte,
 	return digest[0:32], digest[32:]
 }
 
+// _ParseDerivationPath parses a BIP-32 path such as "m/44'/60'/0'/0/0" into its child indices. Hardened
+// components have _HardenedIndex added.
+func _ParseDerivationPath(path string) ([]uint32, error) {
+	components := strings.Split(strings.TrimSpace(path), "/")
+	if components[0] == "m" || components[0] == "M" {
+		components = components[1:]
+	}
+
+	indices := make([]uint32, 0, len(components))
+	for _, component := range components {
+		hardened := strings.HasSuffix(component, "'") || strings.HasSuffix(component, "h") || strings.HasSuffix(component, "H")
+		if hardened {
+			component = component[:len(component)-1]
+		}
+
+		index, err := strconv.ParseUint(component, 10, 31)
+		if err != nil {
+			return nil, _NewErrBadKeyf("invalid derivation path: %v", path)
+		}
+
+		if hardened {
+			index |= uint64(_HardenedIndex)
+		}
+
+		indices = append(indices, uint32(index))
+	}
+
+	return indices, nil
+}
+
 func _DeriveLegacyChildKey(parentKey []byte, index int64) []byte {
 	in := make([]uint8, 8)
 
@@ -536,6 +583,17 @@ func (pk PublicKey) StringDer() string {
 	return ""
 }
 
+// ToEvmAddress returns the hex encoded EVM address of an ECDSA(secp256k1) public key, without the 0x prefix. This is
+// the address Ethereum tooling such as MetaMask shows for the key. Ed25519 keys have no EVM address, so an empty
+// string is returned for them.
+func (pk PublicKey) ToEvmAddress() string {
+	if pk.ecdsaPublicKey != nil {
+		return hex.EncodeToString(pk.ecdsaPublicKey._ToEvmAddress())
+	}
+
+	return ""
+}
+
 func (pk PublicKey) StringRaw() string {
 	if pk.ecdsaPublicKey != nil {
 		return pk.ecdsaPublicKey._StringRaw()
@@ -665,9 +723,17 @@ func (sk PrivateKey) SupportsDerivation() bool {
 		return sk.ed25519PrivateKey._SupportsDerivation()
 	}
 
+	if sk.ecdsaPrivateKey != nil {
+		return sk.ecdsaPrivateKey._SupportsDerivation()
+	}
+
 	return false
 }
 
+// Derive derives the child key at the given index.
+//
+// Ed25519 keys only support hardened derivation, so every index is hardened. ECDSA(secp256k1) keys follow BIP-32:
+// indices with the highest bit set (0x80000000 and above) are hardened and all others are not.
 func (sk PrivateKey) Derive(index uint32) (PrivateKey, error) {
 	if sk.ed25519PrivateKey != nil {
 		key, err := sk.ed25519PrivateKey._Derive(index)
@@ -680,7 +746,41 @@ func (sk PrivateKey) Derive(index uint32) (PrivateKey, error) {
 		}, nil
 	}
 
-	return PrivateKey{}, errors.New("only ed25519 derivation is supported right now")
+	if sk.ecdsaPrivateKey != nil {
+		key, err := sk.ecdsaPrivateKey._Derive(index)
+		if err != nil {
+			return PrivateKey{}, err
+		}
+
+		return PrivateKey{
+			ecdsaPrivateKey: key,
+		}, nil
+	}
+
+	return PrivateKey{}, errors.New("private key does not support derivation")
+}
+
+// DerivePath derives the key at a BIP-32 path such as "m/44'/60'/0'/0/0", relative to this key. Hardened components
+// are marked with ', h or H. Ed25519 keys only support paths made up entirely of hardened components.
+func (sk PrivateKey) DerivePath(path string) (PrivateKey, error) {
+	indices, err := _ParseDerivationPath(path)
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	key := sk
+	for _, index := range indices {
+		if key.ed25519PrivateKey != nil && index&_HardenedIndex == 0 {
+			return PrivateKey{}, _NewErrBadKeyf("ed25519 keys only support hardened derivation, path: %v", path)
+		}
+
+		key, err = key.Derive(index)
+		if err != nil {
+			return PrivateKey{}, err
+		}
+	}
+
+	return key, nil
 }
 
 func (sk PrivateKey) LegacyDerive(index int64) (PrivateKey, error) {
@@ -756,6 +856,8 @@ func (sk PrivateKey) SignTransaction(transaction *Transaction) ([]byte, error) {
 	return []byte{}, errors.New("key type not supported, only ed25519 and ECDSASecp256K1 are supported right now")
 }
 
+// Verify checks a signature made by the private key over the message with Sign. For ECDSA(secp256k1) keys the
+// signature is over the keccak256 hash of the message, which Verify hashes itself.
 func (pk PublicKey) Verify(message []byte, signature []byte) bool {
 	if pk.ecdsaPublicKey != nil {
 		return pk.ecdsaPublicKey._Verify(message, signature)
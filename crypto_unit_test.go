@@ -343,6 +343,19 @@ func TestUnitPrivateKeyECDSASign(t *testing.T) {
 	require.True(t, s2)
 }
 
+func TestUnitPublicKeyECDSAVerify(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	message := []byte("aaa")
+	signature := key.Sign(message)
+
+	// Verify takes the message, as Sign does, and not its keccak256 hash
+	assert.True(t, key.PublicKey().Verify(message, signature))
+	assert.False(t, key.PublicKey().Verify(crypto.Keccak256(message), signature))
+	assert.False(t, key.PublicKey().Verify([]byte("aab"), signature))
+}
+
 func DisabledTestUnitPrivateKeyECDSASign(t *testing.T) {
 	message := []byte("hello world")
 	key, err := PrivateKeyFromStringECSDA("8776c6b831a1b61ac10dac0304a2843de4716f54b1919bb91a2685d0fe3f3048")
@@ -418,3 +431,70 @@ func TestUnitPublicKeyFromPrivateKeyString(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "302f300706052b8104000a032400042102b46925b64940f5d7d3f394aba914c05f1607fa42e9e721afee0770cb55797d99", key.PublicKey().String())
 }
+
+func TestUnitPrivateKeyECDSADeriveBip32(t *testing.T) {
+	// BIP-32 test vector 1
+	seed, err := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	require.NoError(t, err)
+
+	key, err := PrivateKeyFromSeedECDSAsecp256k1(seed)
+	require.NoError(t, err)
+	assert.True(t, key.SupportsDerivation())
+	assert.Equal(t, "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35", key.StringRaw())
+
+	expected := []struct {
+		index uint32
+		key   string
+	}{
+		{0 + _HardenedIndex, "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{1, "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{2 + _HardenedIndex, "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{2, "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{1000000000, "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+
+	for _, child := range expected {
+		key, err = key.Derive(child.index)
+		require.NoError(t, err)
+		assert.Equal(t, child.key, key.StringRaw())
+	}
+
+	pathKey, err := PrivateKeyFromSeedECDSAsecp256k1(seed)
+	require.NoError(t, err)
+	pathKey, err = pathKey.DerivePath("m/0'/1/2h/2/1000000000")
+	require.NoError(t, err)
+	assert.Equal(t, key.StringRaw(), pathKey.StringRaw())
+}
+
+func TestUnitPrivateKeyECDSADeriveUnsupported(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	assert.False(t, key.SupportsDerivation())
+
+	_, err = key.Derive(0)
+	assert.Error(t, err)
+}
+
+func TestUnitPrivateKeyDerivePath(t *testing.T) {
+	mnemonic, err := MnemonicFromString(iosMnemonicString)
+	require.NoError(t, err)
+
+	key, err := PrivateKeyFromMnemonic(mnemonic, "")
+	require.NoError(t, err)
+
+	derivedKey, err := key.Derive(0)
+	require.NoError(t, err)
+
+	pathKey, err := key.DerivePath("m/0'")
+	require.NoError(t, err)
+	assert.Equal(t, derivedKey.String(), pathKey.String())
+
+	_, err = key.DerivePath("m/0")
+	assert.Error(t, err)
+
+	for _, path := range []string{"", "m/", "m/0'/x", "m/2147483648", "m/-1"} {
+		_, err = key.DerivePath(path)
+		assert.Error(t, err, path)
+	}
+}
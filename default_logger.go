@@ -0,0 +1,142 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"io"
+	"os"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultLogger is the Logger every Client starts with. It writes JSON lines to stderr using
+// zerolog, without touching zerolog's global configuration. Only the logger's own level decides
+// what is written: zerolog's global level is ignored, except that zerolog.Disabled silences every level.
+//
+// The initial level is read from the `HEDERA_SDK_GO_LOG_LEVEL` environment variable and defaults
+// to LoggerLevelDisabled. Setting `HEDERA_SDK_GO_LOG_PRETTY` switches to human readable output.
+type DefaultLogger struct {
+	logger zerolog.Logger
+	level  LogLevel
+}
+
+// NewLogger creates a DefaultLogger tagging every entry with the given component name.
+func NewLogger(component string, level LogLevel) *DefaultLogger {
+	var writer io.Writer = os.Stderr
+	if os.Getenv("HEDERA_SDK_GO_LOG_PRETTY") != "" {
+		writer = zerolog.ConsoleWriter{Out: os.Stderr}
+	}
+
+	return NewLoggerWithWriter(writer, component, level)
+}
+
+// NewLoggerWithWriter creates a DefaultLogger writing to w instead of stderr.
+func NewLoggerWithWriter(w io.Writer, component string, level LogLevel) *DefaultLogger {
+	logger := &DefaultLogger{
+		logger: zerolog.New(w).With().Timestamp().Str("module", component).Logger(),
+	}
+
+	return logger.SetLevel(level)
+}
+
+func _NewDefaultLogger() *DefaultLogger {
+	return NewLogger("hedera-sdk-go", LogLevelFromString(os.Getenv("HEDERA_SDK_GO_LOG_LEVEL")))
+}
+
+// SetLevel sets the minimum level written by the logger.
+func (logger *DefaultLogger) SetLevel(level LogLevel) *DefaultLogger {
+	logger.level = level
+	return logger
+}
+
+func (logger *DefaultLogger) GetLevel() LogLevel {
+	return logger.level
+}
+
+func (logger *DefaultLogger) Trace(msg string, keysAndValues ...interface{}) {
+	logger._Log(LoggerLevelTrace, msg, keysAndValues)
+}
+
+func (logger *DefaultLogger) Debug(msg string, keysAndValues ...interface{}) {
+	logger._Log(LoggerLevelDebug, msg, keysAndValues)
+}
+
+func (logger *DefaultLogger) Info(msg string, keysAndValues ...interface{}) {
+	logger._Log(LoggerLevelInfo, msg, keysAndValues)
+}
+
+func (logger *DefaultLogger) Warn(msg string, keysAndValues ...interface{}) {
+	logger._Log(LoggerLevelWarn, msg, keysAndValues)
+}
+
+func (logger *DefaultLogger) Error(msg string, keysAndValues ...interface{}) {
+	logger._Log(LoggerLevelError, msg, keysAndValues)
+}
+
+// _Log writes the entry if level is enabled by the logger's own level. Entries are written without a
+// zerolog level and tagged by hand, so zerolog's global level doesn't filter them; only
+// zerolog.Disabled still silences them all.
+func (logger *DefaultLogger) _Log(level LogLevel, msg string, keysAndValues []interface{}) {
+	zerologLevel := _ZerologLevel(level)
+	if zerologLevel < _ZerologLevel(logger.level) {
+		return
+	}
+
+	_LogEvent(logger.logger.Log().Str(zerolog.LevelFieldName, zerolog.LevelFieldMarshalFunc(zerologLevel)), msg, keysAndValues)
+}
+
+func _LogEvent(event *zerolog.Event, msg string, keysAndValues []interface{}) {
+	if event == nil {
+		return
+	}
+
+	for i := 0; i < len(keysAndValues); i += 2 {
+		key, ok := keysAndValues[i].(string)
+		if !ok {
+			key = "!BADKEY"
+		}
+
+		if i+1 < len(keysAndValues) {
+			event = event.Interface(key, keysAndValues[i+1])
+		} else {
+			event = event.Interface(key, nil)
+		}
+	}
+
+	event.Msg(msg)
+}
+
+func _ZerologLevel(level LogLevel) zerolog.Level {
+	switch level {
+	case LoggerLevelTrace:
+		return zerolog.TraceLevel
+	case LoggerLevelDebug:
+		return zerolog.DebugLevel
+	case LoggerLevelInfo:
+		return zerolog.InfoLevel
+	case LoggerLevelWarn:
+		return zerolog.WarnLevel
+	case LoggerLevelError:
+		return zerolog.ErrorLevel
+	}
+
+	return zerolog.Disabled
+}
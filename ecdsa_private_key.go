@@ -22,9 +22,13 @@ package hedera
 
 import (
 	"crypto/ecdsa"
+	"crypto/hmac"
 	"crypto/rand"
+	"crypto/sha512"
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/crypto"
@@ -35,6 +39,7 @@ import (
 // _ECDSAPrivateKey is an Key_ECDSASecp256K1 private key.
 type _ECDSAPrivateKey struct {
 	*ecdsa.PrivateKey
+	chainCode []byte
 }
 
 func _GenerateECDSAPrivateKey() (*_ECDSAPrivateKey, error) {
@@ -44,7 +49,7 @@ func _GenerateECDSAPrivateKey() (*_ECDSAPrivateKey, error) {
 	}
 
 	return &_ECDSAPrivateKey{
-		key,
+		PrivateKey: key,
 	}, nil
 }
 
@@ -72,7 +77,7 @@ func _ECDSAPrivateKeyFromBytesRaw(byt []byte) (*_ECDSAPrivateKey, error) {
 	}
 
 	return &_ECDSAPrivateKey{
-		key,
+		PrivateKey: key,
 	}, nil
 }
 
@@ -95,10 +100,31 @@ func _ECDSAPrivateKeyFromBytesDer(byt []byte) (*_ECDSAPrivateKey, error) {
 	}
 
 	return &_ECDSAPrivateKey{
-		key,
+		PrivateKey: key,
 	}, nil
 }
 
+// _ECDSAPrivateKeyFromSeed generates the BIP-32 master key for a seed, such as the one produced from a BIP-39
+// mnemonic. The resulting key supports derivation.
+func _ECDSAPrivateKeyFromSeed(seed []byte) (*_ECDSAPrivateKey, error) {
+	h := hmac.New(sha512.New, []byte("Bitcoin seed"))
+
+	if _, err := h.Write(seed); err != nil {
+		return &_ECDSAPrivateKey{}, err
+	}
+
+	digest := h.Sum(nil)
+
+	key, err := _ECDSAPrivateKeyFromBytesRaw(digest[0:32])
+	if err != nil {
+		return &_ECDSAPrivateKey{}, _NewErrBadKeyf("seed does not produce a valid master key")
+	}
+
+	key.chainCode = digest[32:]
+
+	return key, nil
+}
+
 func _ECDSAPrivateKeyFromString(s string) (*_ECDSAPrivateKey, error) {
 	b, err := hex.DecodeString(strings.ToLower(s))
 	if err != nil {
@@ -108,6 +134,64 @@ func _ECDSAPrivateKeyFromString(s string) (*_ECDSAPrivateKey, error) {
 	return _ECDSAPrivateKeyFromBytes(b)
 }
 
+func (sk _ECDSAPrivateKey) _SupportsDerivation() bool {
+	return sk.chainCode != nil
+}
+
+// _Derive derives the BIP-32 child key at the given index. Indices with the highest bit set (0x80000000 and above)
+// produce hardened children, all others produce normal children.
+//
+// This will fail if the key does not support derivation which can be checked by calling _SupportsDerivation()
+func (sk _ECDSAPrivateKey) _Derive(index uint32) (*_ECDSAPrivateKey, error) {
+	if !sk._SupportsDerivation() {
+		return &_ECDSAPrivateKey{}, _NewErrBadKeyf("child key cannot be derived from this key")
+	}
+
+	input := make([]byte, 37)
+
+	if index&_HardenedIndex != 0 {
+		// 0x00 + parentKey + _Index(BE)
+		copy(input[1:33], sk._BytesRaw())
+	} else {
+		// compressed parentPublicKey + _Index(BE)
+		copy(input[0:33], crypto.CompressPubkey(sk._PublicKey().PublicKey))
+	}
+
+	binary.BigEndian.PutUint32(input[33:37], index)
+
+	h := hmac.New(sha512.New, sk.chainCode)
+	if _, err := h.Write(input); err != nil {
+		return &_ECDSAPrivateKey{}, err
+	}
+
+	digest := h.Sum(nil)
+
+	n := crypto.S256().Params().N
+	tweak := new(big.Int).SetBytes(digest[0:32])
+	if tweak.Cmp(n) >= 0 {
+		return &_ECDSAPrivateKey{}, _NewErrBadKeyf("child key at index %v is invalid, use the next index", index)
+	}
+
+	child := tweak.Add(tweak, sk.D)
+	child.Mod(child, n)
+	if child.Sign() == 0 {
+		return &_ECDSAPrivateKey{}, _NewErrBadKeyf("child key at index %v is invalid, use the next index", index)
+	}
+
+	childBytes := make([]byte, 32)
+	raw := child.Bytes()
+	copy(childBytes[32-len(raw):], raw)
+
+	derivedKey, err := _ECDSAPrivateKeyFromBytesRaw(childBytes)
+	if err != nil {
+		return &_ECDSAPrivateKey{}, err
+	}
+
+	derivedKey.chainCode = digest[32:]
+
+	return derivedKey, nil
+}
+
 func (sk *_ECDSAPrivateKey) _PublicKey() *_ECDSAPublicKey {
 	if sk.PrivateKey.Y == nil && sk.PrivateKey.X == nil {
 		b := sk.D.Bytes()
@@ -130,7 +130,8 @@ func (pk _ECDSAPublicKey) _ToSignaturePairProtobuf(signature []byte) *services.S
 }
 
 func (pk _ECDSAPublicKey) _Verify(message []byte, signature []byte) bool {
-	return crypto.VerifySignature(pk._BytesRaw(), message, signature)
+	// _Sign signs the keccak256 hash of the message
+	return crypto.VerifySignature(pk._BytesRaw(), crypto.Keccak256(message), signature)
 }
 
 func (pk _ECDSAPublicKey) _VerifyTransaction(transaction Transaction) bool {
@@ -159,3 +160,8 @@ func (pk _ECDSAPublicKey) _VerifyTransaction(transaction Transaction) bool {
 
 	return true
 }
+
+// _ToEvmAddress returns the last 20 bytes of the keccak256 hash of the uncompressed public key
+func (pk _ECDSAPublicKey) _ToEvmAddress() []byte {
+	return crypto.PubkeyToAddress(*pk.PublicKey).Bytes()
+}
@@ -35,6 +35,18 @@ type ErrMaxChunksExceeded struct {
 }
 
 var errTransactionIsFrozen = errors.New("transaction is immutable; it has at least one signature or has been explicitly frozen")
+var errNotSignableTransaction = errors.New("not a transaction")
+var errSignatureBundleMismatch = errors.New("signature bundles are for different transaction bodies")
+var errInvalidSignature = errors.New("invalid signature")
+var errEthereumTransactionNotSchedulable = errors.New("Ethereum transactions cannot be scheduled")
+var errEthereumChainIDRequired = errors.New("EIP-1559 Ethereum transactions require a chain ID")
+var errEthereumKeyRequired = errors.New("Ethereum transactions must be signed with an ECDSA(secp256k1) key")
+var errAbiMethodNotFound = errors.New("method not found in contract ABI")
+var errAbiIntegerOverflow = errors.New("integer overflows its ABI type")
+var errAbiEventNotFound = errors.New("event not found in contract ABI")
+var errInvalidEventSignature = errors.New("invalid event signature")
+var errEventLogMismatch = errors.New("log was not emitted for this event")
+var errInvalidEvmAddress = errors.New("EVM address must be 20 bytes encoded as 40 hex characters")
 var errNoClientOrTransactionID = errors.New("`client` must have an `_Operator` or `transactionId` must be set")
 var errNoClientOrTransactionIDOrNodeId = errors.New("`client` must be provided or both `nodeId` and `transactionId` must be set") // nolint
 var errClientOperatorSigning = errors.New("`client` must have an `_Operator` to sign with the _Operator")
@@ -50,6 +62,17 @@ var errNetworkMismatch = errors.New("network mismatch; some IDs have different n
 var errNetworkNameMissing = errors.New("can't derive checksum for ID without knowing which _Network the ID is for")
 var errChecksumMissing = errors.New("no checksum provided")
 var errLockedSlice = errors.New("slice is locked")
+var errNoMirrorNetwork = errors.New("`client` must have a mirror network set")
+var errEmptyAddressBook = errors.New("address book doesn't contain any usable node")
+var errNoNodeAllowed = errors.New("no node of the network is allowed by the pinned and excluded node account IDs")
+var errInterceptorRequestType = errors.New("interceptor replaced the request with a message of the wrong type")
+var errInterceptorResponseType = errors.New("interceptor returned a response of the wrong type")
+var errCassetteVersion = errors.New("unsupported cassette version")
+var errInvalidExchangeRate = errors.New("exchange rate must have positive hbar and cent equivalents")
+var errFeeDataNotFound = errors.New("fee schedule has no fees for request type")
+var errHbarOverflow = errors.New("hbar amount overflows the tinybars an Hbar can hold")
+var errHbarFractionalTinybar = errors.New("hbar amount is a fraction of a tinybar")
+var errHbarDivisionByZero = errors.New("hbar amount divided by zero")
 
 type ErrInvalidNodeAccountIDSet struct {
 	NodeAccountID AccountID
@@ -59,6 +82,36 @@ func (err ErrInvalidNodeAccountIDSet) Error() string {
 	return fmt.Sprintf("Invalid node AccountID was set for transaction: %v", err.NodeAccountID.String())
 }
 
+// ErrCassetteMismatch is returned when replaying a Cassette, for a request which isn't the one that was recorded next.
+type ErrCassetteMismatch struct {
+	// Index of the interaction the request was compared to
+	Index         int
+	NodeAccountID AccountID
+	RequestType   RequestType
+	// The node and request type recorded; zero when the cassette has no interactions left
+	ExpectedNodeAccountID AccountID
+	ExpectedRequestType   RequestType
+	// Path of the first field of the normalized request that differs from the recorded one, if the node and request
+	// type match
+	Field string
+	// Whether every interaction of the cassette was already replayed
+	Exhausted bool
+}
+
+func (err ErrCassetteMismatch) Error() string {
+	request := fmt.Sprintf("%s to node %s", err.RequestType.String(), err.NodeAccountID.String())
+	if err.Exhausted {
+		return fmt.Sprintf("cassette has no interaction %d left to replay %s", err.Index, request)
+	}
+
+	expected := fmt.Sprintf("%s to node %s", err.ExpectedRequestType.String(), err.ExpectedNodeAccountID.String())
+	if expected != request || err.Field == "" {
+		return fmt.Sprintf("cassette interaction %d recorded %s, but got %s", err.Index, expected, request)
+	}
+
+	return fmt.Sprintf("cassette interaction %d recorded %s with a different `%s`", err.Index, expected, err.Field)
+}
+
 func (err ErrMaxChunksExceeded) Error() string {
 	return fmt.Sprintf("Message requires %d chunks, but max chunks is %d", err.Chunks, err.MaxChunks)
 }
@@ -122,17 +175,20 @@ func (e ErrHederaNetwork) Error() string {
 type ErrHederaPreCheckStatus struct {
 	TxID   TransactionID
 	Status Status
+	// RevertReason is set when a ContractCallQuery fails with CONTRACT_REVERT_EXECUTED and the contract returned
+	// revert data
+	RevertReason *RevertReason
 }
 
 // Error() implements the Error interface
 func (e ErrHederaPreCheckStatus) Error() string {
 	if e.TxID.AccountID == nil {
-		return fmt.Sprintf("exceptional precheck status %s", e.Status.String())
+		return fmt.Sprintf("exceptional precheck status %s%s", e.Status.String(), _RevertReasonSuffix(e.RevertReason))
 	}
 	if e.TxID.AccountID._IsZero() {
-		return fmt.Sprintf("exceptional precheck status %s", e.Status.String())
+		return fmt.Sprintf("exceptional precheck status %s%s", e.Status.String(), _RevertReasonSuffix(e.RevertReason))
 	}
-	return fmt.Sprintf("exceptional precheck status %s received for transaction %v", e.Status.String(), e.TxID)
+	return fmt.Sprintf("exceptional precheck status %s received for transaction %v%s", e.Status.String(), e.TxID, _RevertReasonSuffix(e.RevertReason))
 }
 
 // ErrHederaReceiptStatus is returned by TransactionID.GetReceipt if the status of the receipt is exceptional.
@@ -140,6 +196,9 @@ type ErrHederaReceiptStatus struct {
 	TxID    TransactionID
 	Status  Status
 	Receipt TransactionReceipt
+	// RevertReason is set when a contract call or creation fails with CONTRACT_REVERT_EXECUTED and the contract
+	// returned revert data. Receipts don't carry it, so it is only available from a TransactionRecordQuery.
+	RevertReason *RevertReason
 }
 
 func _NewErrHederaReceiptStatus(id TransactionID, status Status) ErrHederaReceiptStatus {
@@ -148,7 +207,15 @@ func _NewErrHederaReceiptStatus(id TransactionID, status Status) ErrHederaReceip
 
 // Error() implements the Error interface
 func (e ErrHederaReceiptStatus) Error() string {
-	return fmt.Sprintf("exceptional receipt status: %s", e.Status.String())
+	return fmt.Sprintf("exceptional receipt status: %s%s", e.Status.String(), _RevertReasonSuffix(e.RevertReason))
+}
+
+func _RevertReasonSuffix(reason *RevertReason) string {
+	if reason == nil {
+		return ""
+	}
+
+	return fmt.Sprintf(", reverted with %v", reason)
 }
 
 // ErrHederaRecordStatus is returned by TransactionID.GetRecord if the status of the record is exceptional.
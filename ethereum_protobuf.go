@@ -0,0 +1,124 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// The version of `hedera-protobufs-go/services` this SDK is built against predates HIP-410, so it
+// has neither `EthereumTransactionBody` nor `SmartContractService.callEthereum`. The body is encoded
+// here by hand and carried as field 50 of `TransactionBody`, which is where newer generated code
+// keeps its `ethereumTransaction` case, so the bytes on the wire are identical.
+
+const (
+	smartContractServiceCallEthereum = "/proto.SmartContractService/callEthereum"
+
+	transactionBodyEthereumTransaction protowire.Number = 50
+)
+
+// _EthereumTransactionBody mirrors `proto.EthereumTransactionBody`
+type _EthereumTransactionBody struct {
+	EthereumData    []byte
+	CallData        *services.FileID
+	MaxGasAllowance int64
+}
+
+func (body *_EthereumTransactionBody) _Marshal() (data []byte, err error) {
+	if len(body.EthereumData) > 0 {
+		data = protowire.AppendTag(data, 1, protowire.BytesType)
+		data = protowire.AppendBytes(data, body.EthereumData)
+	}
+	if data, err = _MirrorAppendMessage(data, 2, body.CallData); err != nil {
+		return nil, err
+	}
+	if body.MaxGasAllowance != 0 {
+		data = protowire.AppendTag(data, 3, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(body.MaxGasAllowance))
+	}
+
+	return data, nil
+}
+
+func (body *_EthereumTransactionBody) _Unmarshal(data []byte) error {
+	*body = _EthereumTransactionBody{}
+
+	return _MirrorConsumeFields(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			body.EthereumData = append([]byte{}, value...)
+		case num == 2 && typ == protowire.BytesType:
+			body.CallData = &services.FileID{}
+			return protobuf.Unmarshal(value, body.CallData)
+		case num == 3 && typ == protowire.VarintType:
+			body.MaxGasAllowance = int64(varint)
+		}
+
+		return nil
+	})
+}
+
+// _SetEthereumTransactionBody stores ethereum as the `ethereumTransaction` of body
+func _SetEthereumTransactionBody(body *services.TransactionBody, ethereum *_EthereumTransactionBody) error {
+	bytes, err := ethereum._Marshal()
+	if err != nil {
+		return err
+	}
+
+	data := protowire.AppendTag(nil, transactionBodyEthereumTransaction, protowire.BytesType)
+	body.ProtoReflect().SetUnknown(protowire.AppendBytes(data, bytes))
+
+	return nil
+}
+
+// _GetEthereumTransactionBody returns the `ethereumTransaction` of body, or nil if it is some other transaction
+func _GetEthereumTransactionBody(body *services.TransactionBody) (*_EthereumTransactionBody, error) {
+	var ethereum *_EthereumTransactionBody
+
+	err := _MirrorConsumeFields(body.ProtoReflect().GetUnknown(), func(num protowire.Number, typ protowire.Type, value []byte, _ uint64) error {
+		if num != transactionBodyEthereumTransaction || typ != protowire.BytesType {
+			return nil
+		}
+
+		ethereum = &_EthereumTransactionBody{}
+		return ethereum._Unmarshal(value)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return ethereum, nil
+}
+
+// _CallEthereum submits a transaction to `SmartContractService.callEthereum`
+func (channel _Channel) _CallEthereum(ctx context.Context, in *services.Transaction, opts ...grpc.CallOption) (*services.TransactionResponse, error) {
+	out := new(services.TransactionResponse)
+	if err := channel.client.Invoke(ctx, smartContractServiceCallEthereum, in, out, opts...); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
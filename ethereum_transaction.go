@@ -0,0 +1,470 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+
+	"time"
+)
+
+// EthereumTransaction submits a raw, RLP encoded and signed Ethereum transaction (HIP-410), such as one built with
+// EthereumTransactionData. Its fees are paid by the account whose ECDSA(secp256k1) key signed the Ethereum
+// transaction, falling back to the transaction's payer for up to the max gas allowance.
+type EthereumTransaction struct {
+	Transaction
+	ethereumData    []byte
+	callDataFileID  *FileID
+	maxGasAllowance int64
+}
+
+func NewEthereumTransaction() *EthereumTransaction {
+	transaction := EthereumTransaction{
+		Transaction: _NewTransaction(),
+	}
+	transaction.SetMaxTransactionFee(NewHbar(2))
+
+	return &transaction
+}
+
+func _EthereumTransactionFromProtobuf(transaction Transaction, pb *_EthereumTransactionBody) *EthereumTransaction {
+	return &EthereumTransaction{
+		Transaction:     transaction,
+		ethereumData:    pb.EthereumData,
+		callDataFileID:  _FileIDFromProtobuf(pb.CallData),
+		maxGasAllowance: pb.MaxGasAllowance,
+	}
+}
+
+func (transaction *EthereumTransaction) SetGrpcDeadline(deadline *time.Duration) *EthereumTransaction {
+	transaction.Transaction.SetGrpcDeadline(deadline)
+	return transaction
+}
+
+// SetEthereumData sets the raw, RLP encoded and signed Ethereum transaction. Legacy and EIP-1559 transactions are
+// supported.
+func (transaction *EthereumTransaction) SetEthereumData(data []byte) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.ethereumData = data
+	return transaction
+}
+
+func (transaction *EthereumTransaction) GetEthereumData() []byte {
+	return transaction.ethereumData
+}
+
+// SetCallDataFileID sets the file holding the hex encoded call data of the Ethereum transaction. It is used for
+// call data too large to fit in a transaction, in which case the call data of the Ethereum transaction must be
+// empty.
+func (transaction *EthereumTransaction) SetCallDataFileID(fileID FileID) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.callDataFileID = &fileID
+	return transaction
+}
+
+func (transaction *EthereumTransaction) GetCallDataFileID() FileID {
+	if transaction.callDataFileID == nil {
+		return FileID{}
+	}
+
+	return *transaction.callDataFileID
+}
+
+// SetMaxGasAllowanceHbar sets the most the payer of this transaction is willing to pay towards the gas of the
+// Ethereum transaction when the account that signed it can't cover it.
+func (transaction *EthereumTransaction) SetMaxGasAllowanceHbar(gas Hbar) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.maxGasAllowance = gas.AsTinybar()
+	return transaction
+}
+
+func (transaction *EthereumTransaction) GetMaxGasAllowanceHbar() Hbar {
+	return HbarFromTinybar(transaction.maxGasAllowance)
+}
+
+func (transaction *EthereumTransaction) _ValidateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	if transaction.callDataFileID != nil {
+		if err := transaction.callDataFileID.ValidateChecksum(client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (transaction *EthereumTransaction) _Build() *services.TransactionBody {
+	body := &_EthereumTransactionBody{
+		EthereumData:    transaction.ethereumData,
+		MaxGasAllowance: transaction.maxGasAllowance,
+	}
+
+	if transaction.callDataFileID != nil {
+		body.CallData = transaction.callDataFileID._ToProtobuf()
+	}
+
+	pb := &services.TransactionBody{
+		TransactionFee:           transaction.transactionFee,
+		Memo:                     transaction.Transaction.memo,
+		TransactionValidDuration: _DurationToProtobuf(transaction.GetTransactionValidDuration()),
+		TransactionID:            transaction.transactionID._ToProtobuf(),
+	}
+	_ = _SetEthereumTransactionBody(pb, body)
+
+	return pb
+}
+
+// Schedule always fails: the network doesn't allow Ethereum transactions to be scheduled.
+func (transaction *EthereumTransaction) Schedule() (*ScheduleCreateTransaction, error) {
+	transaction._RequireNotFrozen()
+
+	_, err := transaction._ConstructScheduleProtobuf()
+	return nil, err
+}
+
+func (transaction *EthereumTransaction) _ConstructScheduleProtobuf() (*services.SchedulableTransactionBody, error) {
+	return nil, errEthereumTransactionNotSchedulable
+}
+
+func _EthereumTransactionGetMethod(request interface{}, channel *_Channel) _Method {
+	return _Method{
+		transaction: channel._CallEthereum,
+	}
+}
+
+func (transaction *EthereumTransaction) IsFrozen() bool {
+	return transaction._IsFrozen()
+}
+
+// Sign uses the provided privateKey to sign the transaction.
+func (transaction *EthereumTransaction) Sign(
+	privateKey PrivateKey,
+) *EthereumTransaction {
+	return transaction.SignWith(privateKey.PublicKey(), privateKey.Sign)
+}
+
+func (transaction *EthereumTransaction) SignWithOperator(
+	client *Client,
+) (*EthereumTransaction, error) {
+	// If the transaction is not signed by the _Operator, we need
+	// to sign the transaction with the _Operator
+
+	if client == nil {
+		return nil, errNoClientProvided
+	} else if client.operator == nil {
+		return nil, errClientOperatorSigning
+	}
+
+	if !transaction.IsFrozen() {
+		_, err := transaction.FreezeWith(client)
+		if err != nil {
+			return transaction, err
+		}
+	}
+	return transaction.SignWith(client.operator.publicKey, client.operator.signer), nil
+}
+
+// SignWith executes the TransactionSigner and adds the resulting signature data to the Transaction's signature map
+// with the publicKey as the map key.
+func (transaction *EthereumTransaction) SignWith(
+	publicKey PublicKey,
+	signer TransactionSigner,
+) *EthereumTransaction {
+	if !transaction._KeyAlreadySigned(publicKey) {
+		transaction._SignWith(publicKey, signer)
+	}
+
+	return transaction
+}
+
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *EthereumTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *EthereumTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *EthereumTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *EthereumTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
+// Execute executes the Transaction with the provided client
+func (transaction *EthereumTransaction) Execute(
+	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *EthereumTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
+) (TransactionResponse, error) {
+	if client == nil {
+		return TransactionResponse{}, errNoClientProvided
+	}
+
+	if transaction.freezeError != nil {
+		return TransactionResponse{}, transaction.freezeError
+	}
+
+	if !transaction.IsFrozen() {
+		_, err := transaction.FreezeWith(client)
+		if err != nil {
+			return TransactionResponse{}, err
+		}
+	}
+
+	transactionID := transaction.transactionIDs._GetCurrent().(TransactionID)
+
+	if !client.GetOperatorAccountID()._IsZero() && client.GetOperatorAccountID()._Equals(*transactionID.AccountID) {
+		transaction.SignWith(
+			client.GetOperatorPublicKey(),
+			client.operator.signer,
+		)
+	}
+
+	resp, err := _Execute(
+		ctx,
+		client,
+		&transaction.Transaction,
+		_TransactionShouldRetry,
+		_TransactionMakeRequest,
+		_TransactionAdvanceRequest,
+		_TransactionGetNodeAccountID,
+		_EthereumTransactionGetMethod,
+		_TransactionMapStatusError,
+		_TransactionMapResponse,
+		transaction._GetLogID(),
+		transaction.grpcDeadline,
+		transaction.maxBackoff,
+		transaction.minBackoff,
+		transaction.maxRetry,
+	)
+
+	if err != nil {
+		return TransactionResponse{
+			TransactionID: transaction.GetTransactionID(),
+			NodeID:        resp.(TransactionResponse).NodeID,
+		}, err
+	}
+
+	hash, err := transaction.GetTransactionHash()
+	if err != nil {
+		return TransactionResponse{}, err
+	}
+
+	return TransactionResponse{
+		TransactionID: transaction.GetTransactionID(),
+		NodeID:        resp.(TransactionResponse).NodeID,
+		Hash:          hash,
+	}, nil
+}
+
+func (transaction *EthereumTransaction) Freeze() (*EthereumTransaction, error) {
+	return transaction.FreezeWith(nil)
+}
+
+func (transaction *EthereumTransaction) FreezeWith(client *Client) (*EthereumTransaction, error) {
+	if transaction.IsFrozen() {
+		return transaction, nil
+	}
+	transaction._InitFee(client)
+	err := transaction._ValidateNetworkOnIDs(client)
+	if err != nil {
+		return &EthereumTransaction{}, err
+	}
+	if err := transaction._InitTransactionID(client); err != nil {
+		return transaction, err
+	}
+	body := transaction._Build()
+
+	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
+}
+
+func (transaction *EthereumTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
+func (transaction *EthereumTransaction) GetMaxTransactionFee() Hbar {
+	return transaction.Transaction.GetMaxTransactionFee()
+}
+
+// SetMaxTransactionFee sets the max transaction fee for this EthereumTransaction.
+func (transaction *EthereumTransaction) SetMaxTransactionFee(fee Hbar) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.Transaction.SetMaxTransactionFee(fee)
+	return transaction
+}
+
+// SetRegenerateTransactionID sets if transaction IDs should be regenerated when `TRANSACTION_EXPIRED` is received
+func (transaction *EthereumTransaction) SetRegenerateTransactionID(regenerateTransactionID bool) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.Transaction.SetRegenerateTransactionID(regenerateTransactionID)
+	return transaction
+}
+
+// GetRegenerateTransactionID returns true if transaction ID regeneration is enabled.
+func (transaction *EthereumTransaction) GetRegenerateTransactionID() bool {
+	return transaction.Transaction.GetRegenerateTransactionID()
+}
+
+func (transaction *EthereumTransaction) GetTransactionMemo() string {
+	return transaction.Transaction.GetTransactionMemo()
+}
+
+// SetTransactionMemo sets the memo for this EthereumTransaction.
+func (transaction *EthereumTransaction) SetTransactionMemo(memo string) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.Transaction.SetTransactionMemo(memo)
+	return transaction
+}
+
+func (transaction *EthereumTransaction) GetTransactionValidDuration() time.Duration {
+	return transaction.Transaction.GetTransactionValidDuration()
+}
+
+// SetTransactionValidDuration sets the valid duration for this EthereumTransaction.
+func (transaction *EthereumTransaction) SetTransactionValidDuration(duration time.Duration) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.Transaction.SetTransactionValidDuration(duration)
+	return transaction
+}
+
+func (transaction *EthereumTransaction) GetTransactionID() TransactionID {
+	return transaction.Transaction.GetTransactionID()
+}
+
+// SetTransactionID sets the TransactionID for this EthereumTransaction.
+func (transaction *EthereumTransaction) SetTransactionID(transactionID TransactionID) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+
+	transaction.Transaction.SetTransactionID(transactionID)
+	return transaction
+}
+
+// SetNodeAccountID sets the _Node AccountID for this EthereumTransaction.
+func (transaction *EthereumTransaction) SetNodeAccountIDs(nodeID []AccountID) *EthereumTransaction {
+	transaction._RequireNotFrozen()
+	transaction.Transaction.SetNodeAccountIDs(nodeID)
+	return transaction
+}
+
+func (transaction *EthereumTransaction) SetMaxRetry(count int) *EthereumTransaction {
+	transaction.Transaction.SetMaxRetry(count)
+	return transaction
+}
+
+func (transaction *EthereumTransaction) AddSignature(publicKey PublicKey, signature []byte) *EthereumTransaction {
+	transaction._RequireOneNodeAccountID()
+
+	if transaction._KeyAlreadySigned(publicKey) {
+		return transaction
+	}
+
+	if transaction.signedTransactions._Length() == 0 {
+		return transaction
+	}
+
+	transaction.transactions = _NewLockableSlice()
+	transaction.publicKeys = append(transaction.publicKeys, publicKey)
+	transaction.transactionSigners = append(transaction.transactionSigners, nil)
+	transaction.transactionIDs.locked = true
+
+	for index := 0; index < transaction.signedTransactions._Length(); index++ {
+		var temp *services.SignedTransaction
+		switch t := transaction.signedTransactions._Get(index).(type) { //nolint
+		case *services.SignedTransaction:
+			temp = t
+		}
+		temp.SigMap.SigPair = append(
+			temp.SigMap.SigPair,
+			publicKey._ToSignaturePairProtobuf(signature),
+		)
+		transaction.signedTransactions._Set(index, temp)
+	}
+
+	return transaction
+}
+
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *EthereumTransaction) SetRetryPolicy(policy RetryPolicy) *EthereumTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
+func (transaction *EthereumTransaction) SetMaxBackoff(max time.Duration) *EthereumTransaction {
+	if max.Nanoseconds() < 0 {
+		panic("maxBackoff must be a positive duration")
+	} else if max.Nanoseconds() < transaction.minBackoff.Nanoseconds() {
+		panic("maxBackoff must be greater than or equal to minBackoff")
+	}
+	transaction.maxBackoff = &max
+	return transaction
+}
+
+func (transaction *EthereumTransaction) GetMaxBackoff() time.Duration {
+	if transaction.maxBackoff != nil {
+		return *transaction.maxBackoff
+	}
+
+	return 8 * time.Second
+}
+
+func (transaction *EthereumTransaction) SetMinBackoff(min time.Duration) *EthereumTransaction {
+	if min.Nanoseconds() < 0 {
+		panic("minBackoff must be a positive duration")
+	} else if transaction.maxBackoff.Nanoseconds() < min.Nanoseconds() {
+		panic("minBackoff must be less than or equal to maxBackoff")
+	}
+	transaction.minBackoff = &min
+	return transaction
+}
+
+func (transaction *EthereumTransaction) GetMinBackoff() time.Duration {
+	if transaction.minBackoff != nil {
+		return *transaction.minBackoff
+	}
+
+	return 250 * time.Millisecond
+}
+
+func (transaction *EthereumTransaction) _GetLogID() string {
+	timestamp := transaction.transactionIDs._GetCurrent().(TransactionID).ValidStart
+	return fmt.Sprintf("EthereumTransaction:%d", timestamp.UnixNano())
+}
@@ -0,0 +1,152 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// EthereumTransactionData describes an Ethereum transaction to sign with an ECDSA(secp256k1) key and submit with
+// EthereumTransaction. Data with a GasPrice becomes a legacy transaction, protected against replay on other chains
+// (EIP-155) when ChainID is set. Any other data becomes an EIP-1559 transaction using GasTipCap and GasFeeCap.
+type EthereumTransactionData struct {
+	ChainID   *big.Int
+	Nonce     uint64
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+	GasLimit  uint64
+	// To is the hex encoded EVM address to call, left empty to deploy a contract
+	To string
+	// Value is the amount to send, in weibars (1 tinybar is 10^10 weibars)
+	Value    *big.Int
+	CallData []byte
+}
+
+// EthereumTransactionDataFromBytes decodes an RLP encoded, signed Ethereum transaction. It also returns the hex
+// encoded EVM address of the key that signed it.
+func EthereumTransactionDataFromBytes(data []byte) (EthereumTransactionData, string, error) {
+	transaction := new(types.Transaction)
+	if err := transaction.UnmarshalBinary(data); err != nil {
+		return EthereumTransactionData{}, "", err
+	}
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if transaction.Protected() {
+		signer = types.LatestSignerForChainID(transaction.ChainId())
+	}
+
+	sender, err := types.Sender(signer, transaction)
+	if err != nil {
+		return EthereumTransactionData{}, "", err
+	}
+
+	result := EthereumTransactionData{
+		Nonce:    transaction.Nonce(),
+		GasLimit: transaction.Gas(),
+		Value:    transaction.Value(),
+		CallData: transaction.Data(),
+	}
+
+	if transaction.Protected() {
+		result.ChainID = transaction.ChainId()
+	}
+
+	if transaction.Type() == types.LegacyTxType {
+		result.GasPrice = transaction.GasPrice()
+	} else {
+		result.GasTipCap = transaction.GasTipCap()
+		result.GasFeeCap = transaction.GasFeeCap()
+	}
+
+	if transaction.To() != nil {
+		result.To = common.Bytes2Hex(transaction.To().Bytes())
+	}
+
+	return result, common.Bytes2Hex(sender.Bytes()), nil
+}
+
+func (data EthereumTransactionData) _ToEthereum() (*types.Transaction, error) {
+	var to *common.Address
+	if data.To != "" {
+		address, err := _EvmAddressFromString(data.To)
+		if err != nil {
+			return nil, err
+		}
+
+		temp := common.BytesToAddress(address)
+		to = &temp
+	}
+
+	if data.GasPrice != nil {
+		return types.NewTx(&types.LegacyTx{
+			Nonce:    data.Nonce,
+			GasPrice: data.GasPrice,
+			Gas:      data.GasLimit,
+			To:       to,
+			Value:    data.Value,
+			Data:     data.CallData,
+		}), nil
+	}
+
+	if data.ChainID == nil {
+		return nil, errEthereumChainIDRequired
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   data.ChainID,
+		Nonce:     data.Nonce,
+		GasTipCap: data.GasTipCap,
+		GasFeeCap: data.GasFeeCap,
+		Gas:       data.GasLimit,
+		To:        to,
+		Value:     data.Value,
+		Data:      data.CallData,
+	}), nil
+}
+
+// Sign signs the transaction with an ECDSA(secp256k1) key and returns it RLP encoded, ready to be passed to
+// EthereumTransaction.SetEthereumData.
+func (data EthereumTransactionData) Sign(key PrivateKey) ([]byte, error) {
+	if key.ecdsaPrivateKey == nil {
+		return nil, errEthereumKeyRequired
+	}
+
+	transaction, err := data._ToEthereum()
+	if err != nil {
+		return nil, err
+	}
+
+	var signer types.Signer = types.HomesteadSigner{}
+	if data.ChainID != nil {
+		signer = types.LatestSignerForChainID(data.ChainID)
+	}
+
+	signed, err := types.SignTx(transaction, signer, key.ecdsaPrivateKey.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return signed.MarshalBinary()
+}
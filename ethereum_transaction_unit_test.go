@@ -0,0 +1,165 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestUnitEthereumTransactionDataLegacy(t *testing.T) {
+	// EIP-155 example transaction
+	key, err := PrivateKeyFromStringECSDA("4646464646464646464646464646464646464646464646464646464646464646")
+	require.NoError(t, err)
+
+	data := EthereumTransactionData{
+		ChainID:  big.NewInt(1),
+		Nonce:    9,
+		GasPrice: big.NewInt(20000000000),
+		GasLimit: 21000,
+		To:       "0x3535353535353535353535353535353535353535",
+		Value:    big.NewInt(1000000000000000000),
+	}
+
+	signed, err := data.Sign(key)
+	require.NoError(t, err)
+	require.Equal(t, "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83", hex.EncodeToString(signed))
+
+	decoded, sender, err := EthereumTransactionDataFromBytes(signed)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey().ToEvmAddress(), sender)
+	require.Equal(t, "3535353535353535353535353535353535353535", decoded.To)
+	require.Equal(t, uint64(9), decoded.Nonce)
+	require.Equal(t, int64(1), decoded.ChainID.Int64())
+	require.Equal(t, data.GasPrice, decoded.GasPrice)
+	require.Nil(t, decoded.GasFeeCap)
+}
+
+func TestUnitEthereumTransactionDataEip1559(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	data := EthereumTransactionData{
+		ChainID:   big.NewInt(296),
+		Nonce:     1,
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(2000000000),
+		GasLimit:  100000,
+		Value:     big.NewInt(0),
+		CallData:  []byte{0x60, 0x80, 0x60, 0x40},
+	}
+
+	signed, err := data.Sign(key)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x02), signed[0])
+
+	decoded, sender, err := EthereumTransactionDataFromBytes(signed)
+	require.NoError(t, err)
+	require.Equal(t, key.PublicKey().ToEvmAddress(), sender)
+	require.Equal(t, "", decoded.To)
+	require.Equal(t, data.CallData, decoded.CallData)
+	require.Equal(t, data.GasFeeCap, decoded.GasFeeCap)
+	require.Nil(t, decoded.GasPrice)
+
+	data.ChainID = nil
+	_, err = data.Sign(key)
+	require.Error(t, err)
+
+	ed25519Key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	_, err = EthereumTransactionData{GasPrice: big.NewInt(1)}.Sign(ed25519Key)
+	require.Error(t, err)
+}
+
+func TestUnitEthereumTransactionFromBytes(t *testing.T) {
+	transaction, err := NewEthereumTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		SetEthereumData([]byte{1, 2, 3}).
+		SetCallDataFileID(FileID{File: 1001}).
+		SetMaxGasAllowanceHbar(NewHbar(5)).
+		Freeze()
+	require.NoError(t, err)
+
+	transactionBytes, err := transaction.ToBytes()
+	require.NoError(t, err)
+
+	signable, err := TransactionFromBytes(transactionBytes)
+	require.NoError(t, err)
+
+	ethereum, ok := signable.(*EthereumTransaction)
+	require.True(t, ok)
+	require.Equal(t, []byte{1, 2, 3}, ethereum.GetEthereumData())
+	require.Equal(t, FileID{File: 1001}, ethereum.GetCallDataFileID())
+	require.Equal(t, NewHbar(5), ethereum.GetMaxGasAllowanceHbar())
+
+	_, err = NewEthereumTransaction().Schedule()
+	require.Error(t, err)
+}
+
+func TestUnitEthereumTransactionExecute(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	ethereumData, err := EthereumTransactionData{
+		ChainID:   big.NewInt(296),
+		GasTipCap: big.NewInt(0),
+		GasFeeCap: big.NewInt(2000000000),
+		GasLimit:  21000,
+		To:        "0x3535353535353535353535353535353535353535",
+		Value:     big.NewInt(10000000000),
+	}.Sign(key)
+	require.NoError(t, err)
+
+	var body services.TransactionBody
+	responses := [][]interface{}{{
+		func(request *services.Transaction) *services.TransactionResponse {
+			var signedTransaction services.SignedTransaction
+			require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTransaction))
+			require.NoError(t, protobuf.Unmarshal(signedTransaction.BodyBytes, &body))
+
+			return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK}
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	_, err = NewEthereumTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetEthereumData(ethereumData).
+		Execute(client)
+	require.NoError(t, err)
+
+	ethereum, err := _GetEthereumTransactionBody(&body)
+	require.NoError(t, err)
+	require.NotNil(t, ethereum)
+	require.Equal(t, ethereumData, ethereum.EthereumData)
+	require.Equal(t, RequestTypeEthereumTransaction, _RequestTypeForTransactionBody(&body))
+}
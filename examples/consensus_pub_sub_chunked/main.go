@@ -131,10 +131,10 @@ func main() {
 		return
 	}
 
-	// Interface{} back to TopicMessageSubmitTransaction
+	// SignableTransaction back to TopicMessageSubmitTransaction
 	switch temp := transactionFromBytes.(type) {
-	case hedera.TopicMessageSubmitTransaction:
-		transaction = &temp
+	case *hedera.TopicMessageSubmitTransaction:
+		transaction = temp
 	}
 
 	// Sign with that submit key
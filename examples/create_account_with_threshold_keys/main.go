@@ -125,10 +125,23 @@ func main() {
 	}
 
 	// Manually sign with 2 of the private keys provided in the threshold
-	transactionResponse, err = transferTx.
+	transferTx = transferTx.
 		Sign(keys[0]).
-		Sign(keys[1]).
-		Execute(client)
+		Sign(keys[1])
+
+	// Check locally that the signatures meet the threshold before submitting
+	status, err := hedera.EvaluateTransactionSignatures(thresholdPublicKeys, transferTx)
+	if err != nil {
+		println(err.Error(), ": error evaluating transfer signatures")
+		return
+	}
+
+	if !status.Satisfied {
+		fmt.Printf("transfer still needs signatures from: %v\n", status.MissingKeys)
+		return
+	}
+
+	transactionResponse, err = transferTx.Execute(client)
 	if err != nil {
 		println(err.Error(), ": error freezing create account transaction")
 		return
@@ -69,16 +69,16 @@ func main() {
 	fmt.Printf("Received bytes for signed transaction: \n%v\n", signedTxBytes)
 
 	// Unmarshal your bytes into the signed transaction
-	var signedTx hedera.TransferTransaction
+	var signedTx *hedera.TransferTransaction
 	tx, err := hedera.TransactionFromBytes(signedTxBytes)
 	if err != nil {
 		println(err.Error(), ": error converting bytes to transfer transaction")
 		return
 	}
 
-	// Converting from interface{} to TransferTransaction, if that's what we got
+	// Converting from SignableTransaction to TransferTransaction, if that's what we got
 	switch t := tx.(type) {
-	case hedera.TransferTransaction:
+	case *hedera.TransferTransaction:
 		signedTx = t
 	default:
 		panic("Did not receive `TransferTransaction` back from signed bytes")
@@ -116,15 +116,15 @@ func signingService(txBytes []byte) ([]byte, error) {
 	}
 
 	// Unmarshal the unsigned transaction's bytes
-	var unsignedTx hedera.TransferTransaction
+	var unsignedTx *hedera.TransferTransaction
 	tx, err := hedera.TransactionFromBytes(txBytes)
 	if err != nil {
 		return txBytes, err
 	}
 
-	// Converting from interface{} to TransferTransaction, if that's what we got
+	// Converting from SignableTransaction to TransferTransaction, if that's what we got
 	switch t := tx.(type) {
-	case hedera.TransferTransaction:
+	case *hedera.TransferTransaction:
 		unsignedTx = t
 	default:
 		panic("Did not receive `TransferTransaction` back from signed bytes")
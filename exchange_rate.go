@@ -22,6 +22,7 @@ package hedera
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
 	protobuf "google.golang.org/protobuf/proto"
@@ -57,6 +58,20 @@ func (exchange *ExchangeRate) _ToProtobuf() *services.ExchangeRate {
 	}
 }
 
+// GetCents returns the cents the rate's Hbars are worth
+func (exchange *ExchangeRate) GetCents() int32 {
+	return exchange.cents
+}
+
+// GetExpirationTime returns when the rate stops being in effect, or the zero time if it isn't known
+func (exchange *ExchangeRate) GetExpirationTime() time.Time {
+	if exchange.expirationTime == nil {
+		return time.Time{}
+	}
+
+	return time.Unix(exchange.expirationTime.Seconds, 0)
+}
+
 func (exchange *ExchangeRate) ToBytes() []byte {
 	data, err := protobuf.Marshal(exchange._ToProtobuf())
 	if err != nil {
@@ -0,0 +1,110 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// ExchangeRates are the current and next exchange rates of hbars to cents, as stored in file 0.0.112
+type ExchangeRates struct {
+	current *ExchangeRate
+	next    *ExchangeRate
+}
+
+func _ExchangeRatesFromProtobuf(exchangeRates *services.ExchangeRateSet) (ExchangeRates, error) {
+	if exchangeRates == nil {
+		return ExchangeRates{}, errParameterNull
+	}
+
+	var current *ExchangeRate
+	if exchangeRates.CurrentRate != nil {
+		rate := _ExchangeRateFromProtobuf(exchangeRates.CurrentRate)
+		current = &rate
+	}
+
+	var next *ExchangeRate
+	if exchangeRates.NextRate != nil {
+		rate := _ExchangeRateFromProtobuf(exchangeRates.NextRate)
+		next = &rate
+	}
+
+	return ExchangeRates{
+		current: current,
+		next:    next,
+	}, nil
+}
+
+func (exchangeRates ExchangeRates) _ToProtobuf() *services.ExchangeRateSet {
+	var current *services.ExchangeRate
+	if exchangeRates.current != nil {
+		current = exchangeRates.current._ToProtobuf()
+	}
+
+	var next *services.ExchangeRate
+	if exchangeRates.next != nil {
+		next = exchangeRates.next._ToProtobuf()
+	}
+
+	return &services.ExchangeRateSet{
+		CurrentRate: current,
+		NextRate:    next,
+	}
+}
+
+// GetCurrent returns the exchange rate in effect until its expiration time, or nil if there is none
+func (exchangeRates ExchangeRates) GetCurrent() *ExchangeRate {
+	return exchangeRates.current
+}
+
+// GetNext returns the exchange rate taking effect once the current one expires, or nil if there is none
+func (exchangeRates ExchangeRates) GetNext() *ExchangeRate {
+	return exchangeRates.next
+}
+
+func (exchangeRates ExchangeRates) ToBytes() []byte {
+	data, err := protobuf.Marshal(exchangeRates._ToProtobuf())
+	if err != nil {
+		return make([]byte, 0)
+	}
+
+	return data
+}
+
+func ExchangeRatesFromBytes(data []byte) (ExchangeRates, error) {
+	if data == nil {
+		return ExchangeRates{}, errByteArrayNull
+	}
+	pb := services.ExchangeRateSet{}
+	err := protobuf.Unmarshal(data, &pb)
+	if err != nil {
+		return ExchangeRates{}, err
+	}
+
+	return _ExchangeRatesFromProtobuf(&pb)
+}
+
+func (exchangeRates ExchangeRates) String() string {
+	return fmt.Sprintf("Current: %v, Next: %v", exchangeRates.current, exchangeRates.next)
+}
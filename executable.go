@@ -22,16 +22,10 @@ package hedera
 
 import (
 	"context"
-	"fmt"
-	"math"
-	"os"
 	"time"
 
 	protobuf "google.golang.org/protobuf/proto"
 
-	"github.com/rs/zerolog"
-	"github.com/rs/zerolog/log"
-
 	"github.com/pkg/errors"
 
 	"github.com/hashgraph/hedera-protobufs-go/services"
@@ -40,30 +34,6 @@ import (
 	"google.golang.org/grpc/status"
 )
 
-var logCtx zerolog.Logger
-
-// A required init function to setup logging at the correct level
-func init() { // nolint
-	zerolog.TimeFieldFormat = zerolog.TimeFormatUnix
-
-	if os.Getenv("HEDERA_SDK_GO_LOG_PRETTY") != "" {
-		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
-	}
-
-	switch os.Getenv("HEDERA_SDK_GO_LOG_LEVEL") {
-	case "DEBUG":
-		zerolog.SetGlobalLevel(zerolog.DebugLevel)
-	case "TRACE":
-		zerolog.SetGlobalLevel(zerolog.TraceLevel)
-	case "INFO":
-		zerolog.SetGlobalLevel(zerolog.InfoLevel)
-	default:
-		zerolog.SetGlobalLevel(zerolog.Disabled)
-	}
-
-	logCtx = log.With().Str("module", "hedera-sdk-go").Logger()
-}
-
 const maxAttempts = 10
 
 type _ExecutionState uint32
@@ -89,9 +59,10 @@ type _Method struct {
 }
 
 func _Execute( // nolint
+	ctx context.Context,
 	client *Client,
 	request interface{},
-	shouldRetry func(string, interface{}, interface{}) _ExecutionState,
+	shouldRetry func(Logger, string, interface{}, interface{}) _ExecutionState,
 	makeRequest func(interface{}) interface{},
 	advanceRequest func(interface{}),
 	getNodeAccountID func(interface{}) AccountID,
@@ -103,7 +74,7 @@ func _Execute( // nolint
 	maxBackoff *time.Duration,
 	minBackoff *time.Duration,
 	maxRetry int,
-) (interface{}, error) {
+) (result interface{}, resultErr error) {
 	var maxAttempts int
 
 	if client.maxAttempts != nil {
@@ -112,14 +83,48 @@ func _Execute( // nolint
 		maxAttempts = maxRetry
 	}
 
-	currentBackoff := minBackoff
+	retryPolicy := _GetRetryPolicy(client, request)
+	cassette := client.GetCassette()
+	logger := client.GetLogger()
+	tracer := client.GetTracer()
+	meter := client.GetMeter()
 
 	var attempt int64
 	var errPersistent error
+	var attemptSpan Span
+
+	endAttempt := func() {
+		if attemptSpan != nil {
+			attemptSpan.End()
+			attemptSpan = nil
+		}
+	}
+
+	start := time.Now()
+	ctx, span := tracer.Start(ctx, SpanExecute, Attr(AttributeRequestID, logID))
+	defer func() {
+		if attemptSpan != nil {
+			attemptSpan.RecordError(resultErr)
+		}
+		endAttempt()
+
+		span.SetAttributes(Attr(AttributeAttempt, attempt))
+		span.RecordError(resultErr)
+		span.End()
+
+		meter.RecordHistogram(ctx, MetricRequestDuration, _DurationMilliseconds(time.Since(start)), Attr(AttributeRequestID, logID))
+	}()
+
+	for attempt = int64(0); attempt < int64(maxAttempts); attempt++ {
+		endAttempt()
+
+		if ctx.Err() != nil {
+			return _ExecutableEmptyResponse(request), ctx.Err()
+		}
 
-	for attempt = int64(0); attempt < int64(maxAttempts); attempt, *currentBackoff = attempt+1, *currentBackoff*2 {
 		var protoRequest interface{}
 		var node *_Node
+		var err error
 
 		if transaction, ok := request.(*Transaction); ok {
 			if transaction.nodeAccountIDs.locked && transaction.nodeAccountIDs._Length() > 0 {
@@ -129,7 +134,9 @@ func _Execute( // nolint
 					return TransactionResponse{}, ErrInvalidNodeAccountIDSet{nodeAccountID}
 				}
 			} else {
-				node = client.network._GetNode()
+				if node, err = _ExecutableGetNode(client); err != nil {
+					return TransactionResponse{}, err
+				}
 				transaction.nodeAccountIDs._Set(0, node.accountID)
 				protoTransaction, _ := transaction._BuildTransaction(0)
 				protoRequest = protoTransaction
@@ -142,7 +149,9 @@ func _Execute( // nolint
 					return &services.Response{}, ErrInvalidNodeAccountIDSet{nodeAccountID}
 				}
 			} else {
-				node = client.network._GetNode()
+				if node, err = _ExecutableGetNode(client); err != nil {
+					return &services.Response{}, err
+				}
 				if len(query.paymentTransactions) > 0 {
 					var paymentTransaction services.TransactionBody
 					_ = protobuf.Unmarshal(query.paymentTransactions[0].BodyBytes, &paymentTransaction) // nolint
@@ -158,20 +167,36 @@ func _Execute( // nolint
 
 		node._InUse()
 
-		logCtx.Trace().Str("requestId", logID).Str("nodeAccountID", node.accountID.String()).Str("nodeIPAddress", node.address._String())
+		var attemptCtx context.Context
+		attemptCtx, attemptSpan = tracer.Start(ctx, SpanAttempt, Attr(AttributeNodeAccountID, node.accountID.String()), Attr(AttributeAttempt, attempt))
+		retryAttempt := func(reason string, attributes ...Attribute) {
+			attemptSpan.SetAttributes(append(attributes, Attr(AttributeRetryReason, reason))...)
+			meter.AddCounter(ctx, MetricRequestRetries, 1, Attr(AttributeNodeAccountID, node.accountID.String()), Attr(AttributeRetryReason, reason))
+		}
+
+		logger.Trace("sending request", "requestId", logID, "nodeAccountID", node.accountID.String(), "nodeIPAddress", node.address._String())
 
-		if !node._IsHealthy() {
-			logCtx.Trace().Str("requestId", logID).Str("delay", node._Wait().String()).Msg("node is unhealthy, waiting before continuing")
-			_DelayForAttempt(logID, minBackoff, maxBackoff, attempt)
+		// replayed calls don't reach the node, so its health doesn't matter
+		if !cassette._IsReplaying() && !node._IsHealthy() {
+			logger.Trace("node is unhealthy, waiting before continuing", "requestId", logID, "delay", node._Wait().String())
+			delay := retryPolicy.Delay(attempt, *minBackoff, *maxBackoff)
+			retryAttempt(RetryReasonNodeUnhealthy, Attr(AttributeBackoff, delay.String()))
+			if err := _DelayForAttempt(ctx, logger, logID, delay, attempt); err != nil {
+				return _ExecutableEmptyResponse(request), err
+			}
 			continue
 		}
 
-		logCtx.Trace().Str("requestId", logID).Msg("updating node account ID index")
+		logger.Trace("updating node account ID index", "requestId", logID)
 		advanceRequest(request)
 
-		channel, err := node._GetChannel()
+		channel, err := _ExecutableGetChannel(node, cassette, logger)
 		if err != nil {
+			attemptSpan.RecordError(err)
+			retryAttempt(RetryReasonChannelError)
+			client.network._ObserveNode(node, 0, true)
 			client.network._IncreaseBackoff(node)
+			_RecordNodeBackoff(ctx, meter, node)
 			continue
 		}
 
@@ -179,30 +204,70 @@ func _Execute( // nolint
 
 		var resp interface{}
 
-		ctx := context.TODO()
+		var grpcCtx context.Context
 		var cancel context.CancelFunc
 		if deadline != nil {
 			grpcDeadline := time.Now().Add(*deadline)
-			ctx, cancel = context.WithDeadline(ctx, grpcDeadline)
+			grpcCtx, cancel = context.WithDeadline(attemptCtx, grpcDeadline)
+		} else {
+			grpcCtx, cancel = context.WithCancel(attemptCtx)
 		}
 
-		logCtx.Trace().Str("requestId", logID).Msg("executing gRPC call")
-		if method.query != nil {
-			resp, err = method.query(ctx, protoRequest.(*services.Query))
-		} else {
-			pq := protoRequest.(*services.Transaction)
-			fmt.Printf("REQUEST TX: %+v\n", pq)
-			resp, err = method.transaction(ctx, protoRequest.(*services.Transaction))
+		nodeRequest := &NodeRequest{
+			NodeAccountID: node.accountID,
+			RequestType:   _RequestTypeForProtobuf(protoRequest),
+			Request:       protoRequest.(protobuf.Message),
+			Attempt:       attempt,
+		}
+
+		logger.Trace("executing gRPC call", "requestId", logID, "requestType", nodeRequest.RequestType.String())
+		invoker := _MethodInvoker(method)
+		if cassette != nil {
+			invoker = cassette._Invoker(invoker)
 		}
+		nodeResponse := _CheckNodeResponse(method, _InterceptorChain(client.interceptors, invoker)(grpcCtx, nodeRequest))
+		protoRequest = nodeRequest.Request
+		resp, err = nodeResponse.Response, nodeResponse.Err
 
-		if cancel != nil {
-			cancel()
+		cancel()
+
+		attemptSpan.SetAttributes(Attr(AttributeRequestType, nodeRequest.RequestType.String()))
+		meter.RecordHistogram(ctx, MetricNodeLatency, _DurationMilliseconds(nodeResponse.Latency),
+			Attr(AttributeNodeAccountID, node.accountID.String()),
+			Attr(AttributeRequestType, nodeRequest.RequestType.String()),
+		)
+
+		if ctx.Err() != nil {
+			return _ExecutableEmptyResponse(request), ctx.Err()
 		}
 
+		client.network._ObserveNode(node, nodeResponse.Latency, err != nil)
+
 		if err != nil {
+			if _, ok := err.(ErrCassetteMismatch); ok {
+				return _ExecutableEmptyResponse(request), err
+			}
+
 			errPersistent = err
-			if _ExecutableDefaultRetryHandler(logID, err) {
+			attemptSpan.SetAttributes(Attr(AttributeGrpcCode, status.Code(err).String()))
+			retry := _ExecutableDefaultRetryHandler(logger, logID, err)
+			switch retryPolicy.ShouldRetryError(attempt, err) {
+			case RetryDecisionRetry:
+				retry = true
+			case RetryDecisionRegenerateTransactionID:
+				// as for statuses, a request whose transaction ID can't be regenerated fails
+				retry = _ExecutableRegenerateTransactionID(client, request)
+				if retry {
+					logger.Trace("retry policy chose to regenerate the transaction ID after an error; regenerating", "requestId", logID)
+				}
+			case RetryDecisionFail:
+				retry = false
+			}
+
+			if retry {
+				retryAttempt(RetryReasonGrpcError)
 				client.network._IncreaseBackoff(node)
+				_RecordNodeBackoff(ctx, meter, node)
 				continue
 			}
 			if errPersistent == nil {
@@ -217,20 +282,39 @@ func _Execute( // nolint
 		}
 
 		node._DecreaseBackoff()
+		_RecordNodeBackoff(ctx, meter, node)
+
+		state := shouldRetry(logger, logID, request, resp)
+		statusErr, hasStatus := mapStatusError(request, resp).(ErrHederaPreCheckStatus)
+		if hasStatus {
+			attemptSpan.SetAttributes(Attr(AttributeStatus, statusErr.Status.String()))
+		}
+
+		if state != executionStateFinished {
+			if hasStatus {
+				decision := retryPolicy.ShouldRetryStatus(attempt, statusErr.Status)
+				logger.Trace("retry policy consulted", "requestId", logID, "status", statusErr.Status.String(), "decision", decision.String())
+				state = _ApplyRetryDecision(decision, state)
+			}
+		}
 
-		switch shouldRetry(logID, request, resp) {
+		switch state {
 		case executionStateRetry:
 			errPersistent = mapStatusError(request, resp)
-			_DelayForAttempt(logID, minBackoff, maxBackoff, attempt)
+			delay := retryPolicy.Delay(attempt, *minBackoff, *maxBackoff)
+			if cassette._IsReplaying() {
+				delay = 0
+			}
+			retryAttempt(RetryReasonStatus, Attr(AttributeBackoff, delay.String()))
+			if err := _DelayForAttempt(ctx, logger, logID, delay, attempt); err != nil {
+				return _ExecutableEmptyResponse(request), err
+			}
 			continue
 		case executionStateExpired:
-			if transaction, ok := request.(*Transaction); ok {
-				if !client.GetOperatorAccountID()._IsZero() && transaction.regenerateTransactionID && !transaction.transactionIDs.locked {
-					logCtx.Trace().Str("requestId", logID).Msg("received `TRANSACTION_EXPIRED` with transaction ID regeneration enabled; regenerating")
-					transaction.transactionIDs._Set(transaction.transactionIDs.index, TransactionIDGenerate(client.GetOperatorAccountID()))
-					if err != nil {
-						panic(err)
-					}
+			if _, ok := request.(*Transaction); ok {
+				if _ExecutableRegenerateTransactionID(client, request) {
+					logger.Trace("received `TRANSACTION_EXPIRED` with transaction ID regeneration enabled; regenerating", "requestId", logID)
+					retryAttempt(RetryReasonTransactionExpired)
 					continue
 				} else {
 					return TransactionResponse{}, mapStatusError(request, resp)
@@ -260,16 +344,65 @@ func _Execute( // nolint
 	return &services.Response{}, errors.Wrapf(errPersistent, "retry %d/%d", attempt, maxAttempts)
 }
 
-func _DelayForAttempt(logID string, minBackoff *time.Duration, maxBackoff *time.Duration, attempt int64) {
-	// 0.1s, 0.2s, 0.4s, 0.8s, ...
-	ms := int64(math.Min(float64(minBackoff.Milliseconds())*math.Pow(2, float64(attempt)), float64(maxBackoff.Milliseconds())))
-	logCtx.Trace().Str("requestId", logID).Dur("delay", time.Duration(ms)).Int64("attempt", attempt+1).Msg("retrying  request attempt")
-	time.Sleep(time.Duration(ms) * time.Millisecond)
+// _ExecutableRegenerateTransactionID gives a transaction a newly generated transaction ID, unless it isn't a
+// transaction, its ID was set by the caller or regeneration is disabled, and reports whether it did
+func _ExecutableRegenerateTransactionID(client *Client, request interface{}) bool {
+	transaction, ok := request.(*Transaction)
+	if !ok || client.GetOperatorAccountID()._IsZero() || !transaction.regenerateTransactionID || transaction.transactionIDs.locked {
+		return false
+	}
+
+	transaction.transactionIDs._Set(transaction.transactionIDs.index, TransactionIDGenerate(client.GetOperatorAccountID()))
+	return true
+}
+
+// _ExecutableGetNode returns the node to send a request to when none was set: the one the next interaction of the
+// client's cassette was recorded with when replaying, or the one chosen by the node selector
+func _ExecutableGetNode(client *Client) (*_Node, error) {
+	if node, ok := client.GetCassette()._GetReplayNode(&client.network); ok {
+		return node, nil
+	}
+
+	return client.network._GetNode()
+}
+
+// _ExecutableGetChannel returns the channel to a node, which isn't dialed when the cassette replays the call
+func _ExecutableGetChannel(node *_Node, cassette *Cassette, logger Logger) (*_Channel, error) {
+	if cassette._IsReplaying() {
+		return &_Channel{}, nil
+	}
+
+	return node._GetChannel(logger)
+}
+
+// _DelayForAttempt sleeps for the delay chosen by the retry policy, returning early with
+// the context's error if ctx is done first.
+func _DelayForAttempt(ctx context.Context, logger Logger, logID string, delay time.Duration, attempt int64) error {
+	logger.Trace("retrying request attempt", "requestId", logID, "delay", delay.String(), "attempt", attempt+1)
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// _ExecutableEmptyResponse returns the zero response `_Execute` callers expect for the request type
+func _ExecutableEmptyResponse(request interface{}) interface{} {
+	if _, ok := request.(*Transaction); ok {
+		return TransactionResponse{}
+	}
+
+	return &services.Response{}
 }
 
-func _ExecutableDefaultRetryHandler(logID string, err error) bool {
+func _ExecutableDefaultRetryHandler(logger Logger, logID string, err error) bool {
 	code := status.Code(err)
-	logCtx.Trace().Str("requestId", logID).Str("status", code.String()).Msg("received gRPC error with status code")
+	logger.Trace("received gRPC error with status code", "requestId", logID, "status", code.String())
 
 	switch code {
 	case codes.ResourceExhausted, codes.Unavailable:
@@ -0,0 +1,118 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// _FeeCache holds the fee schedules and exchange rates a client read from the network, which stay valid until their
+// current entries expire. A nil cache holds nothing.
+type _FeeCache struct {
+	mutex         sync.Mutex
+	feeSchedules  *FeeSchedules
+	exchangeRates *ExchangeRates
+}
+
+// _GetFeeSchedules returns the cached fee schedules, if the current one hasn't expired at now
+func (cache *_FeeCache) _GetFeeSchedules(now time.Time) (FeeSchedules, bool) {
+	if cache == nil {
+		return FeeSchedules{}, false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.feeSchedules == nil {
+		return FeeSchedules{}, false
+	}
+
+	current := cache.feeSchedules.current
+	if current == nil || current.ExpirationTime == nil || !now.Before(*current.ExpirationTime) {
+		return FeeSchedules{}, false
+	}
+
+	return *cache.feeSchedules, true
+}
+
+func (cache *_FeeCache) _SetFeeSchedules(feeSchedules FeeSchedules) {
+	if cache == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	cache.feeSchedules = &feeSchedules
+}
+
+// _GetExchangeRates returns the cached exchange rates, if the current one hasn't expired at now
+func (cache *_FeeCache) _GetExchangeRates(now time.Time) (ExchangeRates, bool) {
+	if cache == nil {
+		return ExchangeRates{}, false
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.exchangeRates == nil {
+		return ExchangeRates{}, false
+	}
+
+	current := cache.exchangeRates.current
+	if current == nil || !now.Before(current.GetExpirationTime()) {
+		return ExchangeRates{}, false
+	}
+
+	return *cache.exchangeRates, true
+}
+
+// _UpdateExchangeRates caches exchange rates, unless the cached current rate expires later than theirs, as rates
+// carried by receipts can be older than the ones already read from the network
+func (cache *_FeeCache) _UpdateExchangeRates(exchangeRates ExchangeRates) {
+	if cache == nil || exchangeRates.current == nil {
+		return
+	}
+
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+
+	if cache.exchangeRates != nil && cache.exchangeRates.current != nil &&
+		cache.exchangeRates.current.GetExpirationTime().After(exchangeRates.current.GetExpirationTime()) {
+		return
+	}
+
+	cache.exchangeRates = &exchangeRates
+}
+
+// _UpdateExchangeRatesFromReceipt caches the exchange rates every receipt carries, sparing a read of file 0.0.112
+func (client *Client) _UpdateExchangeRatesFromReceipt(receipt *services.TransactionReceipt) {
+	if receipt.GetExchangeRate() == nil {
+		return
+	}
+
+	if exchangeRates, err := _ExchangeRatesFromProtobuf(receipt.GetExchangeRate()); err == nil {
+		client.feeCache._UpdateExchangeRates(exchangeRates)
+	}
+}
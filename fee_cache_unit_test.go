@@ -0,0 +1,215 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _MockFileContents answers file contents queries with the contents of a file, counting the answers that aren't
+// cost answers
+func _MockFileContents(t *testing.T, fileID FileID, contents []byte, count *int) func(request *services.Query) *services.Response {
+	return func(request *services.Query) *services.Response {
+		query := request.GetFileGetContents()
+		require.NotNil(t, query)
+		require.Equal(t, fileID._ToProtobuf().String(), query.FileID.String())
+
+		responseType := query.Header.GetResponseType()
+		if responseType == services.ResponseType_ANSWER_ONLY {
+			*count++
+		}
+
+		return &services.Response{
+			Response: &services.Response_FileGetContents{
+				FileGetContents: &services.FileGetContentsResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: responseType, Cost: 2},
+					FileContents: &services.FileGetContentsResponse_FileContents{
+						FileID:   fileID._ToProtobuf(),
+						Contents: contents,
+					},
+				},
+			},
+		}
+	}
+}
+
+func _MockExchangeRates(cents int32, expirationTime time.Time) *services.ExchangeRateSet {
+	return &services.ExchangeRateSet{
+		CurrentRate: &services.ExchangeRate{HbarEquiv: 1, CentEquiv: cents, ExpirationTime: &services.TimestampSeconds{Seconds: expirationTime.Unix()}},
+		NextRate:    &services.ExchangeRate{HbarEquiv: 1, CentEquiv: cents + 1, ExpirationTime: &services.TimestampSeconds{Seconds: expirationTime.Add(time.Hour).Unix()}},
+	}
+}
+
+func TestUnitExchangeRatesFromBytes(t *testing.T) {
+	expirationTime := time.Unix(1650000000, 0)
+	exchangeRates, err := _ExchangeRatesFromProtobuf(_MockExchangeRates(12, expirationTime))
+	require.NoError(t, err)
+
+	exchangeRates, err = ExchangeRatesFromBytes(exchangeRates.ToBytes())
+	require.NoError(t, err)
+	require.NotNil(t, exchangeRates.GetCurrent())
+	require.NotNil(t, exchangeRates.GetNext())
+	assert.Equal(t, int32(1), exchangeRates.GetCurrent().Hbars)
+	assert.Equal(t, int32(12), exchangeRates.GetCurrent().GetCents())
+	assert.Equal(t, expirationTime, exchangeRates.GetCurrent().GetExpirationTime())
+	assert.Equal(t, int32(13), exchangeRates.GetNext().GetCents())
+	assert.Equal(t, expirationTime.Add(time.Hour), exchangeRates.GetNext().GetExpirationTime())
+
+	exchangeRates, err = ExchangeRatesFromBytes([]byte{})
+	require.NoError(t, err)
+	assert.Nil(t, exchangeRates.GetCurrent())
+	assert.Nil(t, exchangeRates.GetNext())
+	assert.Equal(t, "Current: <nil>, Next: <nil>", exchangeRates.String())
+
+	_, err = ExchangeRatesFromBytes(nil)
+	assert.Error(t, err)
+}
+
+func TestUnitMockClientGetExchangeRates(t *testing.T) {
+	contents, err := protobuf.Marshal(_MockExchangeRates(12, time.Now().Add(time.Hour)))
+	require.NoError(t, err)
+
+	count := 0
+	call := _MockFileContents(t, FileIDForExchangeRate(), contents, &count)
+	client, server := NewMockClientAndServer([][]interface{}{{call, call, call, call}})
+	defer server.Close()
+
+	exchangeRates, err := client.GetExchangeRates()
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), exchangeRates.GetCurrent().GetCents())
+	assert.Equal(t, int32(13), exchangeRates.GetNext().GetCents())
+
+	// the rates are cached until the current one expires
+	exchangeRates, err = client.GetExchangeRates()
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), exchangeRates.GetCurrent().GetCents())
+	assert.Equal(t, 1, count)
+}
+
+func TestUnitMockClientGetExchangeRatesExpired(t *testing.T) {
+	contents, err := protobuf.Marshal(_MockExchangeRates(12, time.Now().Add(-time.Minute)))
+	require.NoError(t, err)
+
+	count := 0
+	call := _MockFileContents(t, FileIDForExchangeRate(), contents, &count)
+	client, server := NewMockClientAndServer([][]interface{}{{call, call, call, call}})
+	defer server.Close()
+
+	_, err = client.GetExchangeRates()
+	require.NoError(t, err)
+	_, err = client.GetExchangeRates()
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
+
+func TestUnitMockClientGetFeeSchedules(t *testing.T) {
+	expirationTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	feeSchedules := FeeSchedules{
+		current: &FeeSchedule{TransactionFeeSchedules: testFeeSchedule.TransactionFeeSchedules, ExpirationTime: &expirationTime},
+		next:    &FeeSchedule{TransactionFeeSchedules: testFeeSchedule.TransactionFeeSchedules, ExpirationTime: &expirationTime},
+	}
+
+	count := 0
+	call := _MockFileContents(t, FileIDForFeeSchedule(), feeSchedules.ToBytes(), &count)
+	client, server := NewMockClientAndServer([][]interface{}{{call, call, call, call}})
+	defer server.Close()
+
+	result, err := client.GetFeeSchedules()
+	require.NoError(t, err)
+	require.NotNil(t, result.GetCurrent())
+	require.NotNil(t, result.GetNext())
+	assert.Equal(t, expirationTime, *result.GetCurrent().ExpirationTime)
+	assert.Equal(t, len(testFeeSchedule.TransactionFeeSchedules), len(result.GetCurrent().TransactionFeeSchedules))
+
+	_, err = client.GetFeeSchedules()
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestUnitMockClientExchangeRatesFromReceipt(t *testing.T) {
+	expirationTime := time.Now().Add(time.Hour).Truncate(time.Second)
+	receipt := func(exchangeRates *services.ExchangeRateSet) *services.Response {
+		return &services.Response{
+			Response: &services.Response_TransactionGetReceipt{
+				TransactionGetReceipt: &services.TransactionGetReceiptResponse{
+					Header: &services.ResponseHeader{ResponseType: services.ResponseType_ANSWER_ONLY},
+					Receipt: &services.TransactionReceipt{
+						Status:       services.ResponseCodeEnum_SUCCESS,
+						ExchangeRate: exchangeRates,
+					},
+				},
+			},
+		}
+	}
+
+	client, server := NewMockClientAndServer([][]interface{}{{
+		receipt(_MockExchangeRates(12, expirationTime)),
+		receipt(_MockExchangeRates(11, expirationTime.Add(-time.Hour))),
+		receipt(nil),
+	}})
+	defer server.Close()
+
+	for i := 0; i < 3; i++ {
+		_, err := NewTransactionReceiptQuery().
+			SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+			SetNodeAccountIDs([]AccountID{{Account: 3}}).
+			Execute(client)
+		require.NoError(t, err)
+	}
+
+	// the older rates of the second receipt don't replace the ones of the first, and no file is read
+	exchangeRates, err := client.GetExchangeRates()
+	require.NoError(t, err)
+	assert.Equal(t, int32(12), exchangeRates.GetCurrent().GetCents())
+	assert.Equal(t, expirationTime, exchangeRates.GetCurrent().GetExpirationTime())
+	assert.Equal(t, int32(13), exchangeRates.GetNext().GetCents())
+}
+
+func TestUnitEstimateFeeWithClient(t *testing.T) {
+	transaction := _FrozenTestTransfer(t)
+
+	_, err := EstimateFeeWithClient(transaction, nil)
+	assert.Equal(t, errNoClientProvided, err)
+
+	expirationTime := time.Now().Add(time.Hour)
+	feeSchedule := FeeSchedule{TransactionFeeSchedules: testFeeSchedule.TransactionFeeSchedules, ExpirationTime: &expirationTime}
+	exchangeRate := _ExchangeRateFromProtobuf(_MockExchangeRates(12, expirationTime).CurrentRate)
+
+	// the cached schedule and rate are used without reading the files
+	client := ClientForNetwork(map[string]AccountID{})
+	client.feeCache._SetFeeSchedules(FeeSchedules{current: &feeSchedule})
+	client.feeCache._UpdateExchangeRates(ExchangeRates{current: &exchangeRate})
+
+	estimate, err := EstimateFeeWithClient(transaction, client)
+	require.NoError(t, err)
+	expected, err := EstimateFee(transaction, feeSchedule, exchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, expected, estimate)
+}
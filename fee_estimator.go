@@ -0,0 +1,419 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// The sizes and durations nodes assume when working out what a transaction uses
+const (
+	_FeeDivisorFactor              = 1000
+	_FeeIntSize                    = 4
+	_FeeBasicAccountAmountSize     = 32
+	_FeeBasicReceiptSize           = 36
+	_FeeBasicTransactionRecordSize = 132
+	_FeeReceiptStorageTime         = 180 * time.Second
+	_FeeDefaultAutoRenewPeriod     = 7890000 * time.Second
+	_FeeScheduleLifetime           = 30 * time.Minute
+	_FeeSignatureSize              = 64
+)
+
+// FeeUsage is how much of each resource priced by FeeComponents a transaction uses.
+type FeeUsage struct {
+	TransactionBandwidthByte   int64
+	TransactionVerification    int64
+	TransactionRamByteHour     int64
+	TransactionStorageByteHour int64
+	ContractTransactionGas     int64
+	TransferVolumeHbar         int64
+	ResponseMemoryByte         int64
+	ResponseDiscByte           int64
+}
+
+func (usage FeeUsage) String() string {
+	return fmt.Sprintf("TransactionBandwidthByte: %d, TransactionVerification: %d, TransactionRamByteHour: %d, TransactionStorageByteHour: %d, ContractTransactionGas: %d, TransferVolumeHbar: %d, ResponseMemoryByte: %d, ResponseDiscByte: %d", usage.TransactionBandwidthByte, usage.TransactionVerification, usage.TransactionRamByteHour, usage.TransactionStorageByteHour, usage.ContractTransactionGas, usage.TransferVolumeHbar, usage.ResponseMemoryByte, usage.ResponseDiscByte)
+}
+
+// FeeEstimate is the fee a transaction is expected to be charged, as returned by EstimateFee: the sum of what the
+// node it's sent to, the network and the service it's for charge for what it uses of each.
+type FeeEstimate struct {
+	RequestType RequestType
+	// The kind of transaction the fees were taken from
+	SubType FeeDataType
+
+	NodeUsage    FeeUsage
+	NetworkUsage FeeUsage
+	ServiceUsage FeeUsage
+
+	NodeFee    Hbar
+	NetworkFee Hbar
+	ServiceFee Hbar
+}
+
+// Total returns the whole fee, which is what `SetMaxTransactionFee()` should allow for.
+func (estimate FeeEstimate) Total() Hbar {
+	return HbarFromTinybar(estimate.NodeFee.AsTinybar() + estimate.NetworkFee.AsTinybar() + estimate.ServiceFee.AsTinybar())
+}
+
+func (estimate FeeEstimate) String() string {
+	return fmt.Sprintf("RequestType: %s, SubType: %s, NodeFee: %s, NetworkFee: %s, ServiceFee: %s, Total: %s", estimate.RequestType.String(), estimate.SubType.String(), estimate.NodeFee.String(), estimate.NetworkFee.String(), estimate.ServiceFee.String(), estimate.Total().String())
+}
+
+// EstimateFee works out locally the fee a frozen transaction of any type will be charged, from the fee schedule and
+// exchange rate in effect, as nodes do: from the size of its body and signatures, the number of signatures, its memo,
+// and what it stores and for how long, priced with the fees of its RequestType.
+//
+// The transaction is priced with the signatures it has, and at least one for its payer. Sign it with every key
+// before estimating, or the fee of the signatures still missing won't be included. Custom fees of tokens aren't
+// known locally, so token transactions are priced as if the token had none.
+func EstimateFee(transaction interface{}, feeSchedule FeeSchedule, exchangeRate ExchangeRate) (FeeEstimate, error) {
+	tx, err := _TransactionAsSignable(transaction)
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	if !tx.IsFrozen() {
+		return FeeEstimate{}, errTransactionIsNotFrozen
+	}
+	if exchangeRate.Hbars <= 0 || exchangeRate.cents <= 0 {
+		return FeeEstimate{}, errInvalidExchangeRate
+	}
+
+	bodyBytes := tx.GetTransactionBodyBytes()
+	var body services.TransactionBody
+	if err = protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return FeeEstimate{}, err
+	}
+
+	requestType := _RequestTypeForTransactionBody(&body)
+	feeData, err := feeSchedule._GetFeeData(requestType, _FeeDataTypeForTransactionBody(&body))
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	signatures := _FeeSignatureMap(tx._GetTransaction().publicKeys)
+	estimate := FeeEstimate{
+		RequestType: requestType,
+		SubType:     feeData.SubType,
+	}
+	estimate.NodeUsage, estimate.NetworkUsage, estimate.ServiceUsage = _FeeUsageForTransaction(&body,
+		int64(len(bodyBytes)), int64(len(signatures.SigPair)), int64(protobuf.Size(signatures)))
+
+	estimate.NodeFee = _FeeInHbar(feeData.NodeData, estimate.NodeUsage, exchangeRate)
+	estimate.NetworkFee = _FeeInHbar(feeData.NetworkData, estimate.NetworkUsage, exchangeRate)
+	estimate.ServiceFee = _FeeInHbar(feeData.ServiceData, estimate.ServiceUsage, exchangeRate)
+
+	return estimate, nil
+}
+
+// EstimateFeeWithClient is EstimateFee with the fee schedule and exchange rate currently in effect on the client's
+// network, as cached by Client.GetFeeSchedules and Client.GetExchangeRates.
+func EstimateFeeWithClient(transaction interface{}, client *Client) (FeeEstimate, error) {
+	if client == nil {
+		return FeeEstimate{}, errNoClientProvided
+	}
+
+	feeSchedules, err := client.GetFeeSchedules()
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+	exchangeRates, err := client.GetExchangeRates()
+	if err != nil {
+		return FeeEstimate{}, err
+	}
+
+	// a missing schedule or rate is rejected by EstimateFee, as having no fees or an invalid rate
+	var feeSchedule FeeSchedule
+	if feeSchedules.current != nil {
+		feeSchedule = *feeSchedules.current
+	}
+	var exchangeRate ExchangeRate
+	if exchangeRates.current != nil {
+		exchangeRate = *exchangeRates.current
+	}
+
+	return EstimateFee(transaction, feeSchedule, exchangeRate)
+}
+
+// _GetFeeData returns the fees of a request type for a kind of transaction, or its default fees if the kind isn't
+// priced separately
+func (feeSchedule FeeSchedule) _GetFeeData(requestType RequestType, subType FeeDataType) (FeeData, error) {
+	for _, txFeeSchedule := range feeSchedule.TransactionFeeSchedules {
+		if txFeeSchedule.RequestType != requestType {
+			continue
+		}
+
+		var defaultFeeData *FeeData
+		for _, feeData := range txFeeSchedule.Fees {
+			if feeData == nil {
+				continue
+			}
+			if feeData.SubType == subType {
+				return *feeData, nil
+			}
+			if feeData.SubType == FeeDataTypeDefault && defaultFeeData == nil {
+				defaultFeeData = feeData
+			}
+		}
+
+		if defaultFeeData != nil {
+			return *defaultFeeData, nil
+		}
+		if txFeeSchedule.FeeData != nil { // nolint
+			return *txFeeSchedule.FeeData, nil // nolint
+		}
+	}
+
+	return FeeData{}, errors.Wrapf(errFeeDataNotFound, "%s", requestType.String())
+}
+
+// _FeeDataTypeForTransactionBody returns the kind of transaction a body is priced as
+func _FeeDataTypeForTransactionBody(body *services.TransactionBody) FeeDataType {
+	switch data := body.Data.(type) {
+	case *services.TransactionBody_CryptoTransfer:
+		subType := FeeDataTypeDefault
+		for _, tokenTransfers := range data.CryptoTransfer.GetTokenTransfers() {
+			if len(tokenTransfers.NftTransfers) > 0 {
+				return FeeDataTypeTokenNonFungibleUnique
+			}
+			subType = FeeDataTypeTokenFungibleCommon
+		}
+		return subType
+	case *services.TransactionBody_TokenCreation:
+		nonFungible := data.TokenCreation.TokenType == services.TokenType_NON_FUNGIBLE_UNIQUE
+		switch {
+		case nonFungible && len(data.TokenCreation.CustomFees) > 0:
+			return FeeDataTypeTokenNonFungibleUniqueWithCustomFees
+		case nonFungible:
+			return FeeDataTypeTokenNonFungibleUnique
+		case len(data.TokenCreation.CustomFees) > 0:
+			return FeeDataTypeTokenFungibleCommonWithCustomFees
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenMint:
+		if len(data.TokenMint.Metadata) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenBurn:
+		if len(data.TokenBurn.SerialNumbers) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	case *services.TransactionBody_TokenWipe:
+		if len(data.TokenWipe.SerialNumbers) > 0 {
+			return FeeDataTypeTokenNonFungibleUnique
+		}
+		return FeeDataTypeTokenFungibleCommon
+	}
+
+	return FeeDataTypeDefault
+}
+
+// _FeeSignatureMap returns a signature map the size of the one a transaction signed by publicKeys is sent with,
+// which has at least the payer's signature
+func _FeeSignatureMap(publicKeys []PublicKey) *services.SignatureMap {
+	signatures := services.SignatureMap{SigPair: make([]*services.SignaturePair, 0)}
+	for _, publicKey := range publicKeys {
+		signatures.SigPair = append(signatures.SigPair, publicKey._ToSignaturePairProtobuf(make([]byte, _FeeSignatureSize)))
+	}
+
+	if len(signatures.SigPair) == 0 {
+		signatures.SigPair = append(signatures.SigPair, &services.SignaturePair{
+			PubKeyPrefix: make([]byte, 32),
+			Signature:    &services.SignaturePair_Ed25519{Ed25519: make([]byte, _FeeSignatureSize)},
+		})
+	}
+
+	return &signatures
+}
+
+// _FeeUsageForTransaction returns what a transaction uses of what the node it's sent to, the network and the service
+// it's for charge for
+func _FeeUsageForTransaction(body *services.TransactionBody, bodySize int64, signatureCount int64, signatureSize int64) (FeeUsage, FeeUsage, FeeUsage) {
+	bandwidth := bodySize + signatureSize
+
+	node := FeeUsage{
+		TransactionBandwidthByte: bandwidth,
+		// only the payer's signature is checked by the node
+		TransactionVerification: 1,
+		ResponseMemoryByte:      _FeeIntSize,
+	}
+
+	network := FeeUsage{
+		TransactionBandwidthByte: bandwidth,
+		TransactionVerification:  signatureCount,
+		TransactionRamByteHour:   _FeeByteHours(_FeeBasicReceiptSize, _FeeReceiptStorageTime),
+	}
+
+	service := _FeeServiceUsage(body)
+	record := _FeeBasicTransactionRecordSize + int64(len(body.Memo)) + _FeeBasicAccountAmountSize*_FeeTransferCount(body)
+	service.TransactionRamByteHour += _FeeByteHours(record, _FeeReceiptStorageTime)
+
+	return node, network, service
+}
+
+// _FeeServiceUsage returns what a transaction uses of the service it's for, beyond the record kept of it: the gas
+// of contract calls, and the entities it keeps in memory or storage, for as long as it keeps them
+func _FeeServiceUsage(body *services.TransactionBody) FeeUsage {
+	usage := FeeUsage{}
+	validStart := _TimeFromProtobuf(body.TransactionID.GetTransactionValidStart())
+
+	switch data := body.Data.(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		usage.TransactionRamByteHour = _FeeByteHours(int64(protobuf.Size(data.CryptoCreateAccount)),
+			_FeeDurationOrDefault(data.CryptoCreateAccount.AutoRenewPeriod))
+	case *services.TransactionBody_FileCreate:
+		usage.TransactionStorageByteHour = _FeeByteHours(int64(protobuf.Size(data.FileCreate)),
+			_FeeLifetime(validStart, data.FileCreate.ExpirationTime))
+	case *services.TransactionBody_FileUpdate:
+		usage.TransactionStorageByteHour = _FeeByteHours(int64(len(data.FileUpdate.Contents)+protobuf.Size(data.FileUpdate.Keys)),
+			_FeeLifetime(validStart, data.FileUpdate.ExpirationTime))
+	case *services.TransactionBody_FileAppend:
+		usage.TransactionStorageByteHour = _FeeByteHours(int64(len(data.FileAppend.Contents)), _FeeDefaultAutoRenewPeriod)
+	case *services.TransactionBody_ConsensusCreateTopic:
+		usage.TransactionRamByteHour = _FeeByteHours(int64(protobuf.Size(data.ConsensusCreateTopic)),
+			_FeeDurationOrDefault(data.ConsensusCreateTopic.AutoRenewPeriod))
+	case *services.TransactionBody_TokenCreation:
+		usage.TransactionRamByteHour = _FeeByteHours(int64(protobuf.Size(data.TokenCreation)),
+			_FeeDurationOrDefault(data.TokenCreation.AutoRenewPeriod))
+	case *services.TransactionBody_ScheduleCreate:
+		usage.TransactionRamByteHour = _FeeByteHours(int64(protobuf.Size(data.ScheduleCreate)), _FeeScheduleLifetime)
+	case *services.TransactionBody_ContractCreateInstance:
+		usage.ContractTransactionGas = data.ContractCreateInstance.Gas
+		usage.TransactionRamByteHour = _FeeByteHours(int64(protobuf.Size(data.ContractCreateInstance)),
+			_FeeDurationOrDefault(data.ContractCreateInstance.AutoRenewPeriod))
+	case *services.TransactionBody_ContractCall:
+		usage.ContractTransactionGas = data.ContractCall.Gas
+	}
+
+	// the call data may be in a file, but the gas limit is always in the Ethereum transaction
+	if ethereum, _ := _GetEthereumTransactionBody(body); ethereum != nil {
+		if ethereumData, _, err := EthereumTransactionDataFromBytes(ethereum.EthereumData); err == nil {
+			usage.ContractTransactionGas = int64(ethereumData.GasLimit)
+		}
+	}
+
+	return usage
+}
+
+// _FeeTransferCount returns the number of transfers a transaction makes explicitly, each of which is kept in its
+// record
+func _FeeTransferCount(body *services.TransactionBody) int64 {
+	transfer := body.GetCryptoTransfer()
+	if transfer == nil {
+		return 0
+	}
+
+	count := int64(len(transfer.GetTransfers().GetAccountAmounts()))
+	for _, tokenTransfers := range transfer.TokenTransfers {
+		count += int64(len(tokenTransfers.Transfers) + len(tokenTransfers.NftTransfers))
+	}
+
+	return count
+}
+
+// _FeeByteHours returns the byte-hours of keeping bytes for a duration, rounded up
+func _FeeByteHours(bytes int64, duration time.Duration) int64 {
+	seconds := int64(duration / time.Second)
+	if bytes <= 0 || seconds <= 0 {
+		return 0
+	}
+
+	return (bytes*seconds + 3599) / 3600
+}
+
+func _FeeDurationOrDefault(duration *services.Duration) time.Duration {
+	if duration == nil || duration.Seconds <= 0 {
+		return _FeeDefaultAutoRenewPeriod
+	}
+
+	return _DurationFromProtobuf(duration)
+}
+
+// _FeeLifetime returns how long an entity expiring at expirationTime is kept from validStart, or the default auto
+// renew period if it doesn't say
+func _FeeLifetime(validStart time.Time, expirationTime *services.Timestamp) time.Duration {
+	if expirationTime == nil {
+		return _FeeDefaultAutoRenewPeriod
+	}
+
+	if lifetime := _TimeFromProtobuf(expirationTime).Sub(validStart); lifetime > 0 {
+		return lifetime
+	}
+
+	return 0
+}
+
+// _FeeInTinycents returns the price of a usage, as nodes compute it: the sum of each component's price per unit
+// times the units used, which are in thousandths of a tinycent, kept within the minimum and maximum. A zero maximum
+// is taken as none.
+func _FeeInTinycents(feeComponents *FeeComponents, usage FeeUsage) *big.Int {
+	if feeComponents == nil {
+		return big.NewInt(0)
+	}
+
+	total := big.NewInt(feeComponents.Constant)
+	for _, term := range [][2]int64{
+		{feeComponents.TransactionBandwidthByte, usage.TransactionBandwidthByte},
+		{feeComponents.TransactionVerification, usage.TransactionVerification},
+		{feeComponents.TransactionRamByteHour, usage.TransactionRamByteHour},
+		{feeComponents.TransactionStorageByteHour, usage.TransactionStorageByteHour},
+		{feeComponents.ContractTransactionGas, usage.ContractTransactionGas},
+		{feeComponents.TransferVolumeHbar, usage.TransferVolumeHbar},
+		{feeComponents.ResponseMemoryByte, usage.ResponseMemoryByte},
+		{feeComponents.ResponseDiscByte, usage.ResponseDiscByte},
+	} {
+		total.Add(total, new(big.Int).Mul(big.NewInt(term[0]), big.NewInt(term[1])))
+	}
+
+	if minimum := big.NewInt(feeComponents.Min); total.Cmp(minimum) < 0 {
+		total = minimum
+	} else if maximum := big.NewInt(feeComponents.Max); feeComponents.Max > 0 && total.Cmp(maximum) > 0 {
+		total = maximum
+	}
+
+	if total.Sign() <= 0 {
+		return big.NewInt(0)
+	}
+
+	// anything above nothing costs at least a tinycent
+	fee := total.Quo(total, big.NewInt(_FeeDivisorFactor))
+	if fee.Sign() == 0 {
+		fee.SetInt64(1)
+	}
+
+	return fee
+}
+
+// _FeeInHbar returns the price of a usage converted to hbars at an exchange rate
+func _FeeInHbar(feeComponents *FeeComponents, usage FeeUsage, exchangeRate ExchangeRate) Hbar {
+	tinycents := _FeeInTinycents(feeComponents, usage)
+	tinybars := tinycents.Mul(tinycents, big.NewInt(int64(exchangeRate.Hbars)))
+	tinybars.Quo(tinybars, big.NewInt(int64(exchangeRate.cents)))
+
+	return HbarFromTinybar(tinybars.Int64())
+}
@@ -0,0 +1,202 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// 1 hbar = 12 cents
+var testFeeExchangeRate = ExchangeRate{Hbars: 1, cents: 12}
+
+func _TestFeeData(subType FeeDataType, constant int64) *FeeData {
+	return &FeeData{
+		NodeData:    &FeeComponents{Constant: constant, TransactionBandwidthByte: 1000, TransactionVerification: 2000, Max: 1000000000000},
+		NetworkData: &FeeComponents{TransactionBandwidthByte: 3000, TransactionVerification: 4000, TransactionRamByteHour: 5000, Max: 1000000000000},
+		ServiceData: &FeeComponents{TransactionRamByteHour: 6000, TransactionStorageByteHour: 7000, ContractTransactionGas: 8000, Max: 1000000000000},
+		SubType:     subType,
+	}
+}
+
+var testFeeSchedule = FeeSchedule{
+	TransactionFeeSchedules: []TransactionFeeSchedule{
+		{
+			RequestType: RequestTypeCryptoTransfer,
+			Fees:        []*FeeData{_TestFeeData(FeeDataTypeDefault, 1000000), _TestFeeData(FeeDataTypeTokenFungibleCommon, 2000000)},
+		},
+		{
+			RequestType: RequestTypeFileCreate,
+			FeeData:     _TestFeeData(FeeDataTypeDefault, 3000000),
+		},
+	},
+}
+
+func _FrozenTestTransfer(t *testing.T) *TransferTransaction {
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		SetTransactionMemo("fee").
+		AddHbarTransfer(AccountID{Account: 1800}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 1801}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	return transaction
+}
+
+func TestUnitEstimateFeeTransfer(t *testing.T) {
+	transaction := _FrozenTestTransfer(t)
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	transaction.Sign(key)
+
+	estimate, err := EstimateFee(transaction, testFeeSchedule, testFeeExchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, RequestTypeCryptoTransfer, estimate.RequestType)
+	assert.Equal(t, FeeDataTypeDefault, estimate.SubType)
+
+	signatures := protobuf.Size(&services.SignatureMap{SigPair: []*services.SignaturePair{key.PublicKey()._ToSignaturePairProtobuf(make([]byte, 64))}})
+	bandwidth := int64(len(transaction.GetTransactionBodyBytes()) + signatures)
+	assert.Equal(t, FeeUsage{TransactionBandwidthByte: bandwidth, TransactionVerification: 1, ResponseMemoryByte: 4}, estimate.NodeUsage)
+	// a receipt of 36 bytes kept 180 seconds
+	assert.Equal(t, FeeUsage{TransactionBandwidthByte: bandwidth, TransactionVerification: 1, TransactionRamByteHour: 2}, estimate.NetworkUsage)
+	// a record of 132 bytes, the memo and 2 transfers of 32 bytes kept 180 seconds
+	assert.Equal(t, FeeUsage{TransactionRamByteHour: 10}, estimate.ServiceUsage)
+
+	// thousandths of tinycents to tinycents, and tinycents to tinybars at 12 tinycents a tinybar
+	assert.Equal(t, HbarFromTinybar((1000000+1000*bandwidth+2000)/1000/12), estimate.NodeFee)
+	assert.Equal(t, HbarFromTinybar((3000*bandwidth+4000+5000*2)/1000/12), estimate.NetworkFee)
+	assert.Equal(t, HbarFromTinybar(6000*10/1000/12), estimate.ServiceFee)
+	assert.Equal(t, estimate.NodeFee.AsTinybar()+estimate.NetworkFee.AsTinybar()+estimate.ServiceFee.AsTinybar(), estimate.Total().AsTinybar())
+}
+
+func TestUnitEstimateFeeSignatures(t *testing.T) {
+	transaction := _FrozenTestTransfer(t)
+
+	// the payer's signature is always counted
+	estimate, err := EstimateFee(transaction, testFeeSchedule, testFeeExchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), estimate.NetworkUsage.TransactionVerification)
+	unsigned := estimate.NetworkUsage.TransactionBandwidthByte
+
+	for i := 0; i < 2; i++ {
+		key, err := PrivateKeyGenerateEcdsa()
+		require.NoError(t, err)
+		transaction.Sign(key)
+	}
+
+	estimate, err = EstimateFee(*transaction, testFeeSchedule, testFeeExchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), estimate.NetworkUsage.TransactionVerification)
+	assert.Equal(t, int64(1), estimate.NodeUsage.TransactionVerification)
+	assert.True(t, estimate.NetworkUsage.TransactionBandwidthByte > unsigned)
+}
+
+func TestUnitEstimateFeeSubType(t *testing.T) {
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddTokenTransfer(TokenID{Token: 100}, AccountID{Account: 1800}, -1).
+		AddTokenTransfer(TokenID{Token: 100}, AccountID{Account: 1801}, 1).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err := EstimateFee(transaction, testFeeSchedule, testFeeExchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeTokenFungibleCommon, estimate.SubType)
+
+	// NFT transfers aren't priced separately by the schedule, so they get the default fees
+	nftTransaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 1800})).
+		AddNftTransfer(NftID{TokenID: TokenID{Token: 100}, SerialNumber: 1}, AccountID{Account: 1800}, AccountID{Account: 1801}).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err = EstimateFee(nftTransaction, testFeeSchedule, testFeeExchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeDefault, estimate.SubType)
+}
+
+func TestUnitEstimateFeeFileStorage(t *testing.T) {
+	transactionID := TransactionIDGenerate(AccountID{Account: 1800})
+	transaction, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(transactionID).
+		SetContents(make([]byte, 1000)).
+		SetExpirationTime(transactionID.ValidStart.Add(2 * time.Hour)).
+		Freeze()
+	require.NoError(t, err)
+
+	estimate, err := EstimateFee(transaction, testFeeSchedule, testFeeExchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, FeeDataTypeDefault, estimate.SubType)
+
+	var body services.TransactionBody
+	require.NoError(t, protobuf.Unmarshal(transaction.GetTransactionBodyBytes(), &body))
+	assert.Equal(t, int64(protobuf.Size(body.GetFileCreate())*2), estimate.ServiceUsage.TransactionStorageByteHour)
+}
+
+func TestUnitEstimateFeeErrors(t *testing.T) {
+	_, err := EstimateFee(NewTransferTransaction(), testFeeSchedule, testFeeExchangeRate)
+	assert.Equal(t, errTransactionIsNotFrozen, err)
+
+	_, err = EstimateFee(_FrozenTestTransfer(t), testFeeSchedule, ExchangeRate{})
+	assert.Equal(t, errInvalidExchangeRate, err)
+
+	_, err = EstimateFee(_FrozenTestTransfer(t), FeeSchedule{}, testFeeExchangeRate)
+	assert.Error(t, err)
+
+	_, err = EstimateFee("transfer", testFeeSchedule, testFeeExchangeRate)
+	assert.Error(t, err)
+}
+
+func TestUnitFeeInTinycents(t *testing.T) {
+	components := FeeComponents{Constant: 5000, TransactionBandwidthByte: 10, Min: 6000, Max: 20000}
+
+	assert.Equal(t, int64(6), _FeeInTinycents(&components, FeeUsage{}).Int64())
+	assert.Equal(t, int64(7), _FeeInTinycents(&components, FeeUsage{TransactionBandwidthByte: 200}).Int64())
+	assert.Equal(t, int64(20), _FeeInTinycents(&components, FeeUsage{TransactionBandwidthByte: 10000}).Int64())
+
+	// anything above nothing costs at least a tinycent
+	assert.Equal(t, int64(1), _FeeInTinycents(&FeeComponents{Constant: 1}, FeeUsage{}).Int64())
+	assert.Equal(t, int64(0), _FeeInTinycents(&FeeComponents{}, FeeUsage{}).Int64())
+}
+
+func TestUnitTransactionFeeScheduleWithoutFeeData(t *testing.T) {
+	schedule, err := _TransactionFeeScheduleFromProtobuf(&services.TransactionFeeSchedule{
+		HederaFunctionality: services.HederaFunctionality_CryptoTransfer,
+		Fees:                []*services.FeeData{_TestFeeData(FeeDataTypeTokenFungibleCommon, 1)._ToProtobuf()},
+	})
+	require.NoError(t, err)
+	assert.Nil(t, schedule.FeeData) // nolint
+	require.Len(t, schedule.Fees, 1)
+	assert.Equal(t, FeeDataTypeTokenFungibleCommon, schedule.Fees[0].SubType)
+}
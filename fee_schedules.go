@@ -77,6 +77,16 @@ func (feeSchedules FeeSchedules) _ToProtobuf() *services.CurrentAndNextFeeSchedu
 	}
 }
 
+// GetCurrent returns the fee schedule in effect until its expiration time
+func (feeSchedules FeeSchedules) GetCurrent() *FeeSchedule {
+	return feeSchedules.current
+}
+
+// GetNext returns the fee schedule taking effect once the current one expires
+func (feeSchedules FeeSchedules) GetNext() *FeeSchedule {
+	return feeSchedules.next
+}
+
 func (feeSchedules FeeSchedules) ToBytes() []byte {
 	data, err := protobuf.Marshal(feeSchedules._ToProtobuf())
 	if err != nil {
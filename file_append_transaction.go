@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -224,9 +225,38 @@ func (transaction *FileAppendTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *FileAppendTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *FileAppendTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *FileAppendTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *FileAppendTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *FileAppendTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *FileAppendTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -236,7 +266,7 @@ func (transaction *FileAppendTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
-	list, err := transaction.ExecuteAll(client)
+	list, err := transaction.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		if len(list) > 0 {
@@ -258,6 +288,15 @@ func (transaction *FileAppendTransaction) Execute(
 // ExecuteAll executes the all the Transactions with the provided client
 func (transaction *FileAppendTransaction) ExecuteAll(
 	client *Client,
+) ([]TransactionResponse, error) {
+	return transaction.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes all the Transactions with the provided client,
+// stopping any in-flight request or backoff once ctx is done.
+func (transaction *FileAppendTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
 ) ([]TransactionResponse, error) {
 	if client == nil || client.operator == nil {
 		return []TransactionResponse{}, errNoClientProvided
@@ -289,6 +328,7 @@ func (transaction *FileAppendTransaction) ExecuteAll(
 
 	for i := 0; i < size; i++ {
 		resp, err := _Execute(
+			ctx,
 			client,
 			&transaction.Transaction,
 			_TransactionShouldRetry,
@@ -314,7 +354,7 @@ func (transaction *FileAppendTransaction) ExecuteAll(
 		_, err = NewTransactionReceiptQuery().
 			SetNodeAccountIDs([]AccountID{resp.(TransactionResponse).NodeID}).
 			SetTransactionID(resp.(TransactionResponse).TransactionID).
-			Execute(client)
+			ExecuteWithContext(ctx, client)
 		if err != nil {
 			return list, err
 		}
@@ -407,6 +447,11 @@ func (transaction *FileAppendTransaction) FreezeWith(client *Client) (*FileAppen
 	return transaction, nil
 }
 
+func (transaction *FileAppendTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *FileAppendTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -508,6 +553,12 @@ func (transaction *FileAppendTransaction) AddSignature(publicKey PublicKey, sign
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *FileAppendTransaction) SetRetryPolicy(policy RetryPolicy) *FileAppendTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *FileAppendTransaction) SetMaxBackoff(max time.Duration) *FileAppendTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -85,7 +86,14 @@ func (query *FileInfoQuery) _Build() *services.Query_FileGetInfo {
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *FileInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *FileInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -113,6 +121,7 @@ func (query *FileInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_FileInfoQueryShouldRetry,
@@ -140,8 +149,8 @@ func (query *FileInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _FileInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetFileGetInfo().Header.NodeTransactionPrecheckCode))
+func _FileInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetFileGetInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _FileInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -156,7 +165,14 @@ func _FileInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *FileInfoQuery) Execute(client *Client) (FileInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *FileInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (FileInfo, error) {
 	if client == nil || client.operator == nil {
 		return FileInfo{}, errNoClientProvided
 	}
@@ -182,7 +198,7 @@ func (query *FileInfoQuery) Execute(client *Client) (FileInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return FileInfo{}, err
 		}
@@ -224,6 +240,7 @@ func (query *FileInfoQuery) Execute(client *Client) (FileInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_FileInfoQueryShouldRetry,
@@ -278,6 +295,12 @@ func (query *FileInfoQuery) SetMaxRetry(count int) *FileInfoQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *FileInfoQuery) SetRetryPolicy(policy RetryPolicy) *FileInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *FileInfoQuery) SetMaxBackoff(max time.Duration) *FileInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -21,10 +21,13 @@ package hedera
  */
 
 import (
+	"encoding/json"
 	"fmt"
 	"math"
+	"math/big"
 	"regexp"
 	"strconv"
+	"strings"
 
 	"github.com/pkg/errors"
 )
@@ -43,7 +46,8 @@ var MinHbar = Hbar{math.MinInt64}
 // ZeroHbar wraps a 0 value of Hbar.
 var ZeroHbar = Hbar{0}
 
-// HbarFrom creates a representation of Hbar in tinybar on the unit provided
+// HbarFrom creates a representation of Hbar in tinybar on the unit provided. The amount goes through a float64, use
+// HbarFromString to create an exact amount.
 func HbarFrom(bars float64, unit HbarUnit) Hbar {
 	return HbarFromTinybar(int64(bars * float64(unit._NumberOfTinybar())))
 }
@@ -73,11 +77,13 @@ func (hbar Hbar) String() string {
 		return fmt.Sprintf("%v %s", hbar.tinybar, HbarUnits.Tinybar.Symbol())
 	}
 
-	return fmt.Sprintf("%v %s", float64(hbar.tinybar)/float64(HbarUnits.Hbar._NumberOfTinybar()), HbarUnits.Hbar.Symbol())
+	return hbar.ToString(HbarUnits.Hbar)
 }
 
+// HbarFromString parses an amount such as "1.5 ℏ", "-20 tℏ" or "3", which is in hbar when it has no unit. The
+// decimal amount is parsed exactly, and an amount which isn't a whole number of tinybars or doesn't fit an Hbar is an
+// error.
 func HbarFromString(hbar string) (Hbar, error) {
-	var err error
 	match := regexp.MustCompile(`^((?:\+|\-)?\d+(?:\.\d+)?)(?: (tℏ|μℏ|mℏ|ℏ|kℏ|Mℏ|Gℏ))?$`)
 
 	matchArray := match.FindStringSubmatch(hbar)
@@ -85,12 +91,20 @@ func HbarFromString(hbar string) (Hbar, error) {
 		return Hbar{}, errors.New("invalid number and/or symbol")
 	}
 
-	a, err := strconv.ParseFloat(matchArray[1], 64)
-	if err != nil {
-		return Hbar{}, err
+	amount, ok := new(big.Rat).SetString(matchArray[1])
+	if !ok {
+		return Hbar{}, errors.New("invalid number and/or symbol")
+	}
+
+	tinybar := amount.Mul(amount, new(big.Rat).SetInt64(_HbarUnitFromString(matchArray[2])._NumberOfTinybar()))
+	if !tinybar.IsInt() {
+		return Hbar{}, errHbarFractionalTinybar
+	}
+	if !tinybar.Num().IsInt64() {
+		return Hbar{}, errHbarOverflow
 	}
 
-	return HbarFrom(a, _HbarUnitFromString(matchArray[2])), nil
+	return HbarFromTinybar(tinybar.Num().Int64()), nil
 }
 
 func _HbarUnitFromString(symbol string) HbarUnit {
@@ -112,8 +126,16 @@ func _HbarUnitFromString(symbol string) HbarUnit {
 	}
 }
 
+// ToString formats the amount exactly in a unit, without trailing zeros, e.g. "1.5 ℏ"
 func (hbar Hbar) ToString(unit HbarUnit) string {
-	return fmt.Sprintf("%v %v", float64(hbar.tinybar)/float64(unit._NumberOfTinybar()), unit.Symbol())
+	tinybars := unit._NumberOfTinybar()
+	decimals := len(strconv.FormatInt(tinybars, 10)) - 1
+	amount := new(big.Rat).SetFrac(big.NewInt(hbar.tinybar), big.NewInt(tinybars)).FloatString(decimals)
+	if decimals > 0 {
+		amount = strings.TrimRight(strings.TrimRight(amount, "0"), ".")
+	}
+
+	return fmt.Sprintf("%v %v", amount, unit.Symbol())
 }
 
 func (hbar Hbar) Negated() Hbar {
@@ -121,3 +143,168 @@ func (hbar Hbar) Negated() Hbar {
 		tinybar: -hbar.tinybar,
 	}
 }
+
+// IsZero returns whether the amount is zero
+func (hbar Hbar) IsZero() bool {
+	return hbar.tinybar == 0
+}
+
+// Cmp compares two amounts, returning -1 if hbar is less than other, 0 if they're equal and +1 if hbar is greater
+func (hbar Hbar) Cmp(other Hbar) int {
+	switch {
+	case hbar.tinybar < other.tinybar:
+		return -1
+	case hbar.tinybar > other.tinybar:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Add returns the sum of two amounts, or an error if it doesn't fit an Hbar
+func (hbar Hbar) Add(other Hbar) (Hbar, error) {
+	sum := hbar.tinybar + other.tinybar
+	if (other.tinybar > 0 && sum < hbar.tinybar) || (other.tinybar < 0 && sum > hbar.tinybar) {
+		return Hbar{}, errHbarOverflow
+	}
+
+	return Hbar{sum}, nil
+}
+
+// Sub returns the difference of two amounts, or an error if it doesn't fit an Hbar
+func (hbar Hbar) Sub(other Hbar) (Hbar, error) {
+	difference := hbar.tinybar - other.tinybar
+	if (other.tinybar > 0 && difference > hbar.tinybar) || (other.tinybar < 0 && difference < hbar.tinybar) {
+		return Hbar{}, errHbarOverflow
+	}
+
+	return Hbar{difference}, nil
+}
+
+// Mul returns the amount multiplied by a factor, or an error if it doesn't fit an Hbar
+func (hbar Hbar) Mul(factor int64) (Hbar, error) {
+	product := hbar.tinybar * factor
+	if hbar.tinybar != 0 && (product/hbar.tinybar != factor || (hbar.tinybar == -1 && factor == math.MinInt64)) {
+		return Hbar{}, errHbarOverflow
+	}
+
+	return Hbar{product}, nil
+}
+
+// Div returns the amount divided by a divisor, truncated toward zero to a whole number of tinybars, or an error if the
+// divisor is zero or the quotient doesn't fit an Hbar
+func (hbar Hbar) Div(divisor int64) (Hbar, error) {
+	if divisor == 0 {
+		return Hbar{}, errHbarDivisionByZero
+	}
+	if hbar.tinybar == math.MinInt64 && divisor == -1 {
+		return Hbar{}, errHbarOverflow
+	}
+
+	return Hbar{hbar.tinybar / divisor}, nil
+}
+
+// Abs returns the absolute value of the amount, or an error for MinHbar, whose absolute value doesn't fit an Hbar
+func (hbar Hbar) Abs() (Hbar, error) {
+	if hbar.tinybar == math.MinInt64 {
+		return Hbar{}, errHbarOverflow
+	}
+	if hbar.tinybar < 0 {
+		return Hbar{-hbar.tinybar}, nil
+	}
+
+	return hbar, nil
+}
+
+// HbarSum returns the sum of any number of amounts, or an error if it doesn't fit an Hbar
+func HbarSum(amounts ...Hbar) (Hbar, error) {
+	sum := ZeroHbar
+	for _, amount := range amounts {
+		var err error
+		if sum, err = sum.Add(amount); err != nil {
+			return Hbar{}, err
+		}
+	}
+
+	return sum, nil
+}
+
+// ToUSDCents returns the exact value of the amount in US cents at an exchange rate
+func (hbar Hbar) ToUSDCents(exchangeRate ExchangeRate) (*big.Rat, error) {
+	if exchangeRate.Hbars <= 0 || exchangeRate.cents <= 0 {
+		return nil, errInvalidExchangeRate
+	}
+
+	cents := new(big.Int).Mul(big.NewInt(hbar.tinybar), big.NewInt(int64(exchangeRate.cents)))
+	tinybars := new(big.Int).Mul(big.NewInt(int64(exchangeRate.Hbars)), big.NewInt(HbarUnits.Hbar._NumberOfTinybar()))
+
+	return new(big.Rat).SetFrac(cents, tinybars), nil
+}
+
+// HbarFromUSDCents returns the amount worth a number of US cents at an exchange rate, rounded to the nearest tinybar
+// with halves rounded away from zero, or an error if it doesn't fit an Hbar
+func HbarFromUSDCents(cents *big.Rat, exchangeRate ExchangeRate) (Hbar, error) {
+	if cents == nil {
+		return Hbar{}, errParameterNull
+	}
+	if exchangeRate.Hbars <= 0 || exchangeRate.cents <= 0 {
+		return Hbar{}, errInvalidExchangeRate
+	}
+
+	tinybar := new(big.Rat).Mul(cents, new(big.Rat).SetFrac(
+		new(big.Int).Mul(big.NewInt(int64(exchangeRate.Hbars)), big.NewInt(HbarUnits.Hbar._NumberOfTinybar())),
+		big.NewInt(int64(exchangeRate.cents)),
+	))
+
+	// |n|/d rounded half away from zero is (2|n| + d) / 2d
+	numerator := new(big.Int).Abs(tinybar.Num())
+	numerator.Add(numerator.Lsh(numerator, 1), tinybar.Denom())
+	rounded := numerator.Quo(numerator, new(big.Int).Lsh(tinybar.Denom(), 1))
+	if tinybar.Sign() < 0 {
+		rounded.Neg(rounded)
+	}
+
+	if !rounded.IsInt64() {
+		return Hbar{}, errHbarOverflow
+	}
+
+	return HbarFromTinybar(rounded.Int64()), nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface, formatting the amount exactly as String does.
+func (hbar Hbar) MarshalText() ([]byte, error) {
+	return []byte(hbar.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, parsing the amount as HbarFromString does.
+func (hbar *Hbar) UnmarshalText(text []byte) error {
+	amount, err := HbarFromString(string(text))
+	if err != nil {
+		return err
+	}
+
+	*hbar = amount
+
+	return nil
+}
+
+// MarshalJSON implements the encoding.JSON interface, as a string formatted as String does.
+func (hbar Hbar) MarshalJSON() ([]byte, error) {
+	return json.Marshal(hbar.String())
+}
+
+// UnmarshalJSON implements the encoding.JSON interface. The amount is either a string parsed as HbarFromString does,
+// or a number of hbar.
+func (hbar *Hbar) UnmarshalJSON(data []byte) error {
+	text := string(data)
+	if text == "null" {
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		text = str
+	}
+
+	return hbar.UnmarshalText([]byte(text))
+}
@@ -24,6 +24,9 @@ package hedera
  */
 
 import (
+	"encoding/json"
+	"math"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -94,3 +97,197 @@ func TestUnitHbarUnit(t *testing.T) {
 	hbar2, err = HbarFromString("1.151.")
 	assert.Error(t, err)
 }
+
+func TestUnitHbarFromStringExact(t *testing.T) {
+	// 0.29 is 0.28999999999999998 as a float64, which truncated to 28999999 tinybars
+	hbar, err := HbarFromString("0.29 ℏ")
+	require.NoError(t, err)
+	assert.Equal(t, int64(29000000), hbar.AsTinybar())
+
+	hbar, err = HbarFromString("92233720368.54775807")
+	require.NoError(t, err)
+	assert.Equal(t, MaxHbar, hbar)
+
+	hbar, err = HbarFromString("-92233720368.54775808 ℏ")
+	require.NoError(t, err)
+	assert.Equal(t, MinHbar, hbar)
+
+	hbar, err = HbarFromString("+1.50 μℏ")
+	require.NoError(t, err)
+	assert.Equal(t, int64(150), hbar.AsTinybar())
+
+	_, err = HbarFromString("92233720368.54775808")
+	assert.Equal(t, errHbarOverflow, err)
+
+	_, err = HbarFromString("1.5 tℏ")
+	assert.Equal(t, errHbarFractionalTinybar, err)
+
+	_, err = HbarFromString("0.000000001")
+	assert.Equal(t, errHbarFractionalTinybar, err)
+}
+
+func TestUnitHbarToStringExact(t *testing.T) {
+	assert.Equal(t, "92233720368.54775807 ℏ", MaxHbar.String())
+	assert.Equal(t, "-92233720368.54775808 ℏ", MinHbar.String())
+	assert.Equal(t, "1.5 ℏ", HbarFromTinybar(150000000).String())
+	assert.Equal(t, "-10000 tℏ", HbarFromTinybar(-10000).String())
+	assert.Equal(t, "0.00010001 ℏ", HbarFromTinybar(10001).String())
+	assert.Equal(t, "1 Gℏ", HbarFromTinybar(HbarUnits.Gigabar._NumberOfTinybar()).ToString(HbarUnits.Gigabar))
+	assert.Equal(t, "0.00000000000000001 Gℏ", HbarFromTinybar(1).ToString(HbarUnits.Gigabar))
+	assert.Equal(t, "20 tℏ", HbarFromTinybar(20).ToString(HbarUnits.Tinybar))
+	assert.Equal(t, "0 ℏ", ZeroHbar.ToString(HbarUnits.Hbar))
+
+	for _, hbar := range []Hbar{MaxHbar, MinHbar, HbarFromTinybar(123456789)} {
+		for _, unit := range []HbarUnit{HbarUnits.Tinybar, HbarUnits.Microbar, HbarUnits.Hbar, HbarUnits.Gigabar} {
+			parsed, err := HbarFromString(hbar.ToString(unit))
+			require.NoError(t, err)
+			assert.Equal(t, hbar, parsed)
+		}
+	}
+}
+
+func TestUnitHbarArithmetic(t *testing.T) {
+	one := NewHbar(1)
+
+	sum, err := one.Add(HbarFromTinybar(1))
+	require.NoError(t, err)
+	assert.Equal(t, int64(100000001), sum.AsTinybar())
+
+	difference, err := one.Sub(NewHbar(3))
+	require.NoError(t, err)
+	assert.Equal(t, NewHbar(-2), difference)
+
+	product, err := one.Mul(-3)
+	require.NoError(t, err)
+	assert.Equal(t, NewHbar(-3), product)
+
+	quotient, err := HbarFromTinybar(-7).Div(2)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(-3), quotient)
+
+	abs, err := NewHbar(-2).Abs()
+	require.NoError(t, err)
+	assert.Equal(t, NewHbar(2), abs)
+
+	total, err := HbarSum(one, NewHbar(2), HbarFromTinybar(-5))
+	require.NoError(t, err)
+	assert.Equal(t, int64(299999995), total.AsTinybar())
+
+	total, err = HbarSum()
+	require.NoError(t, err)
+	assert.True(t, total.IsZero())
+	assert.False(t, one.IsZero())
+
+	assert.Equal(t, -1, one.Cmp(NewHbar(2)))
+	assert.Equal(t, 0, one.Cmp(HbarFromTinybar(100000000)))
+	assert.Equal(t, 1, one.Cmp(MinHbar))
+}
+
+func TestUnitHbarArithmeticOverflow(t *testing.T) {
+	_, err := MaxHbar.Add(HbarFromTinybar(1))
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = MinHbar.Add(HbarFromTinybar(-1))
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = MinHbar.Sub(HbarFromTinybar(1))
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = ZeroHbar.Sub(MinHbar)
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = MaxHbar.Mul(2)
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = MinHbar.Mul(-1)
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = HbarFromTinybar(-1).Mul(math.MinInt64)
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = MinHbar.Div(-1)
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = MinHbar.Abs()
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = HbarSum(MaxHbar, HbarFromTinybar(1), HbarFromTinybar(-1))
+	assert.Equal(t, errHbarOverflow, err)
+	_, err = NewHbar(1).Div(0)
+	assert.Equal(t, errHbarDivisionByZero, err)
+
+	// the limits themselves aren't overflows
+	sum, err := MaxHbar.Add(MinHbar)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(-1), sum)
+	product, err := MinHbar.Mul(1)
+	require.NoError(t, err)
+	assert.Equal(t, MinHbar, product)
+}
+
+func TestUnitHbarUSDCents(t *testing.T) {
+	// 30000 hbar to 1500 cents, i.e. 5 cents per hbar
+	exchangeRate := ExchangeRate{Hbars: 30000, cents: 150000}
+
+	cents, err := NewHbar(2).ToUSDCents(exchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewRat(10, 1), cents)
+
+	cents, err = HbarFromTinybar(1).ToUSDCents(exchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewRat(1, 20000000), cents)
+
+	hbar, err := HbarFromUSDCents(big.NewRat(10, 1), exchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, NewHbar(2), hbar)
+
+	// a tenth of a tinybar is rounded down, and half a tinybar away from zero
+	hbar, err = HbarFromUSDCents(big.NewRat(1, 200000000), exchangeRate)
+	require.NoError(t, err)
+	assert.True(t, hbar.IsZero())
+	hbar, err = HbarFromUSDCents(big.NewRat(1, 40000000), exchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(1), hbar)
+	hbar, err = HbarFromUSDCents(big.NewRat(-1, 40000000), exchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(-1), hbar)
+
+	cents, err = MaxHbar.ToUSDCents(exchangeRate)
+	require.NoError(t, err)
+	hbar, err = HbarFromUSDCents(cents, exchangeRate)
+	require.NoError(t, err)
+	assert.Equal(t, MaxHbar, hbar)
+
+	_, err = HbarFromUSDCents(cents.Add(cents, big.NewRat(1, 1)), exchangeRate)
+	assert.Equal(t, errHbarOverflow, err)
+
+	_, err = NewHbar(1).ToUSDCents(ExchangeRate{Hbars: 1})
+	assert.Equal(t, errInvalidExchangeRate, err)
+	_, err = HbarFromUSDCents(big.NewRat(1, 1), ExchangeRate{cents: 1})
+	assert.Equal(t, errInvalidExchangeRate, err)
+	_, err = HbarFromUSDCents(nil, exchangeRate)
+	assert.Equal(t, errParameterNull, err)
+}
+
+func TestUnitHbarJSON(t *testing.T) {
+	type transfer struct {
+		Amount Hbar  `json:"amount"`
+		Fee    *Hbar `json:"fee"`
+	}
+
+	data, err := json.Marshal(transfer{Amount: MaxHbar})
+	require.NoError(t, err)
+	assert.Equal(t, `{"amount":"92233720368.54775807 ℏ","fee":null}`, string(data))
+
+	var decoded transfer
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, MaxHbar, decoded.Amount)
+	assert.Nil(t, decoded.Fee)
+
+	require.NoError(t, json.Unmarshal([]byte(`{"amount":"-20 tℏ","fee":0.29}`), &decoded))
+	assert.Equal(t, HbarFromTinybar(-20), decoded.Amount)
+	require.NotNil(t, decoded.Fee)
+	assert.Equal(t, HbarFromTinybar(29000000), *decoded.Fee)
+
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":"1.5 tℏ"}`), &decoded))
+	assert.Error(t, json.Unmarshal([]byte(`{"amount":true}`), &decoded))
+
+	text, err := HbarFromTinybar(150).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "150 tℏ", string(text))
+
+	var hbar Hbar
+	require.NoError(t, hbar.UnmarshalText([]byte("1.5 mℏ")))
+	assert.Equal(t, HbarFromTinybar(150000), hbar)
+}
@@ -0,0 +1,511 @@
+// Package hederasim simulates Hedera smart contracts locally, on an EVM over an in-memory state.
+//
+// It's kept apart from package hedera so programs which only talk to the network don't link the EVM and its state
+// database.
+//
+//	simulator := hederasim.NewContractSimulator()
+//	result, err := simulator.Create(hedera.NewContractCreateFlow().
+//		SetBytecode(bytecode))
+//	if err != nil {
+//		panic(err)
+//	}
+//
+//	gas, err := simulator.EstimateGas(hedera.NewContractExecuteTransaction().
+//		SetContractID(*result.ContractID).
+//		SetFunction("setMessage", hedera.NewContractFunctionParameters().AddString("hello")))
+package hederasim
+
+import (
+	"encoding/hex"
+	"math/big"
+	"time"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/pkg/errors"
+)
+
+// _MaxGas is the most gas a single contract call or creation may use on the network, and the gas given to
+// simulations which don't set any
+const _MaxGas uint64 = 15000000
+
+// _FirstContractNum is the number given to the first contract the simulator creates
+const _FirstContractNum uint64 = 1001
+
+// ContractSimulator runs contract calls and creations locally, on an EVM over an in-memory state, to estimate the gas
+// they need and to dry-run them without a network. Hbar values are in tinybars, as they are on the network.
+//
+// The state is only what is given to the simulator: bytecode set with SetContract or LoadContract, storage set with
+// SetContractStorage, balances set with SetAccountBalance and SetContractBalance, and whatever Execute and Create
+// change. Hedera system contracts, such as the token service precompile, aren't simulated.
+type ContractSimulator struct {
+	state       *state.StateDB
+	chainConfig *params.ChainConfig
+	sender      hedera.AccountID
+	contracts   map[common.Address]hedera.ContractID
+	nextNum     uint64
+	runs        int64
+}
+
+// NewContractSimulator creates a ContractSimulator with an empty state, running calls for the mainnet chain ID as
+// 0.0.2
+func NewContractSimulator() *ContractSimulator {
+	// an empty state backed by memory can't fail to open
+	stateDB, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+
+	simulator := ContractSimulator{
+		state:     stateDB,
+		sender:    hedera.AccountID{Account: 2},
+		contracts: make(map[common.Address]hedera.ContractID),
+		nextNum:   _FirstContractNum,
+	}
+	simulator.SetChainID(295)
+
+	return &simulator
+}
+
+// SetChainID sets the chain ID returned by the CHAINID opcode: 295 for mainnet, 296 for testnet and 297 for previewnet
+func (simulator *ContractSimulator) SetChainID(chainID int64) *ContractSimulator {
+	zero := big.NewInt(0)
+	simulator.chainConfig = &params.ChainConfig{
+		ChainID:             big.NewInt(chainID),
+		HomesteadBlock:      zero,
+		EIP150Block:         zero,
+		EIP155Block:         zero,
+		EIP158Block:         zero,
+		ByzantiumBlock:      zero,
+		ConstantinopleBlock: zero,
+		PetersburgBlock:     zero,
+		IstanbulBlock:       zero,
+		BerlinBlock:         zero,
+		LondonBlock:         zero,
+	}
+
+	return simulator
+}
+
+// GetChainID returns the chain ID returned by the CHAINID opcode
+func (simulator *ContractSimulator) GetChainID() int64 {
+	return simulator.chainConfig.ChainID.Int64()
+}
+
+// SetSender sets the account calls are made from, unless a ContractExecuteTransaction has a transaction ID
+func (simulator *ContractSimulator) SetSender(accountID hedera.AccountID) *ContractSimulator {
+	simulator.sender = accountID
+	return simulator
+}
+
+// GetSender returns the account calls are made from
+func (simulator *ContractSimulator) GetSender() hedera.AccountID {
+	return simulator.sender
+}
+
+// SetNextContractNum sets the number the next contract created is given, unless it's taken, to keep the numbering in
+// step with the other entities of a ledger
+func (simulator *ContractSimulator) SetNextContractNum(num uint64) *ContractSimulator {
+	simulator.nextNum = num
+	return simulator
+}
+
+// GetNextContractNum returns the number the next contract created is given, unless it's taken
+func (simulator *ContractSimulator) GetNextContractNum() uint64 {
+	return simulator.nextNum
+}
+
+// SetContract sets the runtime bytecode of a contract, as returned by ContractBytecodeQuery
+func (simulator *ContractSimulator) SetContract(contractID hedera.ContractID, bytecode []byte) *ContractSimulator {
+	address := _Address(contractID)
+
+	simulator.state.SetCode(address, bytecode)
+	if simulator.state.GetNonce(address) == 0 {
+		simulator.state.SetNonce(address, 1)
+	}
+	simulator.contracts[address] = contractID
+
+	return simulator
+}
+
+// GetContract returns the runtime bytecode of a contract, or an empty slice if it has none
+func (simulator *ContractSimulator) GetContract(contractID hedera.ContractID) []byte {
+	return simulator.state.GetCode(_Address(contractID))
+}
+
+// LoadContract sets the runtime bytecode of a contract to the one deployed on the network. Its storage isn't
+// available from the network and has to be set with SetContractStorage.
+func (simulator *ContractSimulator) LoadContract(client *hedera.Client, contractID hedera.ContractID) error {
+	bytecode, err := hedera.NewContractBytecodeQuery().
+		SetContractID(contractID).
+		Execute(client)
+	if err != nil {
+		return err
+	}
+
+	simulator.SetContract(contractID, bytecode)
+	return nil
+}
+
+// SetContractStorage sets a 32 byte storage slot of a contract. Shorter slots and values are left padded with zeros.
+func (simulator *ContractSimulator) SetContractStorage(contractID hedera.ContractID, slot []byte, value []byte) *ContractSimulator {
+	simulator.state.SetState(_Address(contractID), common.BytesToHash(slot), common.BytesToHash(value))
+	return simulator
+}
+
+// GetContractStorage returns a 32 byte storage slot of a contract
+func (simulator *ContractSimulator) GetContractStorage(contractID hedera.ContractID, slot []byte) []byte {
+	return simulator.state.GetState(_Address(contractID), common.BytesToHash(slot)).Bytes()
+}
+
+// SetAccountBalance sets the balance of an account
+func (simulator *ContractSimulator) SetAccountBalance(accountID hedera.AccountID, balance hedera.Hbar) *ContractSimulator {
+	simulator.state.SetBalance(_Address(accountID), big.NewInt(balance.AsTinybar()))
+	return simulator
+}
+
+// GetAccountBalance returns the balance of an account
+func (simulator *ContractSimulator) GetAccountBalance(accountID hedera.AccountID) hedera.Hbar {
+	return hedera.HbarFromTinybar(simulator.state.GetBalance(_Address(accountID)).Int64())
+}
+
+// SetContractBalance sets the balance of a contract
+func (simulator *ContractSimulator) SetContractBalance(contractID hedera.ContractID, balance hedera.Hbar) *ContractSimulator {
+	simulator.state.SetBalance(_Address(contractID), big.NewInt(balance.AsTinybar()))
+	return simulator
+}
+
+// GetContractBalance returns the balance of a contract
+func (simulator *ContractSimulator) GetContractBalance(contractID hedera.ContractID) hedera.Hbar {
+	return hedera.HbarFromTinybar(simulator.state.GetBalance(_Address(contractID)).Int64())
+}
+
+// Call dry-runs a ContractCallQuery, leaving the state unchanged. As on the network, a failed call returns an
+// ErrHederaPreCheckStatus, along with the result.
+func (simulator *ContractSimulator) Call(query *hedera.ContractCallQuery) (hedera.ContractFunctionResult, error) {
+	result, status := simulator._Run(_Message{
+		sender:     simulator.sender,
+		contractID: _ContractIDOrNil(query.GetContractID()),
+		data:       query.GetFunctionParameters(),
+		gas:        query.GetGas(),
+	}, false)
+	if status != hedera.StatusSuccess {
+		return result, hedera.ErrHederaPreCheckStatus{Status: status, RevertReason: result.RevertReason}
+	}
+
+	return result, nil
+}
+
+// Execute runs a ContractExecuteTransaction, applying its changes to the state when it succeeds. As on the network, a
+// failed call returns an ErrHederaReceiptStatus, along with the result.
+func (simulator *ContractSimulator) Execute(transaction *hedera.ContractExecuteTransaction) (hedera.ContractFunctionResult, error) {
+	return simulator._Complete(simulator._Run(simulator._ExecuteMessage(transaction), true))
+}
+
+// Create runs the creation of a ContractCreateFlow, adding the contract to the state when it succeeds. The contract
+// is given the next free number, from 0.0.1001, as the result's ContractID. As on the network, a failed creation
+// returns an ErrHederaReceiptStatus, along with the result.
+func (simulator *ContractSimulator) Create(flow *hedera.ContractCreateFlow) (hedera.ContractFunctionResult, error) {
+	return simulator._Complete(simulator._Run(simulator._CreateMessage(flow), true))
+}
+
+// EstimateGas returns the least gas the ContractExecuteTransaction succeeds with, leaving the state unchanged. It
+// returns an ErrHederaReceiptStatus if it fails with the most gas a call may use.
+func (simulator *ContractSimulator) EstimateGas(transaction *hedera.ContractExecuteTransaction) (uint64, error) {
+	return simulator._EstimateGas(simulator._ExecuteMessage(transaction))
+}
+
+// EstimateCreateGas returns the least gas the creation of a ContractCreateFlow succeeds with, leaving the state
+// unchanged. It returns an ErrHederaReceiptStatus if it fails with the most gas a creation may use.
+func (simulator *ContractSimulator) EstimateCreateGas(flow *hedera.ContractCreateFlow) (uint64, error) {
+	return simulator._EstimateGas(simulator._CreateMessage(flow))
+}
+
+type _Message struct {
+	sender     hedera.AccountID
+	creation   bool
+	contractID *hedera.ContractID
+	data       []byte
+	value      int64
+	gas        uint64
+}
+
+func (simulator *ContractSimulator) _ExecuteMessage(transaction *hedera.ContractExecuteTransaction) _Message {
+	sender := simulator.sender
+	if accountID := transaction.GetTransactionID().AccountID; accountID != nil {
+		sender = *accountID
+	}
+
+	return _Message{
+		sender:     sender,
+		contractID: _ContractIDOrNil(transaction.GetContractID()),
+		data:       transaction.GetFunctionParameters(),
+		value:      transaction.GetPayableAmount().AsTinybar(),
+		gas:        transaction.GetGas(),
+	}
+}
+
+func (simulator *ContractSimulator) _CreateMessage(flow *hedera.ContractCreateFlow) _Message {
+	// the bytecode is kept as bytes and only returned hex encoded, so it always decodes
+	bytecode, _ := hex.DecodeString(flow.GetBytecode())
+
+	return _Message{
+		sender:   simulator.sender,
+		creation: true,
+		data:     append(bytecode, flow.GetConstructorParameters()...),
+		value:    flow.GetInitialBalance().AsTinybar(),
+		gas:      uint64(flow.GetGas()),
+	}
+}
+
+func (simulator *ContractSimulator) _Complete(result hedera.ContractFunctionResult, status hedera.Status) (hedera.ContractFunctionResult, error) {
+	if status != hedera.StatusSuccess {
+		return result, hedera.ErrHederaReceiptStatus{Status: status, RevertReason: result.RevertReason}
+	}
+
+	return result, nil
+}
+
+func (simulator *ContractSimulator) _EstimateGas(message _Message) (uint64, error) {
+	message.gas = _MaxGas
+	result, status := simulator._Run(message, false)
+	if status != hedera.StatusSuccess {
+		return 0, hedera.ErrHederaReceiptStatus{Status: status, RevertReason: result.RevertReason}
+	}
+
+	// a call can need more gas than it uses, as it has to keep 1/64th of its gas when it calls another contract, so
+	// search for the least gas it succeeds with
+	low, high := result.GasUsed-1, _MaxGas
+	for low+1 < high {
+		message.gas = low + (high-low)/2
+		if _, status = simulator._Run(message, false); status == hedera.StatusSuccess {
+			high = message.gas
+		} else {
+			low = message.gas
+		}
+	}
+
+	return high, nil
+}
+
+// _Run runs the message, keeping its changes to the state only if commit is set and it succeeds
+func (simulator *ContractSimulator) _Run(message _Message, commit bool) (hedera.ContractFunctionResult, hedera.Status) {
+	if message.gas == 0 {
+		message.gas = _MaxGas
+	}
+
+	result := hedera.ContractFunctionResult{
+		GasAvailable:       int64(message.gas),
+		Amount:             hedera.HbarFromTinybar(message.value),
+		FunctionParameters: message.data,
+	}
+
+	if message.gas > _MaxGas {
+		return result, hedera.StatusMaxGasLimitExceeded
+	}
+
+	creation := message.creation
+	intrinsicGas := _IntrinsicGas(message.data, creation)
+	if message.gas < intrinsicGas {
+		result.GasUsed = message.gas
+		return result, hedera.StatusInsufficientGas
+	}
+
+	var contractID hedera.ContractID
+	if creation {
+		for len(simulator.state.GetCode(_Address(hedera.ContractID{Contract: simulator.nextNum}))) > 0 {
+			simulator.nextNum++
+		}
+		contractID = hedera.ContractID{Contract: simulator.nextNum}
+	} else {
+		if message.contractID == nil {
+			return result, hedera.StatusInvalidContractID
+		}
+		contractID = *message.contractID
+	}
+	result.ContractID = &contractID
+
+	sender := _Address(message.sender)
+	address := _Address(contractID)
+	if !creation && len(simulator.state.GetCode(address)) == 0 {
+		return result, hedera.StatusInvalidContractID
+	}
+
+	simulator.runs++
+	runHash := common.BigToHash(big.NewInt(simulator.runs))
+	simulator.state.Prepare(runHash, 0)
+
+	snapshot := simulator.state.Snapshot()
+	rules := simulator.chainConfig.Rules(big.NewInt(0), false)
+	simulator.state.PrepareAccessList(sender, &address, vm.ActivePrecompiles(rules), nil)
+
+	if creation {
+		// the contract is created at the address of its number, as the network does, by running its initcode there
+		simulator.state.CreateAccount(address)
+		simulator.state.SetNonce(address, 1)
+		simulator.state.SetCode(address, message.data)
+	}
+
+	evm := vm.NewEVM(vm.BlockContext{
+		CanTransfer: _CanTransfer,
+		Transfer:    _Transfer,
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: big.NewInt(0),
+		Time:        big.NewInt(time.Now().Unix()),
+		Difficulty:  big.NewInt(0),
+		GasLimit:    _MaxGas,
+		BaseFee:     big.NewInt(0),
+	}, vm.TxContext{
+		Origin:   sender,
+		GasPrice: big.NewInt(0),
+	}, simulator.state, simulator.chainConfig, vm.Config{NoBaseFee: true})
+
+	var input []byte
+	if !creation {
+		input = message.data
+	}
+
+	output, gasLeft, err := evm.Call(vm.AccountRef(sender), address, input, message.gas-intrinsicGas, big.NewInt(message.value))
+	if err == nil && creation {
+		output, gasLeft, err = _Deposit(simulator.state, address, output, gasLeft)
+	}
+
+	result.GasUsed = message.gas - gasLeft
+	for _, log := range simulator.state.GetLogs(runHash, common.Hash{}) {
+		topics := make([][]byte, len(log.Topics))
+		for i, topic := range log.Topics {
+			topics[i] = topic.Bytes()
+		}
+
+		result.LogInfo = append(result.LogInfo, hedera.ContractLogInfo{
+			ContractID: simulator._ContractID(log.Address),
+			Topics:     topics,
+			Data:       log.Data,
+		})
+	}
+
+	if err != nil {
+		simulator.state.RevertToSnapshot(snapshot)
+
+		if errors.Is(err, vm.ErrExecutionReverted) {
+			result.ContractCallResult = output
+			result.ErrorMessage = "0x" + hex.EncodeToString(output)
+			result.RevertReason = hedera.DecodeRevertReason(output)
+			return result, hedera.StatusContractRevertExecuted
+		}
+
+		result.ErrorMessage = err.Error()
+		return result, _Status(err)
+	}
+
+	if !commit {
+		simulator.state.RevertToSnapshot(snapshot)
+	} else {
+		simulator.state.Finalise(true)
+		if creation {
+			simulator.contracts[address] = contractID
+			simulator.nextNum++
+		}
+	}
+
+	if !creation {
+		result.ContractCallResult = output
+	}
+
+	return result, hedera.StatusSuccess
+}
+
+// _Deposit stores the runtime bytecode returned by the initcode of a contract, charging for it
+func _Deposit(stateDB *state.StateDB, address common.Address, code []byte, gasLeft uint64) ([]byte, uint64, error) {
+	if len(code) > params.MaxCodeSize {
+		return nil, 0, vm.ErrMaxCodeSizeExceeded
+	}
+
+	if len(code) > 0 && code[0] == 0xEF {
+		return nil, 0, vm.ErrInvalidCode
+	}
+
+	cost := uint64(len(code)) * params.CreateDataGas
+	if gasLeft < cost {
+		return nil, 0, vm.ErrCodeStoreOutOfGas
+	}
+
+	stateDB.SetCode(address, code)
+	return code, gasLeft - cost, nil
+}
+
+func (simulator *ContractSimulator) _ContractID(address common.Address) hedera.ContractID {
+	if contractID, ok := simulator.contracts[address]; ok {
+		return contractID
+	}
+
+	return hedera.ContractID{EvmAddress: address.Bytes()}
+}
+
+func _Status(err error) hedera.Status {
+	switch {
+	case errors.Is(err, vm.ErrOutOfGas), errors.Is(err, vm.ErrCodeStoreOutOfGas):
+		return hedera.StatusInsufficientGas
+	case errors.Is(err, vm.ErrInsufficientBalance):
+		return hedera.StatusInsufficientPayerBalance
+	}
+
+	return hedera.StatusContractExecutionException
+}
+
+// _IntrinsicGas returns the gas charged for a call or creation before running it
+func _IntrinsicGas(data []byte, creation bool) uint64 {
+	gas := params.TxGas
+	if creation {
+		gas = params.TxGasContractCreation
+	}
+
+	for _, b := range data {
+		if b == 0 {
+			gas += params.TxDataZeroGas
+		} else {
+			gas += params.TxDataNonZeroGasEIP2028
+		}
+	}
+
+	return gas
+}
+
+// _Address returns the EVM address of an account or contract, as the network derives it
+func _Address(id interface{}) common.Address {
+	switch id := id.(type) {
+	case hedera.AccountID:
+		if id.AliasEvmAddress != nil {
+			return common.BytesToAddress(*id.AliasEvmAddress)
+		}
+
+		return common.HexToAddress(id.ToSolidityAddress())
+	case hedera.ContractID:
+		if id.EvmAddress != nil {
+			return common.BytesToAddress(id.EvmAddress)
+		}
+
+		return common.HexToAddress(id.ToSolidityAddress())
+	}
+
+	return common.Address{}
+}
+
+// _ContractIDOrNil returns nil for the empty ContractID getters return when no contract is set
+func _ContractIDOrNil(contractID hedera.ContractID) *hedera.ContractID {
+	if contractID.Shard == 0 && contractID.Realm == 0 && contractID.Contract == 0 && contractID.EvmAddress == nil {
+		return nil
+	}
+
+	return &contractID
+}
+
+func _CanTransfer(db vm.StateDB, address common.Address, amount *big.Int) bool {
+	return db.GetBalance(address).Cmp(amount) >= 0
+}
+
+func _Transfer(db vm.StateDB, sender common.Address, recipient common.Address, amount *big.Int) {
+	db.SubBalance(sender, amount)
+	db.AddBalance(recipient, amount)
+}
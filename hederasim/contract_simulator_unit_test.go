@@ -0,0 +1,179 @@
+//go:build all || unit
+// +build all unit
+
+package hederasim
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the stateful contract of ./examples/create_stateful_contract, with setMessage(string) only changing the message
+// when called by its creator
+const testStatefulContractBytecode = `608060405234801561001057600080fd5b506040516104d73803806104d78339818101604052602081101561003357600080fd5b810190808051604051939291908464010000000082111561005357600080fd5b90830190602082018581111561006857600080fd5b825164010000000081118282018810171561008257600080fd5b82525081516020918201929091019080838360005b838110156100af578181015183820152602001610097565b50505050905090810190601f1680156100dc5780820380516001836020036101000a031916815260200191505b506040525050600080546001600160a01b0319163317905550805161010890600190602084019061010f565b50506101aa565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061015057805160ff191683800117855561017d565b8280016001018555821561017d579182015b8281111561017d578251825591602001919060010190610162565b5061018992915061018d565b5090565b6101a791905b808211156101895760008155600101610193565b90565b61031e806101b96000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c8063368b87721461004657806341c0e1b5146100ee578063ce6d41de146100f6575b600080fd5b6100ec6004803603602081101561005c57600080fd5b81019060208101813564010000000081111561007757600080fd5b82018360208201111561008957600080fd5b803590602001918460018302840111640100000000831117156100ab57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550610173945050505050565b005b6100ec6101a2565b6100fe6101ba565b6040805160208082528351818301528351919283929083019185019080838360005b83811015610138578181015183820152602001610120565b50505050905090810190601f1680156101655780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b6000546001600160a01b0316331461018a5761019f565b805161019d906001906020840190610250565b505b50565b6000546001600160a01b03163314156101b85733ff5b565b60018054604080516020601f600260001961010087891615020190951694909404938401819004810282018101909252828152606093909290918301828280156102455780601f1061021a57610100808354040283529160200191610245565b820191906000526020600020905b81548152906001019060200180831161022857829003601f168201915b505050505090505b90565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061029157805160ff19168380011785556102be565b828001600101855582156102be579182015b828111156102be5782518255916020019190600101906102a3565b506102ca9291506102ce565b5090565b61024d91905b808211156102ca57600081556001016102d456fea264697066735822122084964d4c3f6bc912a9d20e14e449721012d625aa3c8a12de41ae5519752fc89064736f6c63430006000033`
+
+// runtime bytecode reverting every call with Panic(0x11)
+const testPanicContractBytecode = "7f4e487b7100000000000000000000000000000000000000000000000000000000" +
+	"600052" + "6011600452" + "60246000fd"
+
+func _NewTestSimulatorContract(t *testing.T, simulator *ContractSimulator) hedera.ContractID {
+	result, err := simulator.Create(hedera.NewContractCreateFlow().
+		SetBytecodeWithString(testStatefulContractBytecode).
+		SetConstructorParameters(hedera.NewContractFunctionParameters().AddString("hello from hedera")))
+	require.NoError(t, err)
+	require.NotNil(t, result.ContractID)
+
+	return *result.ContractID
+}
+
+func _GetTestSimulatorMessage(t *testing.T, simulator *ContractSimulator, contractID hedera.ContractID) string {
+	result, err := simulator.Call(hedera.NewContractCallQuery().
+		SetContractID(contractID).
+		SetFunction("getMessage", nil))
+	require.NoError(t, err)
+
+	return result.GetString(0)
+}
+
+func TestUnitContractSimulatorCreate(t *testing.T) {
+	simulator := NewContractSimulator()
+
+	result, err := simulator.Create(hedera.NewContractCreateFlow().
+		SetBytecodeWithString(testStatefulContractBytecode).
+		SetConstructorParameters(hedera.NewContractFunctionParameters().AddString("hello from hedera")))
+	require.NoError(t, err)
+
+	assert.Equal(t, hedera.ContractID{Contract: 1001}, *result.ContractID)
+	assert.Greater(t, result.GasUsed, uint64(53000))
+	assert.Equal(t, "hello from hedera", _GetTestSimulatorMessage(t, simulator, *result.ContractID))
+
+	result, err = simulator.Create(hedera.NewContractCreateFlow().
+		SetBytecodeWithString(testStatefulContractBytecode).
+		SetConstructorParameters(hedera.NewContractFunctionParameters().AddString("second")))
+	require.NoError(t, err)
+	assert.Equal(t, hedera.ContractID{Contract: 1002}, *result.ContractID)
+}
+
+func TestUnitContractSimulatorNextContractNum(t *testing.T) {
+	simulator := NewContractSimulator().SetNextContractNum(1500)
+	assert.Equal(t, uint64(1500), simulator.GetNextContractNum())
+
+	contractID := _NewTestSimulatorContract(t, simulator)
+	assert.Equal(t, hedera.ContractID{Contract: 1500}, contractID)
+	assert.Equal(t, uint64(1501), simulator.GetNextContractNum())
+	assert.NotEmpty(t, simulator.GetContract(contractID))
+	assert.Empty(t, simulator.GetContract(hedera.ContractID{Contract: 1501}))
+
+	// a taken number is skipped
+	simulator.SetNextContractNum(1500)
+	assert.Equal(t, hedera.ContractID{Contract: 1501}, _NewTestSimulatorContract(t, simulator))
+}
+
+func TestUnitContractSimulatorExecute(t *testing.T) {
+	simulator := NewContractSimulator()
+	contractID := _NewTestSimulatorContract(t, simulator)
+
+	setMessage := func() *hedera.ContractExecuteTransaction {
+		return hedera.NewContractExecuteTransaction().
+			SetContractID(contractID).
+			SetFunction("setMessage", hedera.NewContractFunctionParameters().AddString("new message"))
+	}
+
+	// a call is a dry run
+	_, err := simulator.Call(hedera.NewContractCallQuery().
+		SetContractID(contractID).
+		SetFunction("setMessage", hedera.NewContractFunctionParameters().AddString("new message")))
+	require.NoError(t, err)
+	assert.Equal(t, "hello from hedera", _GetTestSimulatorMessage(t, simulator, contractID))
+
+	// so is estimating its gas
+	gas, err := simulator.EstimateGas(setMessage())
+	require.NoError(t, err)
+	assert.Equal(t, "hello from hedera", _GetTestSimulatorMessage(t, simulator, contractID))
+
+	_, err = simulator.Execute(setMessage().SetGas(gas - 1))
+	require.Error(t, err)
+	assert.Equal(t, hedera.StatusInsufficientGas, err.(hedera.ErrHederaReceiptStatus).Status)
+	assert.Equal(t, "hello from hedera", _GetTestSimulatorMessage(t, simulator, contractID))
+
+	result, err := simulator.Execute(setMessage().SetGas(gas))
+	require.NoError(t, err)
+	assert.LessOrEqual(t, result.GasUsed, gas)
+	assert.Equal(t, "new message", _GetTestSimulatorMessage(t, simulator, contractID))
+
+	// only the creator can change the message
+	_, err = simulator.Execute(setMessage().
+		SetFunction("setMessage", hedera.NewContractFunctionParameters().AddString("not the creator")).
+		SetTransactionID(hedera.TransactionIDGenerate(hedera.AccountID{Account: 1234})))
+	require.NoError(t, err)
+	assert.Equal(t, "new message", _GetTestSimulatorMessage(t, simulator, contractID))
+}
+
+func TestUnitContractSimulatorEstimateCreateGas(t *testing.T) {
+	simulator := NewContractSimulator()
+	flow := hedera.NewContractCreateFlow().
+		SetBytecodeWithString(testStatefulContractBytecode).
+		SetConstructorParameters(hedera.NewContractFunctionParameters().AddString("hello from hedera"))
+
+	gas, err := simulator.EstimateCreateGas(flow)
+	require.NoError(t, err)
+
+	_, err = simulator.Create(flow.SetGas(int64(gas - 1)))
+	require.Error(t, err)
+
+	result, err := simulator.Create(flow.SetGas(int64(gas)))
+	require.NoError(t, err)
+	assert.Equal(t, hedera.ContractID{Contract: 1001}, *result.ContractID)
+}
+
+func TestUnitContractSimulatorRevert(t *testing.T) {
+	simulator := NewContractSimulator()
+	contractID := hedera.ContractID{Contract: 5005}
+	bytecode, err := hex.DecodeString(testPanicContractBytecode)
+	require.NoError(t, err)
+	simulator.SetContract(contractID, bytecode)
+
+	result, err := simulator.Call(hedera.NewContractCallQuery().
+		SetContractID(contractID).
+		SetFunction("anything", nil))
+	require.Error(t, err)
+
+	precheckErr, ok := err.(hedera.ErrHederaPreCheckStatus)
+	require.True(t, ok)
+	assert.Equal(t, hedera.StatusContractRevertExecuted, precheckErr.Status)
+	require.NotNil(t, precheckErr.RevertReason)
+	assert.Equal(t, uint64(0x11), precheckErr.RevertReason.PanicCode)
+	assert.Equal(t, precheckErr.RevertReason, result.RevertReason)
+}
+
+func TestUnitContractSimulatorInvalidContract(t *testing.T) {
+	simulator := NewContractSimulator()
+
+	_, err := simulator.Call(hedera.NewContractCallQuery().
+		SetContractID(hedera.ContractID{Contract: 404}).
+		SetFunction("getMessage", nil))
+	require.Error(t, err)
+	assert.Equal(t, hedera.StatusInvalidContractID, err.(hedera.ErrHederaPreCheckStatus).Status)
+
+	_, err = simulator.Execute(hedera.NewContractExecuteTransaction().SetFunction("getMessage", nil))
+	require.Error(t, err)
+	assert.Equal(t, hedera.StatusInvalidContractID, err.(hedera.ErrHederaReceiptStatus).Status)
+}
+
+func TestUnitContractSimulatorState(t *testing.T) {
+	simulator := NewContractSimulator().SetChainID(296)
+	contractID := hedera.ContractID{Contract: 5005}
+
+	simulator.SetContractStorage(contractID, []byte{1}, []byte{42}).
+		SetContractBalance(contractID, hedera.HbarFromTinybar(10)).
+		SetAccountBalance(hedera.AccountID{Account: 1234}, hedera.NewHbar(1))
+
+	assert.Equal(t, int64(296), simulator.GetChainID())
+	assert.Equal(t, byte(42), simulator.GetContractStorage(contractID, []byte{1})[31])
+	assert.Equal(t, hedera.HbarFromTinybar(10), simulator.GetContractBalance(contractID))
+	assert.Equal(t, hedera.NewHbar(1), simulator.GetAccountBalance(hedera.AccountID{Account: 1234}))
+}
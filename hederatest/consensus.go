@@ -0,0 +1,177 @@
+package hederatest
+
+import (
+	"bytes"
+	"crypto/sha512"
+	"encoding/binary"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _RunningHashVersion is the version of the running hashes of topics
+const _RunningHashVersion uint64 = 3
+
+func (ledger *_Ledger) _GetTopic(topicID *services.TopicID) (*services.ConsensusTopicInfo, services.ResponseCodeEnum) {
+	topic, ok := ledger.topics[topicID.GetTopicNum()]
+	if topicID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_TOPIC_ID
+	}
+
+	return topic, services.ResponseCodeEnum_SUCCESS
+}
+
+// _CheckTopicAdminKey checks a topic can be changed, by its admin key signing
+func (ledger *_Ledger) _CheckTopicAdminKey(transaction *_Transaction, topic *services.ConsensusTopicInfo) services.ResponseCodeEnum {
+	if topic.AdminKey == nil {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !transaction.signers._Satisfies(topic.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicCreate(transaction *_Transaction, body *services.ConsensusCreateTopicTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	if body.AdminKey != nil && !transaction.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if body.AutoRenewAccount != nil {
+		account, status := ledger._GetAccount(body.AutoRenewAccount)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return services.ResponseCodeEnum_INVALID_AUTORENEW_ACCOUNT
+		}
+		if !transaction.signers._Satisfies(account.info.Key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+	}
+
+	autoRenewPeriod := body.AutoRenewPeriod
+	if autoRenewPeriod == nil {
+		autoRenewPeriod = _Duration(_AutoRenewPeriod)
+	}
+
+	num := ledger._NextNum()
+	ledger.topics[num] = &services.ConsensusTopicInfo{
+		Memo:             body.Memo,
+		RunningHash:      make([]byte, sha512.Size384),
+		ExpirationTime:   _Timestamp(_Time(record.ConsensusTimestamp).Add(_AutoRenewPeriod)),
+		AdminKey:         body.AdminKey,
+		SubmitKey:        body.SubmitKey,
+		AutoRenewPeriod:  autoRenewPeriod,
+		AutoRenewAccount: body.AutoRenewAccount,
+	}
+	record.Receipt.TopicID = &services.TopicID{TopicNum: num}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicUpdate(transaction *_Transaction, body *services.ConsensusUpdateTopicTransactionBody) services.ResponseCodeEnum {
+	topic, status := ledger._GetTopic(body.TopicID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if status := ledger._CheckTopicAdminKey(transaction, topic); status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if body.AdminKey != nil && !transaction.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.Memo != nil {
+		topic.Memo = body.Memo.Value
+	}
+	if body.AdminKey != nil {
+		topic.AdminKey = body.AdminKey
+	}
+	if body.SubmitKey != nil {
+		topic.SubmitKey = body.SubmitKey
+	}
+	if body.AutoRenewPeriod != nil {
+		topic.AutoRenewPeriod = body.AutoRenewPeriod
+	}
+	if body.AutoRenewAccount != nil {
+		topic.AutoRenewAccount = body.AutoRenewAccount
+	}
+	if body.ExpirationTime != nil {
+		topic.ExpirationTime = body.ExpirationTime
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicDelete(transaction *_Transaction, body *services.ConsensusDeleteTopicTransactionBody) services.ResponseCodeEnum {
+	topic, status := ledger._GetTopic(body.TopicID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if status := ledger._CheckTopicAdminKey(transaction, topic); status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	delete(ledger.topics, body.TopicID.TopicNum)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TopicSubmitMessage(transaction *_Transaction, body *services.ConsensusSubmitMessageTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	topic, status := ledger._GetTopic(body.TopicID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if topic.SubmitKey != nil && !transaction.signers._Satisfies(topic.SubmitKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if len(body.Message) == 0 {
+		return services.ResponseCodeEnum_INVALID_TOPIC_MESSAGE
+	}
+
+	topic.SequenceNumber++
+	topic.RunningHash = _RunningHash(topic.RunningHash, transaction.payer, body.TopicID, record.ConsensusTimestamp,
+		topic.SequenceNumber, body.Message)
+
+	record.Receipt.TopicSequenceNumber = topic.SequenceNumber
+	record.Receipt.TopicRunningHash = topic.RunningHash
+	record.Receipt.TopicRunningHashVersion = _RunningHashVersion
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _RunningHash returns the running hash of a topic after a message, hashing the previous running hash with the
+// message and where and when it was submitted, as consensus nodes do
+func _RunningHash(previous []byte, payer *services.AccountID, topicID *services.TopicID, consensusTimestamp *services.Timestamp, sequenceNumber uint64, message []byte) []byte {
+	var buffer bytes.Buffer
+	buffer.Write(previous)
+
+	for _, value := range []interface{}{
+		_RunningHashVersion,
+		payer.GetShardNum(), payer.GetRealmNum(), payer.GetAccountNum(),
+		topicID.GetShardNum(), topicID.GetRealmNum(), topicID.GetTopicNum(),
+		consensusTimestamp.GetSeconds(), consensusTimestamp.GetNanos(),
+		sequenceNumber,
+	} {
+		// writing fixed size values to a buffer can't fail
+		_ = binary.Write(&buffer, binary.BigEndian, value)
+	}
+
+	messageHash := sha512.Sum384(message)
+	buffer.Write(messageHash[:])
+
+	runningHash := sha512.Sum384(buffer.Bytes())
+	return runningHash[:]
+}
+
+func (ledger *_Ledger) _TopicGetInfo(query *services.ConsensusGetTopicInfoQuery) *services.Response {
+	response := services.ConsensusGetTopicInfoResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	topic, status := ledger._GetTopic(query.TopicID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		response.Header.NodeTransactionPrecheckCode = status
+	} else {
+		response.TopicID = &services.TopicID{TopicNum: query.TopicID.TopicNum}
+		response.TopicInfo = protobuf.Clone(topic).(*services.ConsensusTopicInfo)
+	}
+
+	return &services.Response{Response: &services.Response_ConsensusGetTopicInfo{ConsensusGetTopicInfo: &response}}
+}
@@ -0,0 +1,180 @@
+package hederatest
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _ContractCreate creates a contract from the bytecode of a file, either hex encoded as the network expects or raw as
+// ContractCreateFlow uploads it
+func (ledger *_Ledger) _ContractCreate(transaction *_Transaction, body *services.ContractCreateTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	file, status := ledger._GetFile(body.FileID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	bytecode, err := hex.DecodeString(strings.TrimSpace(string(file.contents)))
+	if err != nil {
+		bytecode = file.contents
+	}
+	if len(bytecode) == 0 {
+		return services.ResponseCodeEnum_CONTRACT_FILE_EMPTY
+	}
+	if body.AdminKey != nil && !transaction.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if body.InitialBalance < 0 {
+		return services.ResponseCodeEnum_CONTRACT_NEGATIVE_VALUE
+	}
+
+	payer, status := ledger._GetAccount(transaction.payer)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if payer.info.Balance < uint64(body.InitialBalance) {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+
+	ledger.simulator.SetNextContractNum(uint64(ledger.nextNum))
+	ledger._SetSimulatorSender(payer)
+	result, err := ledger.simulator.Create(hedera.NewContractCreateFlow().
+		SetBytecode(bytecode).
+		SetGas(body.Gas).
+		SetInitialBalance(hedera.HbarFromTinybar(body.InitialBalance)).
+		SetConstructorParametersRaw(body.ConstructorParameters))
+	ledger._GetSimulatorSender(payer)
+
+	record.Body = &services.TransactionRecord_ContractCreateResult{ContractCreateResult: _ContractFunctionResult(result)}
+	if err != nil {
+		return _ContractStatus(err)
+	}
+
+	num := int64(result.ContractID.Contract)
+	ledger.nextNum = int64(ledger.simulator.GetNextContractNum())
+	ledger.contracts[num] = true
+	record.Receipt.ContractID = _ContractID(num)
+	record.TransferList = &services.TransferList{AccountAmounts: _TransferList(map[int64]int64{
+		transaction.payer.GetAccountNum(): -body.InitialBalance,
+		num:                               body.InitialBalance,
+	})}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ContractCall(transaction *_Transaction, body *services.ContractCallTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	num := body.ContractID.GetContractNum()
+	if !ledger.contracts[num] {
+		return services.ResponseCodeEnum_INVALID_CONTRACT_ID
+	}
+	if body.Amount < 0 {
+		return services.ResponseCodeEnum_CONTRACT_NEGATIVE_VALUE
+	}
+
+	payer, status := ledger._GetAccount(transaction.payer)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if payer.info.Balance < uint64(body.Amount) {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+
+	ledger._SetSimulatorSender(payer)
+	result, err := ledger.simulator.Execute(hedera.NewContractExecuteTransaction().
+		SetContractID(hedera.ContractID{Contract: uint64(num)}).
+		SetGas(uint64(body.Gas)).
+		SetPayableAmount(hedera.HbarFromTinybar(body.Amount)).
+		SetFunctionParameters(body.FunctionParameters))
+	ledger._GetSimulatorSender(payer)
+
+	record.Body = &services.TransactionRecord_ContractCallResult{ContractCallResult: _ContractFunctionResult(result)}
+	if err != nil {
+		return _ContractStatus(err)
+	}
+
+	record.TransferList = &services.TransferList{AccountAmounts: _TransferList(map[int64]int64{
+		transaction.payer.GetAccountNum(): -body.Amount,
+		num:                               body.Amount,
+	})}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _SetSimulatorSender makes the account the sender of the simulator's next run, with its balance
+func (ledger *_Ledger) _SetSimulatorSender(account *_Account) {
+	accountID := hedera.AccountID{Account: uint64(account.info.AccountID.GetAccountNum())}
+	ledger.simulator.
+		SetSender(accountID).
+		SetAccountBalance(accountID, hedera.HbarFromTinybar(int64(account.info.Balance)))
+}
+
+// _GetSimulatorSender takes back the balance of the account after the simulator's run
+func (ledger *_Ledger) _GetSimulatorSender(account *_Account) {
+	accountID := hedera.AccountID{Account: uint64(account.info.AccountID.GetAccountNum())}
+	account.info.Balance = uint64(ledger.simulator.GetAccountBalance(accountID).AsTinybar())
+}
+
+func (ledger *_Ledger) _ContractCallLocal(query *services.ContractCallLocalQuery) *services.Response {
+	response := services.ContractCallLocalResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+	answer := &services.Response{Response: &services.Response_ContractCallLocal{ContractCallLocal: &response}}
+
+	num := query.ContractID.GetContractNum()
+	if !ledger.contracts[num] {
+		response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_CONTRACT_ID
+		return answer
+	}
+
+	// the call is made from the account paying for the query, as it is on the network
+	sender := hedera.AccountID{Account: 2}
+	if payment := _TransactionBody(query.Header.GetPayment()); payment != nil {
+		sender = hedera.AccountID{Account: uint64(payment.TransactionID.GetAccountID().GetAccountNum())}
+	}
+
+	result, err := ledger.simulator.
+		SetSender(sender).
+		Call(hedera.NewContractCallQuery().
+			SetContractID(hedera.ContractID{Contract: uint64(num)}).
+			SetGas(uint64(query.Gas)).
+			SetFunctionParameters(query.FunctionParameters))
+	if err != nil {
+		response.Header.NodeTransactionPrecheckCode = _ContractStatus(err)
+	}
+	response.FunctionResult = _ContractFunctionResult(result)
+
+	return answer
+}
+
+func (ledger *_Ledger) _ContractGetBytecode(query *services.ContractGetBytecodeQuery) *services.Response {
+	response := services.ContractGetBytecodeResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	num := query.ContractID.GetContractNum()
+	if ledger.contracts[num] {
+		response.Bytecode = append([]byte{}, ledger.simulator.GetContract(hedera.ContractID{Contract: uint64(num)})...)
+	} else {
+		response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_CONTRACT_ID
+	}
+
+	return &services.Response{Response: &services.Response_ContractGetBytecodeResponse{ContractGetBytecodeResponse: &response}}
+}
+
+// _ContractStatus returns the status of a failed simulator run
+func _ContractStatus(err error) services.ResponseCodeEnum {
+	switch err := err.(type) {
+	case hedera.ErrHederaReceiptStatus:
+		return services.ResponseCodeEnum(err.Status)
+	case hedera.ErrHederaPreCheckStatus:
+		return services.ResponseCodeEnum(err.Status)
+	default:
+		return services.ResponseCodeEnum_CONTRACT_EXECUTION_EXCEPTION
+	}
+}
+
+func _ContractFunctionResult(result hedera.ContractFunctionResult) *services.ContractFunctionResult {
+	var pb services.ContractFunctionResult
+	// the bytes were just marshaled from the same message
+	_ = protobuf.Unmarshal(result.ToBytes(), &pb)
+
+	return &pb
+}
@@ -0,0 +1,308 @@
+package hederatest
+
+import (
+	"sort"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func (ledger *_Ledger) _GetAccount(accountID *services.AccountID) (*_Account, services.ResponseCodeEnum) {
+	account, ok := ledger.accounts[accountID.GetAccountNum()]
+	if accountID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_ACCOUNT_ID
+	}
+	if account.info.Deleted {
+		return nil, services.ResponseCodeEnum_ACCOUNT_DELETED
+	}
+
+	return account, services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoCreate(transaction *_Transaction, body *services.CryptoCreateTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	if body.Key == nil {
+		return services.ResponseCodeEnum_KEY_REQUIRED
+	}
+	if body.ReceiverSigRequired && !transaction.signers._Satisfies(body.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	payer, status := ledger._GetAccount(transaction.payer)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if payer.info.Balance < body.InitialBalance {
+		return services.ResponseCodeEnum_INSUFFICIENT_PAYER_BALANCE
+	}
+
+	num := ledger._NextNum()
+	account := ledger._SetAccount(hedera.AccountID{Account: uint64(num)}, body.Key, int64(body.InitialBalance))
+	account.info.ReceiverSigRequired = body.ReceiverSigRequired
+	account.info.Memo = body.Memo
+	account.info.MaxAutomaticTokenAssociations = body.MaxAutomaticTokenAssociations
+	if body.AutoRenewPeriod != nil {
+		account.info.AutoRenewPeriod = body.AutoRenewPeriod
+	}
+	payer.info.Balance -= body.InitialBalance
+
+	record.Receipt.AccountID = account.info.AccountID
+	record.TransferList = &services.TransferList{AccountAmounts: _TransferList(map[int64]int64{
+		transaction.payer.GetAccountNum(): -int64(body.InitialBalance),
+		num:                               int64(body.InitialBalance),
+	})}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoUpdate(transaction *_Transaction, body *services.CryptoUpdateTransactionBody) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.AccountIDToUpdate)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if !transaction.signers._Satisfies(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if body.Key != nil && !transaction.signers._Satisfies(body.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.Key != nil {
+		account.info.Key = body.Key
+	}
+	if body.Memo != nil {
+		account.info.Memo = body.Memo.Value
+	}
+	if wrapper := body.GetReceiverSigRequiredWrapper(); wrapper != nil {
+		account.info.ReceiverSigRequired = wrapper.Value
+	} else if _, ok := body.ReceiverSigRequiredField.(*services.CryptoUpdateTransactionBody_ReceiverSigRequired); ok {
+		account.info.ReceiverSigRequired = body.GetReceiverSigRequired()
+	}
+	if body.AutoRenewPeriod != nil {
+		account.info.AutoRenewPeriod = body.AutoRenewPeriod
+	}
+	if body.ExpirationTime != nil {
+		account.info.ExpirationTime = body.ExpirationTime
+	}
+	if body.MaxAutomaticTokenAssociations != nil {
+		account.info.MaxAutomaticTokenAssociations = body.MaxAutomaticTokenAssociations.Value
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoTransfer(transaction *_Transaction, body *services.CryptoTransferTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	amounts := make(map[int64]int64)
+	sum := int64(0)
+	for _, transfer := range body.GetTransfers().GetAccountAmounts() {
+		status := ledger._CheckTransfer(transaction, transfer)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+
+		amounts[transfer.AccountID.GetAccountNum()] += transfer.Amount
+		sum += transfer.Amount
+	}
+	if sum != 0 {
+		return services.ResponseCodeEnum_INVALID_ACCOUNT_AMOUNTS
+	}
+
+	tokenAmounts := make(map[int64]map[int64]int64)
+	for _, list := range body.TokenTransfers {
+		token, status := ledger._GetToken(list.Token)
+		if status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		if len(list.NftTransfers) > 0 {
+			return services.ResponseCodeEnum_NOT_SUPPORTED
+		}
+		if list.ExpectedDecimals != nil && list.ExpectedDecimals.Value != token.Decimals {
+			return services.ResponseCodeEnum_UNEXPECTED_TOKEN_DECIMALS
+		}
+
+		tokenNum := list.Token.TokenNum
+		if tokenAmounts[tokenNum] == nil {
+			tokenAmounts[tokenNum] = make(map[int64]int64)
+		}
+
+		sum := int64(0)
+		for _, transfer := range list.Transfers {
+			status := ledger._CheckTransfer(transaction, transfer)
+			if status != services.ResponseCodeEnum_SUCCESS {
+				return status
+			}
+			if _, ok := ledger.accounts[transfer.AccountID.GetAccountNum()].tokens[tokenNum]; !ok {
+				return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+			}
+
+			tokenAmounts[tokenNum][transfer.AccountID.GetAccountNum()] += transfer.Amount
+			sum += transfer.Amount
+		}
+		if sum != 0 {
+			return services.ResponseCodeEnum_TRANSFERS_NOT_ZERO_SUM_FOR_TOKEN
+		}
+	}
+
+	for num, amount := range amounts {
+		if int64(ledger.accounts[num].info.Balance)+amount < 0 {
+			return services.ResponseCodeEnum_INSUFFICIENT_ACCOUNT_BALANCE
+		}
+	}
+	for tokenNum, amounts := range tokenAmounts {
+		for num, amount := range amounts {
+			if int64(ledger.accounts[num].tokens[tokenNum])+amount < 0 {
+				return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+			}
+		}
+	}
+
+	for num, amount := range amounts {
+		ledger.accounts[num].info.Balance = uint64(int64(ledger.accounts[num].info.Balance) + amount)
+	}
+	record.TransferList = &services.TransferList{AccountAmounts: _TransferList(amounts)}
+
+	tokenNums := make([]int64, 0, len(tokenAmounts))
+	for tokenNum, amounts := range tokenAmounts {
+		for num, amount := range amounts {
+			ledger.accounts[num].tokens[tokenNum] = uint64(int64(ledger.accounts[num].tokens[tokenNum]) + amount)
+		}
+		tokenNums = append(tokenNums, tokenNum)
+	}
+	sort.Slice(tokenNums, func(i, j int) bool { return tokenNums[i] < tokenNums[j] })
+	for _, tokenNum := range tokenNums {
+		record.TokenTransferLists = append(record.TokenTransferLists, &services.TokenTransferList{
+			Token:     &services.TokenID{TokenNum: tokenNum},
+			Transfers: _TransferList(tokenAmounts[tokenNum]),
+		})
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _CheckTransfer checks the account of a transfer exists and, if it has to, signed the transaction
+func (ledger *_Ledger) _CheckTransfer(transaction *_Transaction, transfer *services.AccountAmount) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(transfer.AccountID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	if transfer.Amount < 0 || (transfer.Amount > 0 && account.info.ReceiverSigRequired) {
+		if !transaction.signers._Satisfies(account.info.Key) {
+			return services.ResponseCodeEnum_INVALID_SIGNATURE
+		}
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoDelete(transaction *_Transaction, body *services.CryptoDeleteTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.DeleteAccountID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if body.TransferAccountID == nil {
+		return services.ResponseCodeEnum_ACCOUNT_ID_DOES_NOT_EXIST
+	}
+	transferAccount, status := ledger._GetAccount(body.TransferAccountID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if account == transferAccount {
+		return services.ResponseCodeEnum_TRANSFER_ACCOUNT_SAME_AS_DELETE_ACCOUNT
+	}
+	if !transaction.signers._Satisfies(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	for tokenNum, balance := range account.tokens {
+		if ledger.tokens[tokenNum].Treasury.GetAccountNum() == body.DeleteAccountID.GetAccountNum() {
+			return services.ResponseCodeEnum_ACCOUNT_IS_TREASURY
+		}
+		if balance > 0 {
+			return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+		}
+	}
+
+	record.TransferList = &services.TransferList{AccountAmounts: _TransferList(map[int64]int64{
+		body.DeleteAccountID.GetAccountNum():   -int64(account.info.Balance),
+		body.TransferAccountID.GetAccountNum(): int64(account.info.Balance),
+	})}
+	transferAccount.info.Balance += account.info.Balance
+	account.info.Balance = 0
+	account.info.Deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _CryptoGetAccountBalance(query *services.CryptoGetAccountBalanceQuery) *services.Response {
+	response := services.CryptoGetAccountBalanceResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+	answer := &services.Response{Response: &services.Response_CryptogetAccountBalance{CryptogetAccountBalance: &response}}
+
+	if contractID := query.GetContractID(); contractID != nil {
+		if !ledger.contracts[contractID.GetContractNum()] {
+			response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_CONTRACT_ID
+			return answer
+		}
+
+		balance := ledger.simulator.GetContractBalance(hedera.ContractID{Contract: uint64(contractID.GetContractNum())})
+		response.AccountID = _AccountID(contractID.GetContractNum())
+		response.Balance = uint64(balance.AsTinybar())
+
+		return answer
+	}
+
+	account, status := ledger._GetAccount(query.GetAccountID())
+	if status != services.ResponseCodeEnum_SUCCESS {
+		response.Header.NodeTransactionPrecheckCode = status
+		return answer
+	}
+
+	response.AccountID = _AccountID(account.info.AccountID.GetAccountNum())
+	response.Balance = account.info.Balance
+	for _, relationship := range ledger._TokenRelationships(account) {
+		response.TokenBalances = append(response.TokenBalances, &services.TokenBalance{
+			TokenId:  relationship.TokenId,
+			Balance:  relationship.Balance,
+			Decimals: relationship.Decimals,
+		})
+	}
+
+	return answer
+}
+
+func (ledger *_Ledger) _CryptoGetInfo(query *services.CryptoGetInfoQuery) *services.Response {
+	response := services.CryptoGetInfoResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	account, status := ledger._GetAccount(query.AccountID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		response.Header.NodeTransactionPrecheckCode = status
+	} else {
+		response.AccountInfo = protobuf.Clone(account.info).(*services.CryptoGetInfoResponse_AccountInfo)
+		response.AccountInfo.TokenRelationships = ledger._TokenRelationships(account)
+	}
+
+	return &services.Response{Response: &services.Response_CryptoGetInfo{CryptoGetInfo: &response}}
+}
+
+// _TokenRelationships returns the tokens associated with an account, in order of token
+func (ledger *_Ledger) _TokenRelationships(account *_Account) []*services.TokenRelationship {
+	tokenNums := make([]int64, 0, len(account.tokens))
+	for tokenNum := range account.tokens {
+		tokenNums = append(tokenNums, tokenNum)
+	}
+	sort.Slice(tokenNums, func(i, j int) bool { return tokenNums[i] < tokenNums[j] })
+
+	relationships := make([]*services.TokenRelationship, 0, len(tokenNums))
+	for _, tokenNum := range tokenNums {
+		token := ledger.tokens[tokenNum]
+		relationships = append(relationships, &services.TokenRelationship{
+			TokenId:      &services.TokenID{TokenNum: tokenNum},
+			Symbol:       token.Symbol,
+			Balance:      account.tokens[tokenNum],
+			KycStatus:    token.DefaultKycStatus,
+			FreezeStatus: token.DefaultFreezeStatus,
+			Decimals:     token.Decimals,
+		})
+	}
+
+	return relationships
+}
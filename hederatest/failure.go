@@ -0,0 +1,74 @@
+package hederatest
+
+import (
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"google.golang.org/grpc/codes"
+)
+
+// Failure is an answer a node gives to a request instead of handling it. Failures are injected with
+// Network.InjectFailures, and each one is used once, by the first request it matches.
+type Failure struct {
+	// NodeAccountID is the node to fail on, or nil for any node
+	NodeAccountID *hedera.AccountID
+	// Method is the gRPC method to fail, such as "cryptoTransfer" or "getTransactionReceipts", or "" for any method
+	Method string
+	// Status is the precheck status to answer with, such as hedera.StatusBusy or hedera.StatusTransactionExpired
+	Status hedera.Status
+	// Code, unless codes.OK, is the gRPC error to fail the call with, such as codes.Unavailable, instead of answering
+	Code codes.Code
+}
+
+// FailWithStatus returns a Failure answering with the precheck status
+func FailWithStatus(status hedera.Status) Failure {
+	return Failure{Status: status}
+}
+
+// FailWithCode returns a Failure failing the call with the gRPC error code
+func FailWithCode(code codes.Code) Failure {
+	return Failure{Code: code}
+}
+
+// OnNode returns the failure limited to the node
+func (failure Failure) OnNode(nodeAccountID hedera.AccountID) Failure {
+	failure.NodeAccountID = &nodeAccountID
+	return failure
+}
+
+// OnMethod returns the failure limited to the gRPC method
+func (failure Failure) OnMethod(method string) Failure {
+	failure.Method = method
+	return failure
+}
+
+func (failure Failure) _Matches(nodeAccountID hedera.AccountID, method string) bool {
+	return (failure.NodeAccountID == nil || failure.NodeAccountID.Account == nodeAccountID.Account) &&
+		(failure.Method == "" || failure.Method == method)
+}
+
+// InjectFailures queues failures for the nodes to answer with, in order
+func (network *Network) InjectFailures(failures ...Failure) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	network.failures = append(network.failures, failures...)
+}
+
+// GetPendingFailureCount returns how many injected failures haven't been used yet
+func (network *Network) GetPendingFailureCount() int {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	return len(network.failures)
+}
+
+// _TakeFailure removes and returns the first failure matching the request, the network's mutex being held
+func (network *Network) _TakeFailure(nodeAccountID hedera.AccountID, method string) (Failure, bool) {
+	for i, failure := range network.failures {
+		if failure._Matches(nodeAccountID, method) {
+			network.failures = append(network.failures[:i], network.failures[i+1:]...)
+			return failure, true
+		}
+	}
+
+	return Failure{}, false
+}
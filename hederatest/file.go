@@ -0,0 +1,149 @@
+package hederatest
+
+import (
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func (ledger *_Ledger) _GetFile(fileID *services.FileID) (*_File, services.ResponseCodeEnum) {
+	file, ok := ledger.files[fileID.GetFileNum()]
+	if fileID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_FILE_ID
+	}
+	if file.info.Deleted {
+		return nil, services.ResponseCodeEnum_FILE_DELETED
+	}
+
+	return file, services.ResponseCodeEnum_SUCCESS
+}
+
+// _CheckFileKeys checks a file can be changed, by every one of its keys signing
+func (ledger *_Ledger) _CheckFileKeys(transaction *_Transaction, file *_File) services.ResponseCodeEnum {
+	if len(file.info.Keys.GetKeys()) == 0 {
+		return services.ResponseCodeEnum_UNAUTHORIZED
+	}
+	if !transaction.signers._Satisfies(_KeyListKey(file.info.Keys)) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileCreate(transaction *_Transaction, body *services.FileCreateTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	keys := body.Keys
+	if keys == nil {
+		keys = &services.KeyList{}
+	}
+	if len(keys.Keys) > 0 && !transaction.signers._Satisfies(_KeyListKey(keys)) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	expirationTime := body.ExpirationTime
+	if expirationTime == nil {
+		expirationTime = _Timestamp(_Time(record.ConsensusTimestamp).Add(_AutoRenewPeriod))
+	}
+
+	num := ledger._NextNum()
+	ledger.files[num] = &_File{
+		info: &services.FileGetInfoResponse_FileInfo{
+			FileID:         &services.FileID{FileNum: num},
+			Size:           int64(len(body.Contents)),
+			ExpirationTime: expirationTime,
+			Keys:           keys,
+			Memo:           body.Memo,
+		},
+		contents: body.Contents,
+	}
+	record.Receipt.FileID = &services.FileID{FileNum: num}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileAppend(transaction *_Transaction, body *services.FileAppendTransactionBody) services.ResponseCodeEnum {
+	file, status := ledger._GetFile(body.FileID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if status := ledger._CheckFileKeys(transaction, file); status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	file.contents = append(append([]byte{}, file.contents...), body.Contents...)
+	file.info.Size = int64(len(file.contents))
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileUpdate(transaction *_Transaction, body *services.FileUpdateTransactionBody) services.ResponseCodeEnum {
+	file, status := ledger._GetFile(body.FileID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if status := ledger._CheckFileKeys(transaction, file); status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if len(body.Keys.GetKeys()) > 0 && !transaction.signers._Satisfies(_KeyListKey(body.Keys)) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	if body.Keys != nil {
+		file.info.Keys = body.Keys
+	}
+	if len(body.Contents) > 0 {
+		file.contents = body.Contents
+		file.info.Size = int64(len(file.contents))
+	}
+	if body.Memo != nil {
+		file.info.Memo = body.Memo.Value
+	}
+	if body.ExpirationTime != nil {
+		file.info.ExpirationTime = body.ExpirationTime
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileDelete(transaction *_Transaction, body *services.FileDeleteTransactionBody) services.ResponseCodeEnum {
+	file, status := ledger._GetFile(body.FileID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if status := ledger._CheckFileKeys(transaction, file); status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	file.contents = nil
+	file.info.Size = 0
+	file.info.Deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _FileGetContents(query *services.FileGetContentsQuery) *services.Response {
+	response := services.FileGetContentsResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	file, status := ledger._GetFile(query.FileID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		response.Header.NodeTransactionPrecheckCode = status
+	} else {
+		response.FileContents = &services.FileGetContentsResponse_FileContents{
+			FileID:   &services.FileID{FileNum: query.FileID.FileNum},
+			Contents: append([]byte{}, file.contents...),
+		}
+	}
+
+	return &services.Response{Response: &services.Response_FileGetContents{FileGetContents: &response}}
+}
+
+func (ledger *_Ledger) _FileGetInfo(query *services.FileGetInfoQuery) *services.Response {
+	response := services.FileGetInfoResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	// a deleted file still has its info
+	if file, ok := ledger.files[query.FileID.GetFileNum()]; ok {
+		response.FileInfo = protobuf.Clone(file.info).(*services.FileGetInfoResponse_FileInfo)
+	} else {
+		response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_FILE_ID
+	}
+
+	return &services.Response{Response: &services.Response_FileGetInfo{FileGetInfo: &response}}
+}
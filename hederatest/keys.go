@@ -0,0 +1,116 @@
+package hederatest
+
+import (
+	"encoding/hex"
+	"sort"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// _Signers is the set of public keys that signed a transaction, by their hex encoding
+type _Signers map[string]*services.Key
+
+// _VerifySignatures returns the keys of the signature map with a valid signature of the body
+func _VerifySignatures(bodyBytes []byte, sigMap *services.SignatureMap) _Signers {
+	signers := make(_Signers)
+
+	for _, pair := range sigMap.GetSigPair() {
+		var publicKey hedera.PublicKey
+		var signature []byte
+		var key *services.Key
+		var err error
+
+		switch sig := pair.GetSignature().(type) {
+		case *services.SignaturePair_Ed25519:
+			publicKey, err = hedera.PublicKeyFromBytesEd25519(pair.PubKeyPrefix)
+			signature = sig.Ed25519
+			key = &services.Key{Key: &services.Key_Ed25519{Ed25519: pair.PubKeyPrefix}}
+		case *services.SignaturePair_ECDSASecp256K1:
+			publicKey, err = hedera.PublicKeyFromBytesECDSA(pair.PubKeyPrefix)
+			signature = sig.ECDSASecp256K1
+			key = &services.Key{Key: &services.Key_ECDSASecp256K1{ECDSASecp256K1: pair.PubKeyPrefix}}
+		default:
+			continue
+		}
+
+		if err == nil && publicKey.Verify(bodyBytes, signature) {
+			signers[hex.EncodeToString(pair.PubKeyPrefix)] = key
+		}
+	}
+
+	return signers
+}
+
+// _Satisfies returns whether the signers satisfy the key: every key of a key list, or the threshold of a threshold
+// key. A nil key, an empty key list and contract keys can't be satisfied.
+func (signers _Signers) _Satisfies(key *services.Key) bool {
+	switch key := key.GetKey().(type) {
+	case *services.Key_Ed25519:
+		return signers[hex.EncodeToString(key.Ed25519)] != nil
+	case *services.Key_ECDSASecp256K1:
+		return signers[hex.EncodeToString(key.ECDSASecp256K1)] != nil
+	case *services.Key_KeyList:
+		keys := key.KeyList.GetKeys()
+		for _, key := range keys {
+			if !signers._Satisfies(key) {
+				return false
+			}
+		}
+
+		return len(keys) > 0
+	case *services.Key_ThresholdKey:
+		satisfied := uint32(0)
+		for _, key := range key.ThresholdKey.GetKeys().GetKeys() {
+			if signers._Satisfies(key) {
+				satisfied++
+			}
+		}
+
+		return key.ThresholdKey.GetThreshold() > 0 && satisfied >= key.ThresholdKey.GetThreshold()
+	default:
+		return false
+	}
+}
+
+func (signers _Signers) _Add(other _Signers) {
+	for name, key := range other {
+		signers[name] = key
+	}
+}
+
+// _KeyList returns the signers as a key list, in a stable order
+func (signers _Signers) _KeyList() *services.KeyList {
+	names := make([]string, 0, len(signers))
+	for name := range signers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	keys := make([]*services.Key, 0, len(names))
+	for _, name := range names {
+		keys = append(keys, signers[name])
+	}
+
+	return &services.KeyList{Keys: keys}
+}
+
+// _ProtoKey returns the protobuf key of a public key, an ed25519 key being 32 bytes and an ECDSA key 33 compressed
+// bytes
+func _ProtoKey(publicKey hedera.PublicKey) *services.Key {
+	bytes := publicKey.BytesRaw()
+	if len(bytes) == 32 {
+		return &services.Key{Key: &services.Key_Ed25519{Ed25519: bytes}}
+	}
+
+	return &services.Key{Key: &services.Key_ECDSASecp256K1{ECDSASecp256K1: bytes}}
+}
+
+// _KeyListKey returns a key list as a key, or nil for a nil list
+func _KeyListKey(keys *services.KeyList) *services.Key {
+	if keys == nil {
+		return nil
+	}
+
+	return &services.Key{Key: &services.Key_KeyList{KeyList: keys}}
+}
@@ -0,0 +1,312 @@
+package hederatest
+
+import (
+	"crypto/sha512"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/arhtur007/hedera-sdk-go/v2/hederasim"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// _FirstEntityNum is the number given to the first entity created on a ledger
+const _FirstEntityNum int64 = 1001
+
+// _AutoRenewPeriod is the auto renew period entities are created with when none is given, 90 days
+const _AutoRenewPeriod = 90 * 24 * time.Hour
+
+// _ScheduleExpiry is how long a schedule waits for its signatures, 30 minutes
+const _ScheduleExpiry = 30 * time.Minute
+
+// _MaxClockSkew is how far ahead of the node's clock a transaction may start
+const _MaxClockSkew = 10 * time.Second
+
+type _Account struct {
+	info *services.CryptoGetInfoResponse_AccountInfo
+	// tokens holds the balance of every associated token, by token number
+	tokens map[int64]uint64
+}
+
+type _File struct {
+	info     *services.FileGetInfoResponse_FileInfo
+	contents []byte
+}
+
+type _Schedule struct {
+	info    *services.ScheduleInfo
+	body    *services.TransactionBody
+	signers _Signers
+}
+
+// _Ledger is the state of a network. Entities of every type share their numbers, from _FirstEntityNum, and are kept
+// by number, as a ledger only has shard 0 and realm 0.
+type _Ledger struct {
+	nextNum       int64
+	consensusTime time.Time
+	accounts      map[int64]*_Account
+	files         map[int64]*_File
+	topics        map[int64]*services.ConsensusTopicInfo
+	tokens        map[int64]*services.TokenInfo
+	schedules     map[int64]*_Schedule
+	contracts     map[int64]bool
+	simulator     *hederasim.ContractSimulator
+	records       map[string]*services.TransactionRecord
+}
+
+// _Transaction is a transaction that passed its precheck, or a scheduled transaction being executed
+type _Transaction struct {
+	id          *services.TransactionID
+	payer       *services.AccountID
+	body        *services.TransactionBody
+	signers     _Signers
+	hash        []byte
+	scheduleRef *services.ScheduleID
+}
+
+func _NewLedger() *_Ledger {
+	return &_Ledger{
+		nextNum:   _FirstEntityNum,
+		accounts:  make(map[int64]*_Account),
+		files:     make(map[int64]*_File),
+		topics:    make(map[int64]*services.ConsensusTopicInfo),
+		tokens:    make(map[int64]*services.TokenInfo),
+		schedules: make(map[int64]*_Schedule),
+		contracts: make(map[int64]bool),
+		simulator: hederasim.NewContractSimulator(),
+		records:   make(map[string]*services.TransactionRecord),
+	}
+}
+
+func (ledger *_Ledger) _NextNum() int64 {
+	num := ledger.nextNum
+	ledger.nextNum++
+
+	return num
+}
+
+// _ConsensusTime returns the consensus time of the next transaction, later than every one before it
+func (ledger *_Ledger) _ConsensusTime() time.Time {
+	now := time.Now()
+	if !now.After(ledger.consensusTime) {
+		now = ledger.consensusTime.Add(time.Nanosecond)
+	}
+	ledger.consensusTime = now
+
+	return now
+}
+
+func (ledger *_Ledger) _SetAccount(accountID hedera.AccountID, key *services.Key, balance int64) *_Account {
+	num := int64(accountID.Account)
+	if num >= ledger.nextNum {
+		ledger.nextNum = num + 1
+	}
+
+	account := _Account{
+		info: &services.CryptoGetInfoResponse_AccountInfo{
+			AccountID:         _AccountID(num),
+			ContractAccountID: fmt.Sprintf("%040x", num),
+			Key:               key,
+			Balance:           uint64(balance),
+			ExpirationTime:    _Timestamp(time.Now().Add(_AutoRenewPeriod)),
+			AutoRenewPeriod:   _Duration(_AutoRenewPeriod),
+		},
+		tokens: make(map[int64]uint64),
+	}
+	ledger.accounts[num] = &account
+
+	return &account
+}
+
+// _Submit prechecks a transaction sent to a node and, when it passes, executes it
+func (ledger *_Ledger) _Submit(nodeAccountID hedera.AccountID, transaction *services.Transaction) services.ResponseCodeEnum {
+	bodyBytes, sigMap, hash := transaction.BodyBytes, transaction.SigMap, sha512.Sum384(transaction.BodyBytes)
+	if len(transaction.SignedTransactionBytes) > 0 {
+		var signedTransaction services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.SignedTransactionBytes, &signedTransaction); err != nil {
+			return services.ResponseCodeEnum_INVALID_TRANSACTION
+		}
+		bodyBytes, sigMap = signedTransaction.BodyBytes, signedTransaction.SigMap
+		hash = sha512.Sum384(transaction.SignedTransactionBytes)
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil || len(bodyBytes) == 0 {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_BODY
+	}
+
+	id := body.TransactionID
+	if id.GetAccountID() == nil || id.GetTransactionValidStart() == nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_ID
+	}
+	if id.Scheduled {
+		return services.ResponseCodeEnum_TRANSACTION_ID_FIELD_NOT_ALLOWED
+	}
+	if body.NodeAccountID.GetAccountNum() != int64(nodeAccountID.Account) {
+		return services.ResponseCodeEnum_INVALID_NODE_ACCOUNT
+	}
+
+	now := time.Now()
+	validStart := _Time(id.TransactionValidStart)
+	validDuration := time.Duration(body.TransactionValidDuration.GetSeconds()) * time.Second
+	if validDuration < 15*time.Second || validDuration > 180*time.Second {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_DURATION
+	}
+	if validStart.After(now.Add(_MaxClockSkew)) {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION_START
+	}
+	if now.After(validStart.Add(validDuration)) {
+		return services.ResponseCodeEnum_TRANSACTION_EXPIRED
+	}
+	if _, ok := ledger.records[_TransactionKey(id)]; ok {
+		return services.ResponseCodeEnum_DUPLICATE_TRANSACTION
+	}
+
+	payer, ok := ledger.accounts[id.AccountID.GetAccountNum()]
+	if !ok {
+		return services.ResponseCodeEnum_PAYER_ACCOUNT_NOT_FOUND
+	}
+	if payer.info.Deleted {
+		return services.ResponseCodeEnum_PAYER_ACCOUNT_DELETED
+	}
+
+	signers := _VerifySignatures(bodyBytes, sigMap)
+	if !signers._Satisfies(payer.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	ledger._Execute(&_Transaction{
+		id:      id,
+		payer:   id.AccountID,
+		body:    &body,
+		signers: signers,
+		hash:    hash[:],
+	})
+
+	return services.ResponseCodeEnum_OK
+}
+
+// _NewRecord returns the record of a transaction with a new consensus time, its receipt having no status yet
+func (ledger *_Ledger) _NewRecord(transaction *_Transaction) *services.TransactionRecord {
+	return &services.TransactionRecord{
+		Receipt:            &services.TransactionReceipt{},
+		TransactionHash:    transaction.hash,
+		ConsensusTimestamp: _Timestamp(ledger._ConsensusTime()),
+		TransactionID:      transaction.id,
+		Memo:               transaction.body.Memo,
+		ScheduleRef:        transaction.scheduleRef,
+	}
+}
+
+// _Execute applies a transaction, keeping its record whether or not it succeeds
+func (ledger *_Ledger) _Execute(transaction *_Transaction) *services.TransactionRecord {
+	record := ledger._NewRecord(transaction)
+	record.Receipt.Status = ledger._Apply(transaction, record)
+	ledger.records[_TransactionKey(transaction.id)] = record
+
+	return record
+}
+
+// _Apply applies the body of a transaction to the ledger, filling in its record. Every check is made before the
+// ledger is changed, so a transaction that fails leaves the ledger as it was.
+func (ledger *_Ledger) _Apply(transaction *_Transaction, record *services.TransactionRecord) services.ResponseCodeEnum {
+	switch data := transaction.body.Data.(type) {
+	case *services.TransactionBody_CryptoCreateAccount:
+		return ledger._CryptoCreate(transaction, data.CryptoCreateAccount, record)
+	case *services.TransactionBody_CryptoUpdateAccount:
+		return ledger._CryptoUpdate(transaction, data.CryptoUpdateAccount)
+	case *services.TransactionBody_CryptoTransfer:
+		return ledger._CryptoTransfer(transaction, data.CryptoTransfer, record)
+	case *services.TransactionBody_CryptoDelete:
+		return ledger._CryptoDelete(transaction, data.CryptoDelete, record)
+	case *services.TransactionBody_FileCreate:
+		return ledger._FileCreate(transaction, data.FileCreate, record)
+	case *services.TransactionBody_FileAppend:
+		return ledger._FileAppend(transaction, data.FileAppend)
+	case *services.TransactionBody_FileUpdate:
+		return ledger._FileUpdate(transaction, data.FileUpdate)
+	case *services.TransactionBody_FileDelete:
+		return ledger._FileDelete(transaction, data.FileDelete)
+	case *services.TransactionBody_ContractCreateInstance:
+		return ledger._ContractCreate(transaction, data.ContractCreateInstance, record)
+	case *services.TransactionBody_ContractCall:
+		return ledger._ContractCall(transaction, data.ContractCall, record)
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return ledger._TopicCreate(transaction, data.ConsensusCreateTopic, record)
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return ledger._TopicUpdate(transaction, data.ConsensusUpdateTopic)
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return ledger._TopicDelete(transaction, data.ConsensusDeleteTopic)
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return ledger._TopicSubmitMessage(transaction, data.ConsensusSubmitMessage, record)
+	case *services.TransactionBody_TokenCreation:
+		return ledger._TokenCreate(transaction, data.TokenCreation, record)
+	case *services.TransactionBody_TokenMint:
+		return ledger._TokenMint(transaction, data.TokenMint, record)
+	case *services.TransactionBody_TokenBurn:
+		return ledger._TokenBurn(transaction, data.TokenBurn, record)
+	case *services.TransactionBody_TokenAssociate:
+		return ledger._TokenAssociate(transaction, data.TokenAssociate)
+	case *services.TransactionBody_TokenDissociate:
+		return ledger._TokenDissociate(transaction, data.TokenDissociate)
+	case *services.TransactionBody_TokenDeletion:
+		return ledger._TokenDelete(transaction, data.TokenDeletion)
+	case *services.TransactionBody_ScheduleCreate:
+		return ledger._ScheduleCreate(transaction, data.ScheduleCreate, record)
+	case *services.TransactionBody_ScheduleSign:
+		return ledger._ScheduleSign(transaction, data.ScheduleSign, record)
+	case *services.TransactionBody_ScheduleDelete:
+		return ledger._ScheduleDelete(transaction, data.ScheduleDelete, record)
+	default:
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+}
+
+// _TransactionKey returns the key a transaction's record is kept by
+func _TransactionKey(id *services.TransactionID) string {
+	accountID := id.GetAccountID()
+	start := id.GetTransactionValidStart()
+
+	return fmt.Sprintf("%d.%d.%d@%d.%09d?scheduled=%t&nonce=%d", accountID.GetShardNum(), accountID.GetRealmNum(),
+		accountID.GetAccountNum(), start.GetSeconds(), start.GetNanos(), id.GetScheduled(), id.GetNonce())
+}
+
+// _TransferList returns the transfers of the amounts by account number, in order of account
+func _TransferList(amounts map[int64]int64) []*services.AccountAmount {
+	nums := make([]int64, 0, len(amounts))
+	for num, amount := range amounts {
+		if amount != 0 {
+			nums = append(nums, num)
+		}
+	}
+	sort.Slice(nums, func(i, j int) bool { return nums[i] < nums[j] })
+
+	transfers := make([]*services.AccountAmount, 0, len(nums))
+	for _, num := range nums {
+		transfers = append(transfers, &services.AccountAmount{AccountID: _AccountID(num), Amount: amounts[num]})
+	}
+
+	return transfers
+}
+
+func _AccountID(num int64) *services.AccountID {
+	return &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: num}}
+}
+
+func _ContractID(num int64) *services.ContractID {
+	return &services.ContractID{Contract: &services.ContractID_ContractNum{ContractNum: num}}
+}
+
+func _Timestamp(t time.Time) *services.Timestamp {
+	return &services.Timestamp{Seconds: t.Unix(), Nanos: int32(t.Nanosecond())}
+}
+
+func _Time(timestamp *services.Timestamp) time.Time {
+	return time.Unix(timestamp.GetSeconds(), int64(timestamp.GetNanos()))
+}
+
+func _Duration(duration time.Duration) *services.Duration {
+	return &services.Duration{Seconds: int64(duration / time.Second)}
+}
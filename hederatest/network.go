@@ -0,0 +1,208 @@
+// Package hederatest runs a fake Hedera network in process, for testing code that uses the SDK without a live
+// network.
+//
+// A Network serves the crypto, file, smart contract, consensus, token and schedule services over gRPC on localhost,
+// with every node sharing one in-memory ledger. Transactions are checked the way a node checks them, applied as soon
+// as they're submitted, and their receipts and records kept for queries. Fees aren't charged, and queries cost
+// nothing. Contracts run on a hederasim.ContractSimulator.
+//
+//	network, err := hederatest.NewNetwork(1)
+//	if err != nil {
+//		panic(err)
+//	}
+//	defer network.Close()
+//
+//	client := network.Client()
+//	balance, err := hedera.NewAccountBalanceQuery().
+//		SetAccountID(network.GetOperatorAccountID()).
+//		Execute(client)
+//
+// Failures can be scripted with InjectFailures, to test how code handles busy nodes, expired transactions and nodes
+// that can't be reached.
+package hederatest
+
+import (
+	"net"
+	"sync"
+	"time"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// OperatorBalance is the balance the operator account of a Network starts with
+var OperatorBalance = hedera.NewHbar(50000000000)
+
+var errNodeCount = errors.New("a network needs at least one node")
+
+// Network is a fake Hedera network of nodes sharing an in-memory ledger
+type Network struct {
+	mutex       sync.Mutex
+	ledger      *_Ledger
+	nodes       []*_Node
+	failures    []Failure
+	operatorID  hedera.AccountID
+	operatorKey hedera.PrivateKey
+}
+
+// NewNetwork starts a network of nodeCount nodes, with accounts 0.0.3 and on, listening on localhost. The operator
+// account 0.0.2 is given a new ed25519 key and OperatorBalance.
+func NewNetwork(nodeCount int) (*Network, error) {
+	if nodeCount < 1 {
+		return nil, errNodeCount
+	}
+
+	operatorKey, err := hedera.PrivateKeyGenerateEd25519()
+	if err != nil {
+		return nil, err
+	}
+
+	network := Network{
+		ledger:      _NewLedger(),
+		operatorID:  hedera.AccountID{Account: 2},
+		operatorKey: operatorKey,
+	}
+
+	for i := 0; i < nodeCount; i++ {
+		accountID := hedera.AccountID{Account: uint64(3 + i)}
+		network.ledger._SetAccount(accountID, nil, 0)
+
+		node, err := _StartNode(&network, accountID)
+		if err != nil {
+			network.Close()
+			return nil, err
+		}
+
+		network.nodes = append(network.nodes, node)
+	}
+
+	network.ledger._SetAccount(network.operatorID, _ProtoKey(operatorKey.PublicKey()), OperatorBalance.AsTinybar())
+
+	return &network, nil
+}
+
+// Close stops every node of the network
+func (network *Network) Close() {
+	for _, node := range network.nodes {
+		node.server.Stop()
+	}
+}
+
+// GetNodes returns the address and account ID of every node, as taken by hedera.ClientForNetwork
+func (network *Network) GetNodes() map[string]hedera.AccountID {
+	nodes := make(map[string]hedera.AccountID, len(network.nodes))
+	for _, node := range network.nodes {
+		nodes[node.listener.Addr().String()] = node.accountID
+	}
+
+	return nodes
+}
+
+// GetOperatorAccountID returns the account ID of the operator, 0.0.2
+func (network *Network) GetOperatorAccountID() hedera.AccountID {
+	return network.operatorID
+}
+
+// GetOperatorKey returns the private key of the operator
+func (network *Network) GetOperatorKey() hedera.PrivateKey {
+	return network.operatorKey
+}
+
+// Client returns a client for the network, with the operator set and backoffs short enough for tests
+func (network *Network) Client() *hedera.Client {
+	client := hedera.ClientForNetwork(network.GetNodes())
+	client.SetOperator(network.operatorID, network.operatorKey)
+	client.SetMinBackoff(time.Millisecond)
+	client.SetMaxBackoff(10 * time.Millisecond)
+	client.SetMinNodeReadmitTime(0)
+	client.SetMaxNodeReadmitTime(0)
+	client.SetNodeMinBackoff(0)
+	client.SetNodeMaxBackoff(0)
+
+	return client
+}
+
+// CreateAccount adds an account with the key and balance to the ledger, without a transaction
+func (network *Network) CreateAccount(key hedera.PublicKey, balance hedera.Hbar) hedera.AccountID {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	accountID := hedera.AccountID{Account: uint64(network.ledger._NextNum())}
+	network.ledger._SetAccount(accountID, _ProtoKey(key), balance.AsTinybar())
+
+	return accountID
+}
+
+// GetAccountBalance returns the hbar balance of an account, or zero if it doesn't exist
+func (network *Network) GetAccountBalance(accountID hedera.AccountID) hedera.Hbar {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if account, ok := network.ledger.accounts[int64(accountID.Account)]; ok {
+		return hedera.HbarFromTinybar(int64(account.info.Balance))
+	}
+
+	return hedera.ZeroHbar
+}
+
+// GetTokenBalance returns the balance of a token held by an account, or zero if the account isn't associated with it
+func (network *Network) GetTokenBalance(accountID hedera.AccountID, tokenID hedera.TokenID) uint64 {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if account, ok := network.ledger.accounts[int64(accountID.Account)]; ok {
+		return account.tokens[int64(tokenID.Token)]
+	}
+
+	return 0
+}
+
+// GetTransactionCount returns how many transactions the network has handled, including scheduled transactions that
+// were executed and transactions that failed after their precheck
+func (network *Network) GetTransactionCount() int {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	return len(network.ledger.records)
+}
+
+// _Node is a gRPC server answering for one node account of a network
+type _Node struct {
+	network   *Network
+	accountID hedera.AccountID
+	listener  net.Listener
+	server    *grpc.Server
+}
+
+func _StartNode(network *Network, accountID hedera.AccountID) (*_Node, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	node := _Node{
+		network:   network,
+		accountID: accountID,
+		listener:  listener,
+		server:    grpc.NewServer(),
+	}
+
+	for _, service := range []*grpc.ServiceDesc{
+		&services.CryptoService_ServiceDesc,
+		&services.FileService_ServiceDesc,
+		&services.SmartContractService_ServiceDesc,
+		&services.ConsensusService_ServiceDesc,
+		&services.TokenService_ServiceDesc,
+		&services.ScheduleService_ServiceDesc,
+	} {
+		node.server.RegisterService(node._ServiceDescription(service), nil)
+	}
+
+	go func() {
+		_ = node.server.Serve(listener)
+	}()
+
+	return &node, nil
+}
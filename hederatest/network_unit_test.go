@@ -0,0 +1,457 @@
+//go:build all || unit
+// +build all unit
+
+package hederatest
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/arhtur007/hedera-sdk-go/v2"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// the stateful contract of ./examples/create_stateful_contract, with setMessage(string) only changing the message
+// when called by its creator
+const testStatefulContractBytecode = `608060405234801561001057600080fd5b506040516104d73803806104d78339818101604052602081101561003357600080fd5b810190808051604051939291908464010000000082111561005357600080fd5b90830190602082018581111561006857600080fd5b825164010000000081118282018810171561008257600080fd5b82525081516020918201929091019080838360005b838110156100af578181015183820152602001610097565b50505050905090810190601f1680156100dc5780820380516001836020036101000a031916815260200191505b506040525050600080546001600160a01b0319163317905550805161010890600190602084019061010f565b50506101aa565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061015057805160ff191683800117855561017d565b8280016001018555821561017d579182015b8281111561017d578251825591602001919060010190610162565b5061018992915061018d565b5090565b6101a791905b808211156101895760008155600101610193565b90565b61031e806101b96000396000f3fe608060405234801561001057600080fd5b50600436106100415760003560e01c8063368b87721461004657806341c0e1b5146100ee578063ce6d41de146100f6575b600080fd5b6100ec6004803603602081101561005c57600080fd5b81019060208101813564010000000081111561007757600080fd5b82018360208201111561008957600080fd5b803590602001918460018302840111640100000000831117156100ab57600080fd5b91908080601f016020809104026020016040519081016040528093929190818152602001838380828437600092019190915250929550610173945050505050565b005b6100ec6101a2565b6100fe6101ba565b6040805160208082528351818301528351919283929083019185019080838360005b83811015610138578181015183820152602001610120565b50505050905090810190601f1680156101655780820380516001836020036101000a031916815260200191505b509250505060405180910390f35b6000546001600160a01b0316331461018a5761019f565b805161019d906001906020840190610250565b505b50565b6000546001600160a01b03163314156101b85733ff5b565b60018054604080516020601f600260001961010087891615020190951694909404938401819004810282018101909252828152606093909290918301828280156102455780601f1061021a57610100808354040283529160200191610245565b820191906000526020600020905b81548152906001019060200180831161022857829003601f168201915b505050505090505b90565b828054600181600116156101000203166002900490600052602060002090601f016020900481019282601f1061029157805160ff19168380011785556102be565b828001600101855582156102be579182015b828111156102be5782518255916020019190600101906102a3565b506102ca9291506102ce565b5090565b61024d91905b808211156102ca57600081556001016102d456fea264697066735822122084964d4c3f6bc912a9d20e14e449721012d625aa3c8a12de41ae5519752fc89064736f6c63430006000033`
+
+func _NewTestNetwork(t *testing.T, nodeCount int) (*Network, *hedera.Client) {
+	network, err := NewNetwork(nodeCount)
+	require.NoError(t, err)
+	t.Cleanup(network.Close)
+
+	return network, network.Client()
+}
+
+func _NewTestAccount(t *testing.T, network *Network, balance hedera.Hbar) (hedera.AccountID, hedera.PrivateKey) {
+	key, err := hedera.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	return network.CreateAccount(key.PublicKey(), balance), key
+}
+
+func TestUnitNetworkNodeCount(t *testing.T) {
+	_, err := NewNetwork(0)
+	assert.Error(t, err)
+
+	network, _ := _NewTestNetwork(t, 3)
+	nodes := network.GetNodes()
+	assert.Len(t, nodes, 3)
+	for _, accountID := range nodes {
+		assert.GreaterOrEqual(t, accountID.Account, uint64(3))
+		assert.LessOrEqual(t, accountID.Account, uint64(5))
+	}
+}
+
+func TestUnitNetworkAccountCreateAndTransfer(t *testing.T) {
+	network, client := _NewTestNetwork(t, 1)
+
+	key, err := hedera.PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	response, err := hedera.NewAccountCreateTransaction().
+		SetKey(key.PublicKey()).
+		SetInitialBalance(hedera.NewHbar(10)).
+		Execute(client)
+	require.NoError(t, err)
+
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	require.NotNil(t, receipt.AccountID)
+	accountID := *receipt.AccountID
+	assert.Equal(t, uint64(1001), accountID.Account)
+
+	response, err = hedera.NewTransferTransaction().
+		AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(-5)).
+		AddHbarTransfer(accountID, hedera.NewHbar(5)).
+		Execute(client)
+	require.NoError(t, err)
+
+	record, err := response.GetRecord(client)
+	require.NoError(t, err)
+	assert.Equal(t, hedera.StatusSuccess, record.Receipt.Status)
+	assert.Equal(t, response.TransactionID.String(), record.TransactionID.String())
+	assert.Len(t, record.Transfers, 2)
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hedera.NewHbar(15), balance.Hbars)
+	assert.Equal(t, OperatorBalance.AsTinybar()-hedera.NewHbar(15).AsTinybar(),
+		network.GetAccountBalance(network.GetOperatorAccountID()).AsTinybar())
+
+	info, err := hedera.NewAccountInfoQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, key.PublicKey().String(), info.Key.String())
+	assert.Equal(t, 2, network.GetTransactionCount())
+}
+
+func TestUnitNetworkTransferFailures(t *testing.T) {
+	network, client := _NewTestNetwork(t, 1)
+	accountID, key := _NewTestAccount(t, network, hedera.NewHbar(1))
+
+	// the account has to sign to be debited
+	response, err := hedera.NewTransferTransaction().
+		AddHbarTransfer(accountID, hedera.NewHbar(-1)).
+		AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	var receiptErr hedera.ErrHederaReceiptStatus
+	require.True(t, errors.As(err, &receiptErr))
+	assert.Equal(t, hedera.StatusInvalidSignature, receiptErr.Status)
+
+	transfer, err := hedera.NewTransferTransaction().
+		AddHbarTransfer(accountID, hedera.NewHbar(-2)).
+		AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(2)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = transfer.Sign(key).Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.Error(t, err)
+	assert.Equal(t, hedera.StatusInsufficientAccountBalance, receipt.Status)
+	assert.Equal(t, hedera.NewHbar(1), network.GetAccountBalance(accountID))
+
+	// a transaction can't be submitted twice
+	_, err = transfer.Execute(client)
+	var precheckErr hedera.ErrHederaPreCheckStatus
+	require.ErrorAs(t, err, &precheckErr)
+	assert.Equal(t, hedera.StatusDuplicateTransaction, precheckErr.Status)
+}
+
+func TestUnitNetworkToken(t *testing.T) {
+	network, client := _NewTestNetwork(t, 1)
+	accountID, key := _NewTestAccount(t, network, hedera.NewHbar(1))
+	operatorKey := network.GetOperatorKey().PublicKey()
+
+	response, err := hedera.NewTokenCreateTransaction().
+		SetTokenName("ffff").
+		SetTokenSymbol("F").
+		SetDecimals(3).
+		SetInitialSupply(1000).
+		SetTreasuryAccountID(network.GetOperatorAccountID()).
+		SetAdminKey(operatorKey).
+		SetSupplyKey(operatorKey).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	tokenID := *receipt.TokenID
+
+	// the account isn't associated with the token yet
+	response, err = hedera.NewTransferTransaction().
+		AddTokenTransfer(tokenID, network.GetOperatorAccountID(), -10).
+		AddTokenTransfer(tokenID, accountID, 10).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.Error(t, err)
+	assert.Equal(t, hedera.StatusTokenNotAssociatedToAccount, receipt.Status)
+
+	associate, err := hedera.NewTokenAssociateTransaction().
+		SetAccountID(accountID).
+		SetTokenIDs(tokenID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = associate.Sign(key).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hedera.NewTransferTransaction().
+		AddTokenTransferWithDecimals(tokenID, network.GetOperatorAccountID(), -10, 3).
+		AddTokenTransferWithDecimals(tokenID, accountID, 10, 3).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	response, err = hedera.NewTokenMintTransaction().
+		SetTokenID(tokenID).
+		SetAmount(500).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1500), receipt.TotalSupply)
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(accountID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), balance.Tokens.Get(tokenID))
+	assert.Equal(t, uint64(1490), network.GetTokenBalance(network.GetOperatorAccountID(), tokenID))
+
+	info, err := hedera.NewTokenInfoQuery().
+		SetTokenID(tokenID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "ffff", info.Name)
+	assert.Equal(t, uint64(1500), info.TotalSupply)
+	assert.Equal(t, network.GetOperatorAccountID().String(), info.Treasury.String())
+}
+
+func TestUnitNetworkTopic(t *testing.T) {
+	_, client := _NewTestNetwork(t, 1)
+
+	response, err := hedera.NewTopicCreateTransaction().
+		SetTopicMemo("memo").
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	topicID := *receipt.TopicID
+
+	var runningHash []byte
+	for i := uint64(1); i <= 2; i++ {
+		response, err = hedera.NewTopicMessageSubmitTransaction().
+			SetTopicID(topicID).
+			SetMessage([]byte("hello")).
+			Execute(client)
+		require.NoError(t, err)
+		receipt, err = response.GetReceipt(client)
+		require.NoError(t, err)
+
+		assert.Equal(t, i, receipt.TopicSequenceNumber)
+		assert.Len(t, receipt.TopicRunningHash, 48)
+		assert.NotEqual(t, runningHash, receipt.TopicRunningHash)
+		runningHash = receipt.TopicRunningHash
+	}
+
+	info, err := hedera.NewTopicInfoQuery().
+		SetTopicID(topicID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, "memo", info.TopicMemo)
+	assert.Equal(t, uint64(2), info.SequenceNumber)
+	assert.Equal(t, runningHash, info.RunningHash)
+}
+
+func TestUnitNetworkFile(t *testing.T) {
+	network, client := _NewTestNetwork(t, 1)
+
+	response, err := hedera.NewFileCreateTransaction().
+		SetKeys(network.GetOperatorKey().PublicKey()).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	fileID := *receipt.FileID
+
+	response, err = hedera.NewFileAppendTransaction().
+		SetFileID(fileID).
+		SetContents([]byte(" world")).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	contents, err := hedera.NewFileContentsQuery().
+		SetFileID(fileID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello world"), contents)
+
+	response, err = hedera.NewFileDeleteTransaction().
+		SetFileID(fileID).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	info, err := hedera.NewFileInfoQuery().
+		SetFileID(fileID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.True(t, info.IsDeleted)
+}
+
+func TestUnitNetworkSchedule(t *testing.T) {
+	network, client := _NewTestNetwork(t, 1)
+	accountID, key := _NewTestAccount(t, network, hedera.NewHbar(10))
+
+	scheduled, err := hedera.NewScheduleCreateTransaction().
+		SetScheduledTransaction(hedera.NewTransferTransaction().
+			AddHbarTransfer(accountID, hedera.NewHbar(-1)).
+			AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(1)))
+	require.NoError(t, err)
+
+	response, err := scheduled.Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	scheduleID := *receipt.ScheduleID
+	scheduledTransactionID := *receipt.ScheduledTransactionID
+
+	// the account hasn't signed yet
+	info, err := hedera.NewScheduleInfoQuery().
+		SetScheduleID(scheduleID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Nil(t, info.ExecutedAt)
+	assert.Equal(t, hedera.NewHbar(10), network.GetAccountBalance(accountID))
+
+	sign, err := hedera.NewScheduleSignTransaction().
+		SetScheduleID(scheduleID).
+		FreezeWith(client)
+	require.NoError(t, err)
+	response, err = sign.Sign(key).Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	receipt, err = hedera.NewTransactionReceiptQuery().
+		SetTransactionID(scheduledTransactionID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hedera.StatusSuccess, receipt.Status)
+	assert.Equal(t, hedera.NewHbar(9), network.GetAccountBalance(accountID))
+
+	info, err = hedera.NewScheduleInfoQuery().
+		SetScheduleID(scheduleID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.NotNil(t, info.ExecutedAt)
+}
+
+func TestUnitNetworkContract(t *testing.T) {
+	_, client := _NewTestNetwork(t, 1)
+
+	response, err := hedera.NewContractCreateFlow().
+		SetBytecodeWithString(testStatefulContractBytecode).
+		SetGas(300000).
+		SetConstructorParameters(hedera.NewContractFunctionParameters().AddString("hello from hedera")).
+		Execute(client)
+	require.NoError(t, err)
+	receipt, err := response.GetReceipt(client)
+	require.NoError(t, err)
+	contractID := *receipt.ContractID
+
+	getMessage := func() string {
+		result, err := hedera.NewContractCallQuery().
+			SetContractID(contractID).
+			SetGas(100000).
+			SetFunction("getMessage", nil).
+			Execute(client)
+		require.NoError(t, err)
+		return result.GetString(0)
+	}
+	assert.Equal(t, "hello from hedera", getMessage())
+
+	response, err = hedera.NewContractExecuteTransaction().
+		SetContractID(contractID).
+		SetGas(100000).
+		SetFunction("setMessage", hedera.NewContractFunctionParameters().AddString("new message")).
+		Execute(client)
+	require.NoError(t, err)
+	record, err := response.GetRecord(client)
+	require.NoError(t, err)
+	require.NotNil(t, record.CallResult)
+	assert.Greater(t, record.CallResult.GasUsed, uint64(0))
+	assert.Equal(t, "new message", getMessage())
+
+	bytecode, err := hedera.NewContractBytecodeQuery().
+		SetContractID(contractID).
+		Execute(client)
+	require.NoError(t, err)
+	assert.NotEmpty(t, bytecode)
+}
+
+func TestUnitNetworkInjectBusy(t *testing.T) {
+	network, client := _NewTestNetwork(t, 1)
+	network.InjectFailures(
+		FailWithStatus(hedera.StatusBusy).OnMethod("cryptoTransfer"),
+		FailWithStatus(hedera.StatusBusy).OnMethod("cryptoTransfer"),
+		FailWithStatus(hedera.StatusBusy).OnMethod("cryptoGetBalance"),
+	)
+
+	response, err := hedera.NewTransferTransaction().
+		AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(-1)).
+		AddHbarTransfer(hedera.AccountID{Account: 3}, hedera.NewHbar(1)).
+		Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	balance, err := hedera.NewAccountBalanceQuery().
+		SetAccountID(hedera.AccountID{Account: 3}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hedera.NewHbar(1), balance.Hbars)
+	assert.Equal(t, 0, network.GetPendingFailureCount())
+}
+
+func TestUnitNetworkInjectTransactionExpired(t *testing.T) {
+	network, client := _NewTestNetwork(t, 1)
+	network.InjectFailures(FailWithStatus(hedera.StatusTransactionExpired))
+
+	transfer := hedera.NewTransferTransaction().
+		AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(-1)).
+		AddHbarTransfer(hedera.AccountID{Account: 3}, hedera.NewHbar(1))
+	response, err := transfer.Execute(client)
+	require.NoError(t, err)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+	assert.Equal(t, 0, network.GetPendingFailureCount())
+
+	// without regenerating its transaction ID, the transaction fails
+	network.InjectFailures(FailWithStatus(hedera.StatusTransactionExpired))
+	_, err = hedera.NewTransferTransaction().
+		AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(-1)).
+		AddHbarTransfer(hedera.AccountID{Account: 3}, hedera.NewHbar(1)).
+		SetTransactionID(hedera.TransactionIDGenerate(network.GetOperatorAccountID())).
+		Execute(client)
+	var precheckErr hedera.ErrHederaPreCheckStatus
+	require.ErrorAs(t, err, &precheckErr)
+	assert.Equal(t, hedera.StatusTransactionExpired, precheckErr.Status)
+}
+
+func TestUnitNetworkInjectUnavailable(t *testing.T) {
+	network, client := _NewTestNetwork(t, 2)
+	network.InjectFailures(FailWithCode(codes.Unavailable).OnNode(hedera.AccountID{Account: 3}))
+
+	// the transaction is sent to the other node
+	response, err := hedera.NewTransferTransaction().
+		AddHbarTransfer(network.GetOperatorAccountID(), hedera.NewHbar(-1)).
+		AddHbarTransfer(hedera.AccountID{Account: 3}, hedera.NewHbar(1)).
+		SetNodeAccountIDs([]hedera.AccountID{{Account: 3}, {Account: 4}}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, hedera.AccountID{Account: 4}, response.NodeID)
+	_, err = response.GetReceipt(client)
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, network.GetPendingFailureCount())
+	assert.Equal(t, hedera.NewHbar(1), network.GetAccountBalance(hedera.AccountID{Account: 3}))
+}
+
+func TestUnitNetworkAnswer(t *testing.T) {
+	// every query has to be answerable with only a header
+	queries := services.File_query_proto.Messages().ByName("Query").Oneofs().ByName("query").Fields()
+	for i := 0; i < queries.Len(); i++ {
+		query := services.Query{}
+		message := query.ProtoReflect()
+		message.Set(queries.Get(i), message.NewField(queries.Get(i)))
+
+		response := _Answer(&query, _Header(nil, services.ResponseCodeEnum_BUSY))
+		field := response.ProtoReflect().WhichOneof(response.ProtoReflect().Descriptor().Oneofs().ByName("response"))
+		require.NotNil(t, field, queries.Get(i).Name())
+		assert.True(t, strings.HasPrefix(strings.ToLower(string(field.Name())), strings.ToLower(string(queries.Get(i).Name()))),
+			queries.Get(i).Name())
+
+		header := response.ProtoReflect().Get(field).Message().Get(field.Message().Fields().ByName("header"))
+		assert.Equal(t, protoreflect.EnumNumber(services.ResponseCodeEnum_BUSY),
+			header.Message().Get(header.Message().Descriptor().Fields().ByName("nodeTransactionPrecheckCode")).Enum(),
+			queries.Get(i).Name())
+	}
+}
@@ -0,0 +1,239 @@
+package hederatest
+
+import (
+	"context"
+	"reflect"
+	"strings"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var queryType = reflect.TypeOf((*services.Query)(nil))
+
+// _ServiceDescription returns the description of a generated service with every method answered by the node
+func (node *_Node) _ServiceDescription(service *grpc.ServiceDesc) *grpc.ServiceDesc {
+	handlerType := reflect.TypeOf(service.HandlerType).Elem()
+
+	methods := make([]grpc.MethodDesc, 0, len(service.Methods))
+	for _, desc := range service.Methods {
+		methods = append(methods, grpc.MethodDesc{
+			MethodName: desc.MethodName,
+			Handler:    node._Handler(desc.MethodName, _IsQueryMethod(handlerType, desc.MethodName)),
+		})
+	}
+
+	return &grpc.ServiceDesc{
+		ServiceName: service.ServiceName,
+		HandlerType: service.HandlerType,
+		Methods:     methods,
+		Streams:     []grpc.StreamDesc{},
+		Metadata:    service.Metadata,
+	}
+}
+
+// _IsQueryMethod returns whether a method of a service takes a Query, rather than a Transaction
+func _IsQueryMethod(handlerType reflect.Type, methodName string) bool {
+	method, ok := handlerType.MethodByName(strings.ToUpper(methodName[:1]) + methodName[1:])
+	return ok && method.Type.NumIn() > 1 && method.Type.In(1) == queryType
+}
+
+func (node *_Node) _Handler(method string, query bool) func(interface{}, context.Context, func(interface{}) error, grpc.UnaryServerInterceptor) (interface{}, error) {
+	return func(_ interface{}, _ context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+		if query {
+			request := new(services.Query)
+			if err := dec(request); err != nil {
+				return nil, err
+			}
+			return node._Query(method, request)
+		}
+
+		request := new(services.Transaction)
+		if err := dec(request); err != nil {
+			return nil, err
+		}
+		return node._Transaction(method, request)
+	}
+}
+
+func (node *_Node) _Transaction(method string, request *services.Transaction) (*services.TransactionResponse, error) {
+	network := node.network
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	if failure, ok := network._TakeFailure(node.accountID, method); ok {
+		if failure.Code != codes.OK {
+			return nil, status.Error(failure.Code, "hederatest: injected failure")
+		}
+
+		return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum(failure.Status)}, nil
+	}
+
+	return &services.TransactionResponse{
+		NodeTransactionPrecheckCode: network.ledger._Submit(node.accountID, request),
+	}, nil
+}
+
+func (node *_Node) _Query(method string, request *services.Query) (*services.Response, error) {
+	network := node.network
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	header := _QueryHeader(request)
+	if failure, ok := network._TakeFailure(node.accountID, method); ok {
+		if failure.Code != codes.OK {
+			return nil, status.Error(failure.Code, "hederatest: injected failure")
+		}
+
+		return _Answer(request, _Header(header, services.ResponseCodeEnum(failure.Status))), nil
+	}
+
+	// queries are free
+	switch header.GetResponseType() {
+	case services.ResponseType_COST_ANSWER, services.ResponseType_COST_ANSWER_STATE_PROOF:
+		return _Answer(request, _Header(header, services.ResponseCodeEnum_OK)), nil
+	}
+
+	ledger := network.ledger
+	switch query := request.Query.(type) {
+	case *services.Query_CryptogetAccountBalance:
+		return ledger._CryptoGetAccountBalance(query.CryptogetAccountBalance), nil
+	case *services.Query_CryptoGetInfo:
+		return ledger._CryptoGetInfo(query.CryptoGetInfo), nil
+	case *services.Query_TransactionGetReceipt:
+		return ledger._TransactionGetReceipt(query.TransactionGetReceipt), nil
+	case *services.Query_TransactionGetRecord:
+		return ledger._TransactionGetRecord(query.TransactionGetRecord), nil
+	case *services.Query_FileGetContents:
+		return ledger._FileGetContents(query.FileGetContents), nil
+	case *services.Query_FileGetInfo:
+		return ledger._FileGetInfo(query.FileGetInfo), nil
+	case *services.Query_ContractCallLocal:
+		return ledger._ContractCallLocal(query.ContractCallLocal), nil
+	case *services.Query_ContractGetBytecode:
+		return ledger._ContractGetBytecode(query.ContractGetBytecode), nil
+	case *services.Query_ConsensusGetTopicInfo:
+		return ledger._TopicGetInfo(query.ConsensusGetTopicInfo), nil
+	case *services.Query_TokenGetInfo:
+		return ledger._TokenGetInfo(query.TokenGetInfo), nil
+	case *services.Query_ScheduleGetInfo:
+		return ledger._ScheduleGetInfo(query.ScheduleGetInfo), nil
+	default:
+		return _Answer(request, _Header(header, services.ResponseCodeEnum_NOT_SUPPORTED)), nil
+	}
+}
+
+func (ledger *_Ledger) _TransactionGetReceipt(query *services.TransactionGetReceiptQuery) *services.Response {
+	response := services.TransactionGetReceiptResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	if record, ok := ledger.records[_TransactionKey(query.TransactionID)]; ok {
+		response.Receipt = protobuf.Clone(record.Receipt).(*services.TransactionReceipt)
+	} else {
+		response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_RECEIPT_NOT_FOUND
+	}
+
+	return &services.Response{Response: &services.Response_TransactionGetReceipt{TransactionGetReceipt: &response}}
+}
+
+func (ledger *_Ledger) _TransactionGetRecord(query *services.TransactionGetRecordQuery) *services.Response {
+	response := services.TransactionGetRecordResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	if record, ok := ledger.records[_TransactionKey(query.TransactionID)]; ok {
+		response.TransactionRecord = protobuf.Clone(record).(*services.TransactionRecord)
+	} else {
+		response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_RECORD_NOT_FOUND
+	}
+
+	return &services.Response{Response: &services.Response_TransactionGetRecord{TransactionGetRecord: &response}}
+}
+
+func _Header(header *services.QueryHeader, code services.ResponseCodeEnum) *services.ResponseHeader {
+	return &services.ResponseHeader{
+		NodeTransactionPrecheckCode: code,
+		ResponseType:                header.GetResponseType(),
+	}
+}
+
+// _QueryField returns the field of the query that's set
+func _QueryField(query *services.Query) protoreflect.FieldDescriptor {
+	message := query.ProtoReflect()
+	return message.WhichOneof(message.Descriptor().Oneofs().ByName("query"))
+}
+
+// _QueryHeader returns the header of any query
+func _QueryHeader(query *services.Query) *services.QueryHeader {
+	field := _QueryField(query)
+	if field == nil {
+		return nil
+	}
+
+	header := query.ProtoReflect().Get(field).Message()
+	headerField := header.Descriptor().Fields().ByName("header")
+	if headerField == nil || !header.Has(headerField) {
+		return nil
+	}
+
+	queryHeader, _ := header.Get(headerField).Message().Interface().(*services.QueryHeader)
+	return queryHeader
+}
+
+// _Answer returns an answer to any query with only a header, the fields of a response being named after the
+// queries they answer
+func _Answer(query *services.Query, header *services.ResponseHeader) *services.Response {
+	response := services.Response{}
+
+	field := _QueryField(query)
+	if field == nil {
+		return &response
+	}
+
+	message := response.ProtoReflect()
+	responseField := _ResponseField(message.Descriptor(), field.Name())
+	if responseField == nil {
+		return &response
+	}
+
+	answer := message.NewField(responseField)
+	if headerField := answer.Message().Descriptor().Fields().ByName("header"); headerField != nil {
+		answer.Message().Set(headerField, protoreflect.ValueOfMessage(header.ProtoReflect()))
+	}
+	message.Set(responseField, answer)
+
+	return &response
+}
+
+// _ResponseField returns the field of a response answering a query field, which has the same name, up to case and a
+// "Response" suffix
+func _ResponseField(response protoreflect.MessageDescriptor, queryName protoreflect.Name) protoreflect.FieldDescriptor {
+	fields := response.Oneofs().ByName("response").Fields()
+	for i := 0; i < fields.Len(); i++ {
+		if strings.EqualFold(strings.TrimSuffix(string(fields.Get(i).Name()), "Response"), string(queryName)) {
+			return fields.Get(i)
+		}
+	}
+
+	return nil
+}
+
+// _TransactionBody returns the body of a transaction, or nil if it can't be decoded
+func _TransactionBody(transaction *services.Transaction) *services.TransactionBody {
+	bodyBytes := transaction.GetBodyBytes()
+	if signedTransactionBytes := transaction.GetSignedTransactionBytes(); len(signedTransactionBytes) > 0 {
+		var signedTransaction services.SignedTransaction
+		if err := protobuf.Unmarshal(signedTransactionBytes, &signedTransaction); err != nil {
+			return nil
+		}
+		bodyBytes = signedTransaction.BodyBytes
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil || len(bodyBytes) == 0 {
+		return nil
+	}
+
+	return &body
+}
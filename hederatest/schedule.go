@@ -0,0 +1,170 @@
+package hederatest
+
+import (
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func (ledger *_Ledger) _GetSchedule(scheduleID *services.ScheduleID) (*_Schedule, services.ResponseCodeEnum) {
+	schedule, ok := ledger.schedules[scheduleID.GetScheduleNum()]
+	if scheduleID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_SCHEDULE_ID
+	}
+	if schedule.info.GetDeletionTime() != nil {
+		return nil, services.ResponseCodeEnum_SCHEDULE_ALREADY_DELETED
+	}
+	if schedule.info.GetExecutionTime() != nil {
+		return nil, services.ResponseCodeEnum_SCHEDULE_ALREADY_EXECUTED
+	}
+
+	return schedule, services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ScheduleCreate(transaction *_Transaction, body *services.ScheduleCreateTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	if body.ScheduledTransactionBody == nil {
+		return services.ResponseCodeEnum_INVALID_TRANSACTION
+	}
+	if body.AdminKey != nil && !transaction.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	payer := body.PayerAccountID
+	if payer == nil {
+		payer = transaction.payer
+	}
+	if _, status := ledger._GetAccount(payer); status != services.ResponseCodeEnum_SUCCESS {
+		return services.ResponseCodeEnum_ACCOUNT_ID_DOES_NOT_EXIST
+	}
+
+	for _, schedule := range ledger.schedules {
+		info := schedule.info
+		if info.GetDeletionTime() == nil && info.GetExecutionTime() == nil && info.Memo == body.Memo &&
+			protobuf.Equal(info.ScheduledTransactionBody, body.ScheduledTransactionBody) &&
+			protobuf.Equal(info.AdminKey, body.AdminKey) && info.PayerAccountID.GetAccountNum() == payer.GetAccountNum() {
+			record.Receipt.ScheduleID = info.ScheduleID
+			record.Receipt.ScheduledTransactionID = info.ScheduledTransactionID
+			return services.ResponseCodeEnum_IDENTICAL_SCHEDULE_ALREADY_CREATED
+		}
+	}
+
+	scheduledTransactionID := &services.TransactionID{
+		AccountID:             transaction.id.AccountID,
+		TransactionValidStart: transaction.id.TransactionValidStart,
+		Scheduled:             true,
+	}
+	scheduled := _ScheduledBody(body.ScheduledTransactionBody)
+	scheduled.TransactionID = scheduledTransactionID
+
+	num := ledger._NextNum()
+	schedule := _Schedule{
+		info: &services.ScheduleInfo{
+			ScheduleID:               &services.ScheduleID{ScheduleNum: num},
+			ExpirationTime:           _Timestamp(_Time(record.ConsensusTimestamp).Add(_ScheduleExpiry)),
+			ScheduledTransactionBody: body.ScheduledTransactionBody,
+			Memo:                     body.Memo,
+			AdminKey:                 body.AdminKey,
+			CreatorAccountID:         transaction.id.AccountID,
+			PayerAccountID:           payer,
+			ScheduledTransactionID:   scheduledTransactionID,
+		},
+		body:    scheduled,
+		signers: make(_Signers),
+	}
+	schedule.signers._Add(transaction.signers)
+	ledger.schedules[num] = &schedule
+
+	record.Receipt.ScheduleID = schedule.info.ScheduleID
+	record.Receipt.ScheduledTransactionID = scheduledTransactionID
+	ledger._TryExecuteSchedule(&schedule)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ScheduleSign(transaction *_Transaction, body *services.ScheduleSignTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	schedule, status := ledger._GetSchedule(body.ScheduleID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+
+	schedule.signers._Add(transaction.signers)
+	record.Receipt.ScheduledTransactionID = schedule.info.ScheduledTransactionID
+	ledger._TryExecuteSchedule(schedule)
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _ScheduleDelete(transaction *_Transaction, body *services.ScheduleDeleteTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	schedule, status := ledger._GetSchedule(body.ScheduleID)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if schedule.info.AdminKey == nil {
+		return services.ResponseCodeEnum_SCHEDULE_IS_IMMUTABLE
+	}
+	if !transaction.signers._Satisfies(schedule.info.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	schedule.info.Data = &services.ScheduleInfo_DeletionTime{DeletionTime: record.ConsensusTimestamp}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TryExecuteSchedule executes the scheduled transaction once its payer and everything it needs have signed. A
+// transaction that fails for another reason than a missing signature is executed, and its failure recorded.
+func (ledger *_Ledger) _TryExecuteSchedule(schedule *_Schedule) {
+	payer := ledger.accounts[schedule.info.PayerAccountID.GetAccountNum()]
+	if !schedule.signers._Satisfies(payer.info.Key) {
+		return
+	}
+
+	transaction := _Transaction{
+		id:          schedule.info.ScheduledTransactionID,
+		payer:       schedule.info.PayerAccountID,
+		body:        schedule.body,
+		signers:     schedule.signers,
+		scheduleRef: schedule.info.ScheduleID,
+	}
+	record := ledger._NewRecord(&transaction)
+
+	status := ledger._Apply(&transaction, record)
+	if status == services.ResponseCodeEnum_INVALID_SIGNATURE {
+		return
+	}
+
+	record.Receipt.Status = status
+	ledger.records[_TransactionKey(transaction.id)] = record
+	schedule.info.Data = &services.ScheduleInfo_ExecutionTime{ExecutionTime: record.ConsensusTimestamp}
+}
+
+// _ScheduledBody returns the transaction body a schedulable body is executed as, the fields of the two having the
+// same names
+func _ScheduledBody(schedulable *services.SchedulableTransactionBody) *services.TransactionBody {
+	body := services.TransactionBody{}
+	message := body.ProtoReflect()
+	fields := message.Descriptor().Fields()
+
+	schedulable.ProtoReflect().Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		if target := fields.ByName(field.Name()); target != nil {
+			message.Set(target, value)
+		}
+		return true
+	})
+
+	return &body
+}
+
+func (ledger *_Ledger) _ScheduleGetInfo(query *services.ScheduleGetInfoQuery) *services.Response {
+	response := services.ScheduleGetInfoResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	// a deleted or executed schedule still has its info
+	if schedule, ok := ledger.schedules[query.ScheduleID.GetScheduleNum()]; ok {
+		response.ScheduleInfo = protobuf.Clone(schedule.info).(*services.ScheduleInfo)
+		response.ScheduleInfo.Signers = schedule.signers._KeyList()
+	} else {
+		response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_SCHEDULE_ID
+	}
+
+	return &services.Response{Response: &services.Response_ScheduleGetInfo{ScheduleGetInfo: &response}}
+}
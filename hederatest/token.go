@@ -0,0 +1,283 @@
+package hederatest
+
+import (
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func (ledger *_Ledger) _GetToken(tokenID *services.TokenID) (*services.TokenInfo, services.ResponseCodeEnum) {
+	token, ok := ledger.tokens[tokenID.GetTokenNum()]
+	if tokenID == nil || !ok {
+		return nil, services.ResponseCodeEnum_INVALID_TOKEN_ID
+	}
+	if token.Deleted {
+		return nil, services.ResponseCodeEnum_TOKEN_WAS_DELETED
+	}
+
+	return token, services.ResponseCodeEnum_SUCCESS
+}
+
+// _CheckTokenSupplyKey checks the supply of a token can be changed, by its supply key signing
+func (ledger *_Ledger) _CheckTokenSupplyKey(transaction *_Transaction, token *services.TokenInfo) services.ResponseCodeEnum {
+	if token.SupplyKey == nil {
+		return services.ResponseCodeEnum_TOKEN_HAS_NO_SUPPLY_KEY
+	}
+	if !transaction.signers._Satisfies(token.SupplyKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenCreate creates a fungible token. Non-fungible tokens aren't supported.
+func (ledger *_Ledger) _TokenCreate(transaction *_Transaction, body *services.TokenCreateTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	if body.TokenType != services.TokenType_FUNGIBLE_COMMON {
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+	if body.Name == "" {
+		return services.ResponseCodeEnum_MISSING_TOKEN_NAME
+	}
+	if body.Symbol == "" {
+		return services.ResponseCodeEnum_MISSING_TOKEN_SYMBOL
+	}
+	if body.SupplyType == services.TokenSupplyType_FINITE {
+		if body.MaxSupply <= 0 {
+			return services.ResponseCodeEnum_INVALID_TOKEN_MAX_SUPPLY
+		}
+		if body.InitialSupply > uint64(body.MaxSupply) {
+			return services.ResponseCodeEnum_INVALID_TOKEN_INITIAL_SUPPLY
+		}
+	}
+
+	treasury, status := ledger._GetAccount(body.Treasury)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return services.ResponseCodeEnum_INVALID_TREASURY_ACCOUNT_FOR_TOKEN
+	}
+	if !transaction.signers._Satisfies(treasury.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+	if body.AdminKey != nil && !transaction.signers._Satisfies(body.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	token := services.TokenInfo{
+		Name:                body.Name,
+		Symbol:              body.Symbol,
+		Decimals:            body.Decimals,
+		TotalSupply:         body.InitialSupply,
+		Treasury:            body.Treasury,
+		AdminKey:            body.AdminKey,
+		KycKey:              body.KycKey,
+		FreezeKey:           body.FreezeKey,
+		WipeKey:             body.WipeKey,
+		SupplyKey:           body.SupplyKey,
+		DefaultFreezeStatus: services.TokenFreezeStatus_FreezeNotApplicable,
+		DefaultKycStatus:    services.TokenKycStatus_KycNotApplicable,
+		AutoRenewAccount:    body.AutoRenewAccount,
+		AutoRenewPeriod:     body.AutoRenewPeriod,
+		Expiry:              body.Expiry,
+		Memo:                body.Memo,
+		TokenType:           body.TokenType,
+		SupplyType:          body.SupplyType,
+		MaxSupply:           body.MaxSupply,
+		FeeScheduleKey:      body.FeeScheduleKey,
+		CustomFees:          body.CustomFees,
+		PauseKey:            body.PauseKey,
+		PauseStatus:         services.TokenPauseStatus_PauseNotApplicable,
+	}
+	if body.FreezeKey != nil {
+		token.DefaultFreezeStatus = services.TokenFreezeStatus_Unfrozen
+		if body.FreezeDefault {
+			token.DefaultFreezeStatus = services.TokenFreezeStatus_Frozen
+		}
+	}
+	if body.KycKey != nil {
+		token.DefaultKycStatus = services.TokenKycStatus_Revoked
+	}
+	if body.PauseKey != nil {
+		token.PauseStatus = services.TokenPauseStatus_Unpaused
+	}
+	if token.Expiry == nil {
+		token.Expiry = _Timestamp(_Time(record.ConsensusTimestamp).Add(_AutoRenewPeriod))
+	}
+
+	num := ledger._NextNum()
+	token.TokenId = &services.TokenID{TokenNum: num}
+	ledger.tokens[num] = &token
+	treasury.tokens[num] = body.InitialSupply
+
+	record.Receipt.TokenID = token.TokenId
+	record.TokenTransferLists = _TokenTransferLists(num, body.Treasury.GetAccountNum(), int64(body.InitialSupply))
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenMint mints fungible tokens into the treasury. Minting non-fungible tokens isn't supported.
+func (ledger *_Ledger) _TokenMint(transaction *_Transaction, body *services.TokenMintTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	token, status := ledger._GetToken(body.Token)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if status := ledger._CheckTokenSupplyKey(transaction, token); status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if len(body.Metadata) > 0 {
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+	if body.Amount == 0 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_MINT_AMOUNT
+	}
+	if token.SupplyType == services.TokenSupplyType_FINITE && token.TotalSupply+body.Amount > uint64(token.MaxSupply) {
+		return services.ResponseCodeEnum_TOKEN_MAX_SUPPLY_REACHED
+	}
+
+	ledger.accounts[token.Treasury.GetAccountNum()].tokens[body.Token.TokenNum] += body.Amount
+	token.TotalSupply += body.Amount
+
+	record.Receipt.NewTotalSupply = token.TotalSupply
+	record.TokenTransferLists = _TokenTransferLists(body.Token.TokenNum, token.Treasury.GetAccountNum(), int64(body.Amount))
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+// _TokenBurn burns fungible tokens from the treasury. Burning non-fungible tokens isn't supported.
+func (ledger *_Ledger) _TokenBurn(transaction *_Transaction, body *services.TokenBurnTransactionBody, record *services.TransactionRecord) services.ResponseCodeEnum {
+	token, status := ledger._GetToken(body.Token)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if status := ledger._CheckTokenSupplyKey(transaction, token); status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if len(body.SerialNumbers) > 0 {
+		return services.ResponseCodeEnum_NOT_SUPPORTED
+	}
+	if body.Amount == 0 {
+		return services.ResponseCodeEnum_INVALID_TOKEN_BURN_AMOUNT
+	}
+
+	treasury := ledger.accounts[token.Treasury.GetAccountNum()]
+	if treasury.tokens[body.Token.TokenNum] < body.Amount {
+		return services.ResponseCodeEnum_INSUFFICIENT_TOKEN_BALANCE
+	}
+
+	treasury.tokens[body.Token.TokenNum] -= body.Amount
+	token.TotalSupply -= body.Amount
+
+	record.Receipt.NewTotalSupply = token.TotalSupply
+	record.TokenTransferLists = _TokenTransferLists(body.Token.TokenNum, token.Treasury.GetAccountNum(), -int64(body.Amount))
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenAssociate(transaction *_Transaction, body *services.TokenAssociateTransactionBody) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.Account)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if !transaction.signers._Satisfies(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	seen := make(map[int64]bool, len(body.Tokens))
+	for _, tokenID := range body.Tokens {
+		if _, status := ledger._GetToken(tokenID); status != services.ResponseCodeEnum_SUCCESS {
+			return status
+		}
+		if seen[tokenID.TokenNum] {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+		if _, ok := account.tokens[tokenID.TokenNum]; ok {
+			return services.ResponseCodeEnum_TOKEN_ALREADY_ASSOCIATED_TO_ACCOUNT
+		}
+		seen[tokenID.TokenNum] = true
+	}
+
+	for tokenNum := range seen {
+		account.tokens[tokenNum] = 0
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenDissociate(transaction *_Transaction, body *services.TokenDissociateTransactionBody) services.ResponseCodeEnum {
+	account, status := ledger._GetAccount(body.Account)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if !transaction.signers._Satisfies(account.info.Key) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	seen := make(map[int64]bool, len(body.Tokens))
+	for _, tokenID := range body.Tokens {
+		// a deleted token can still be dissociated from, whatever the balance
+		token, ok := ledger.tokens[tokenID.GetTokenNum()]
+		if !ok {
+			return services.ResponseCodeEnum_INVALID_TOKEN_ID
+		}
+		if seen[tokenID.TokenNum] {
+			return services.ResponseCodeEnum_TOKEN_ID_REPEATED_IN_TOKEN_LIST
+		}
+
+		balance, ok := account.tokens[tokenID.TokenNum]
+		if !ok {
+			return services.ResponseCodeEnum_TOKEN_NOT_ASSOCIATED_TO_ACCOUNT
+		}
+		if !token.Deleted && token.Treasury.GetAccountNum() == body.Account.GetAccountNum() {
+			return services.ResponseCodeEnum_ACCOUNT_IS_TREASURY
+		}
+		if !token.Deleted && balance > 0 {
+			return services.ResponseCodeEnum_TRANSACTION_REQUIRES_ZERO_TOKEN_BALANCES
+		}
+		seen[tokenID.TokenNum] = true
+	}
+
+	for tokenNum := range seen {
+		delete(account.tokens, tokenNum)
+	}
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenDelete(transaction *_Transaction, body *services.TokenDeleteTransactionBody) services.ResponseCodeEnum {
+	token, status := ledger._GetToken(body.Token)
+	if status != services.ResponseCodeEnum_SUCCESS {
+		return status
+	}
+	if token.AdminKey == nil {
+		return services.ResponseCodeEnum_TOKEN_IS_IMMUTABLE
+	}
+	if !transaction.signers._Satisfies(token.AdminKey) {
+		return services.ResponseCodeEnum_INVALID_SIGNATURE
+	}
+
+	token.Deleted = true
+
+	return services.ResponseCodeEnum_SUCCESS
+}
+
+func (ledger *_Ledger) _TokenGetInfo(query *services.TokenGetInfoQuery) *services.Response {
+	response := services.TokenGetInfoResponse{Header: _Header(query.Header, services.ResponseCodeEnum_OK)}
+
+	// a deleted token still has its info
+	if token, ok := ledger.tokens[query.Token.GetTokenNum()]; ok {
+		response.TokenInfo = protobuf.Clone(token).(*services.TokenInfo)
+	} else {
+		response.Header.NodeTransactionPrecheckCode = services.ResponseCodeEnum_INVALID_TOKEN_ID
+	}
+
+	return &services.Response{Response: &services.Response_TokenGetInfo{TokenGetInfo: &response}}
+}
+
+// _TokenTransferLists returns the record of an amount of a token being added to, or taken from, the treasury
+func _TokenTransferLists(tokenNum int64, treasuryNum int64, amount int64) []*services.TokenTransferList {
+	if amount == 0 {
+		return nil
+	}
+
+	return []*services.TokenTransferList{{
+		Token:     &services.TokenID{TokenNum: tokenNum},
+		Transfers: _TransferList(map[int64]int64{treasuryNum: amount}),
+	}}
+}
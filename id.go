@@ -26,14 +26,26 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+
+	"github.com/pkg/errors"
 )
 
-func _AccountIDFromString(s string) (shard int, realm int, num int, checksum *string, alias *PublicKey, err error) {
+func _AccountIDFromString(s string) (shard int, realm int, num int, checksum *string, alias *PublicKey, evmAddress *[]byte, err error) {
+	if _HasHexPrefix(s) {
+		// A bare EVM address, which is always in shard 0 and realm 0
+		address, err := _EvmAddressFromString(s)
+		if err != nil {
+			return 0, 0, 0, nil, nil, nil, err
+		}
+
+		return 0, 0, -1, nil, nil, &address, nil
+	}
+
 	if strings.Contains(s, "-") {
 		values := strings.SplitN(s, "-", 2)
 
 		if len(values) > 2 {
-			return 0, 0, 0, nil, nil, fmt.Errorf("expected {shard}.{realm}.{num}-{checksum}")
+			return 0, 0, 0, nil, nil, nil, fmt.Errorf("expected {shard}.{realm}.{num}-{checksum}")
 		}
 
 		checksum = &values[1]
@@ -43,30 +55,55 @@ func _AccountIDFromString(s string) (shard int, realm int, num int, checksum *st
 	values := strings.SplitN(s, ".", 3)
 	if len(values) != 3 {
 		// Was not three values separated by periods
-		return 0, 0, 0, nil, nil, fmt.Errorf("expected {shard}.{realm}.{num}")
+		return 0, 0, 0, nil, nil, nil, fmt.Errorf("expected {shard}.{realm}.{num}")
 	}
 
 	shard, err = strconv.Atoi(values[0])
 	if err != nil {
-		return 0, 0, 0, nil, nil, err
+		return 0, 0, 0, nil, nil, nil, err
 	}
 
 	realm, err = strconv.Atoi(values[1])
 	if err != nil {
-		return 0, 0, 0, nil, nil, err
+		return 0, 0, 0, nil, nil, nil, err
+	}
+
+	if _HasHexPrefix(values[2]) || len(values[2]) == 40 {
+		address, err := _EvmAddressFromString(values[2])
+		if err == nil {
+			return shard, realm, -1, checksum, nil, &address, nil
+		}
 	}
 
 	key, err := PublicKeyFromString(values[2])
 	if err != nil {
 		num, err = strconv.Atoi(values[2])
 		if err != nil {
-			return 0, 0, 0, nil, nil, err
+			return 0, 0, 0, nil, nil, nil, err
 		}
 
-		return shard, realm, num, checksum, nil, nil
+		return shard, realm, num, checksum, nil, nil, nil
+	}
+
+	return shard, realm, -1, checksum, &key, nil, nil
+}
+
+func _HasHexPrefix(s string) bool {
+	return strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X")
+}
+
+// _EvmAddressFromString decodes a 20 byte EVM address, with or without the 0x prefix
+func _EvmAddressFromString(s string) ([]byte, error) {
+	if _HasHexPrefix(s) {
+		s = s[2:]
+	}
+
+	address, err := hex.DecodeString(s)
+	if err != nil || len(address) != 20 {
+		return nil, errors.Wrapf(errInvalidEvmAddress, "%q", s)
 	}
 
-	return shard, realm, -1, checksum, &key, nil
+	return address, nil
 }
 
 func _ContractIDFromString(s string) (shard int, realm int, num int, checksum *string, evmAddress []byte, err error) {
@@ -0,0 +1,124 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// NodeRequest describes a single gRPC call the SDK is about to make to a node.
+type NodeRequest struct {
+	// The node the request is sent to
+	NodeAccountID AccountID
+	// The kind of transaction or query being sent
+	RequestType RequestType
+	// The `*services.Transaction` or `*services.Query` sent to the node. Interceptors may replace
+	// it with another message of the same type before calling the next invoker.
+	Request protobuf.Message
+	// Zero-based attempt number within the current execution
+	Attempt int64
+}
+
+// NodeResponse is the outcome of a NodeRequest.
+type NodeResponse struct {
+	// The `*services.TransactionResponse` or `*services.Response` returned by the node
+	Response protobuf.Message
+	// The gRPC error returned by the node, if any
+	Err error
+	// Time spent waiting on the node
+	Latency time.Duration
+}
+
+// NodeInvoker sends a NodeRequest and returns its NodeResponse.
+type NodeInvoker func(ctx context.Context, request *NodeRequest) NodeResponse
+
+// Interceptor wraps every call a Client makes to a node. An interceptor may inspect or mutate the
+// request, call next to continue the chain, and inspect or replace the response. Returning without
+// calling next short-circuits the call, which is useful for fault injection in tests.
+type Interceptor func(ctx context.Context, request *NodeRequest, next NodeInvoker) NodeResponse
+
+// _InterceptorChain builds a NodeInvoker which runs interceptors in order, the first being outermost,
+// before calling invoker.
+func _InterceptorChain(interceptors []Interceptor, invoker NodeInvoker) NodeInvoker {
+	for i := len(interceptors) - 1; i >= 0; i-- {
+		interceptor := interceptors[i]
+		next := invoker
+		invoker = func(ctx context.Context, request *NodeRequest) NodeResponse {
+			return interceptor(ctx, request, next)
+		}
+	}
+
+	return invoker
+}
+
+// _MethodInvoker returns the innermost NodeInvoker, which performs the actual gRPC call.
+func _MethodInvoker(method _Method) NodeInvoker {
+	return func(ctx context.Context, request *NodeRequest) NodeResponse {
+		var resp protobuf.Message
+		var err error
+
+		start := time.Now()
+		switch req := request.Request.(type) {
+		case *services.Query:
+			if method.query == nil {
+				return NodeResponse{Err: errInterceptorRequestType}
+			}
+			resp, err = method.query(ctx, req)
+		case *services.Transaction:
+			if method.transaction == nil {
+				return NodeResponse{Err: errInterceptorRequestType}
+			}
+			resp, err = method.transaction(ctx, req)
+		default:
+			return NodeResponse{Err: errInterceptorRequestType}
+		}
+
+		return NodeResponse{
+			Response: resp,
+			Err:      err,
+			Latency:  time.Since(start),
+		}
+	}
+}
+
+// _CheckNodeResponse makes sure a response produced by the interceptor chain can be handled by `_Execute`
+func _CheckNodeResponse(method _Method, response NodeResponse) NodeResponse {
+	if response.Err != nil {
+		return response
+	}
+
+	var ok bool
+	if method.query != nil {
+		_, ok = response.Response.(*services.Response)
+	} else {
+		_, ok = response.Response.(*services.TransactionResponse)
+	}
+
+	if !ok {
+		response.Err = errInterceptorResponseType
+	}
+
+	return response
+}
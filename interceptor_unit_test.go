@@ -0,0 +1,195 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _MockAccountBalanceResponse(balance uint64) *services.Response {
+	return &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+				AccountID: &services.AccountID{ShardNum: 0, RealmNum: 0, Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+				Balance:   balance,
+			},
+		},
+	}
+}
+
+func TestUnitInterceptorChainOrder(t *testing.T) {
+	calls := make([]string, 0)
+
+	record := func(name string) Interceptor {
+		return func(ctx context.Context, request *NodeRequest, next NodeInvoker) NodeResponse {
+			calls = append(calls, name+" before")
+			response := next(ctx, request)
+			calls = append(calls, name+" after")
+			return response
+		}
+	}
+
+	invoker := _InterceptorChain([]Interceptor{record("first"), record("second")}, func(ctx context.Context, request *NodeRequest) NodeResponse {
+		calls = append(calls, "invoke")
+		return NodeResponse{}
+	})
+	invoker(context.Background(), &NodeRequest{})
+
+	assert.Equal(t, []string{"first before", "second before", "invoke", "second after", "first after"}, calls)
+}
+
+func TestUnitInterceptorObservesQuery(t *testing.T) {
+	responses := [][]interface{}{{
+		_MockAccountBalanceResponse(2000),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	var observed []NodeRequest
+	var observedResponse NodeResponse
+	client.AddInterceptor(func(ctx context.Context, request *NodeRequest, next NodeInvoker) NodeResponse {
+		observed = append(observed, *request)
+		observedResponse = next(ctx, request)
+		return observedResponse
+	})
+
+	balance, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(2000), balance.Hbars)
+
+	require.Equal(t, 1, len(observed))
+	assert.Equal(t, AccountID{Account: 3}, observed[0].NodeAccountID)
+	assert.Equal(t, RequestTypeCryptoGetAccountBalance, observed[0].RequestType)
+	assert.Equal(t, int64(0), observed[0].Attempt)
+	require.IsType(t, &services.Query{}, observed[0].Request)
+	require.NoError(t, observedResponse.Err)
+	require.IsType(t, &services.Response{}, observedResponse.Response)
+	assert.True(t, observedResponse.Latency > 0)
+}
+
+func TestUnitInterceptorObservesTransaction(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.TransactionResponse{
+			NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK,
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	var requestType RequestType
+	client.AddInterceptor(func(ctx context.Context, request *NodeRequest, next NodeInvoker) NodeResponse {
+		requestType = request.RequestType
+		return next(ctx, request)
+	})
+
+	_, err := NewFileCreateTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetContents([]byte("hello")).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, RequestTypeFileCreate, requestType)
+}
+
+func TestUnitInterceptorFaultInjection(t *testing.T) {
+	responses := [][]interface{}{{
+		_MockAccountBalanceResponse(2000),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	attempts := make([]int64, 0)
+	client.SetInterceptors(func(ctx context.Context, request *NodeRequest, next NodeInvoker) NodeResponse {
+		attempts = append(attempts, request.Attempt)
+		if request.Attempt == 0 {
+			return NodeResponse{Response: &services.Response{
+				Response: &services.Response_CryptogetAccountBalance{
+					CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+						Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY},
+					},
+				},
+			}}
+		}
+		return next(ctx, request)
+	})
+
+	balance, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(2000), balance.Hbars)
+	assert.Equal(t, []int64{0, 1}, attempts)
+}
+
+func TestUnitInterceptorShortCircuitError(t *testing.T) {
+	responses := [][]interface{}{{
+		_MockAccountBalanceResponse(2000),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	injected := errors.New("injected")
+	client.AddInterceptor(func(ctx context.Context, request *NodeRequest, next NodeInvoker) NodeResponse {
+		return NodeResponse{Err: injected}
+	})
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.ErrorIs(t, err, injected)
+}
+
+func TestUnitInterceptorWrongResponseType(t *testing.T) {
+	responses := [][]interface{}{{
+		_MockAccountBalanceResponse(2000),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.AddInterceptor(func(ctx context.Context, request *NodeRequest, next NodeInvoker) NodeResponse {
+		return NodeResponse{Response: &services.TransactionResponse{}}
+	})
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.ErrorIs(t, err, errInterceptorResponseType)
+}
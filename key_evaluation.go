@@ -0,0 +1,182 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"sort"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+)
+
+// KeySignatureStatus reports how far a set of signatures goes towards meeting the requirements of a Key,
+// as the network would check them before returning INVALID_SIGNATURE.
+type KeySignatureStatus struct {
+	// Satisfied is true when the signatures meet the key's requirements.
+	Satisfied bool
+	// Satisfiable is false when signatures alone can never satisfy the key, e.g. a ContractID key, or a
+	// threshold larger than its KeyList.
+	Satisfiable bool
+	// SignedKeys are the public keys within the key which have a valid signature.
+	SignedKeys []PublicKey
+	// MissingKeys is a minimal set of public keys which, by also signing, would satisfy the key. It is empty
+	// when the key is satisfied or not satisfiable.
+	MissingKeys []PublicKey
+}
+
+// EvaluateKeySignatures checks signatures over message against key, which may be a PublicKey, a KeyList or a
+// threshold KeyList, nested to any depth. Signatures which don't verify are ignored. ContractID and
+// DelegatableContractID keys are only satisfied by the contract itself calling, so never by signatures.
+func EvaluateKeySignatures(key Key, message []byte, signatures map[*PublicKey][]byte) KeySignatureStatus {
+	signed := make(map[string]bool)
+	for publicKey, signature := range signatures {
+		if publicKey != nil && publicKey.Verify(message, signature) {
+			signed[publicKey.String()] = true
+		}
+	}
+
+	return _EvaluateKey(key, signed)
+}
+
+// EvaluateTransactionSignatures checks the signatures on a frozen transaction against key, as EvaluateKeySignatures
+// does. A public key only counts as signed if it signed every body the transaction is sent as, one per node and, for
+// chunked transactions such as FileAppendTransaction, per chunk. Keys given to Sign or SignWith only have signatures
+// once the transaction was built, e.g. by ToBytes.
+func EvaluateTransactionSignatures(key Key, transaction interface{}) (KeySignatureStatus, error) {
+	tx, err := _TransactionAsSignable(transaction)
+	if err != nil {
+		return KeySignatureStatus{}, err
+	}
+
+	if !tx.IsFrozen() {
+		return KeySignatureStatus{}, errTransactionIsNotFrozen
+	}
+
+	signed := make(map[string]bool)
+	for i, value := range tx._GetTransaction().signedTransactions.slice {
+		signedTransaction := value.(*services.SignedTransaction)
+		signatures, err := _SignaturesFromSigMap(signedTransaction.GetSigMap())
+		if err != nil {
+			return KeySignatureStatus{}, err
+		}
+
+		valid := make(map[string]bool)
+		for publicKey, signature := range signatures {
+			if publicKey.Verify(signedTransaction.GetBodyBytes(), signature) && (i == 0 || signed[publicKey.String()]) {
+				valid[publicKey.String()] = true
+			}
+		}
+
+		signed = valid
+	}
+
+	return _EvaluateKey(key, signed), nil
+}
+
+func _EvaluateKey(key Key, signed map[string]bool) KeySignatureStatus {
+	switch k := key.(type) {
+	case PublicKey:
+		if signed[k.String()] {
+			return KeySignatureStatus{Satisfied: true, Satisfiable: true, SignedKeys: []PublicKey{k}, MissingKeys: []PublicKey{}}
+		}
+
+		return KeySignatureStatus{Satisfiable: true, SignedKeys: []PublicKey{}, MissingKeys: []PublicKey{k}}
+	case *PublicKey:
+		if k != nil {
+			return _EvaluateKey(*k, signed)
+		}
+	case PrivateKey:
+		return _EvaluateKey(k.PublicKey(), signed)
+	case *PrivateKey:
+		if k != nil {
+			return _EvaluateKey(k.PublicKey(), signed)
+		}
+	case *KeyList:
+		if k != nil {
+			return _EvaluateKeyList(k, signed)
+		}
+	}
+
+	return KeySignatureStatus{SignedKeys: []PublicKey{}, MissingKeys: []PublicKey{}}
+}
+
+func _EvaluateKeyList(keyList *KeyList, signed map[string]bool) KeySignatureStatus {
+	required := len(keyList.keys)
+	if keyList.threshold >= 0 {
+		required = keyList.threshold
+	}
+
+	status := KeySignatureStatus{SignedKeys: []PublicKey{}, MissingKeys: []PublicKey{}}
+	satisfied := 0
+	candidates := make([]KeySignatureStatus, 0)
+
+	for _, key := range keyList.keys {
+		inner := _EvaluateKey(key, signed)
+		status.SignedKeys = _AppendUniquePublicKeys(status.SignedKeys, inner.SignedKeys...)
+
+		if inner.Satisfied {
+			satisfied++
+		} else if inner.Satisfiable {
+			candidates = append(candidates, inner)
+		}
+	}
+
+	if satisfied >= required {
+		status.Satisfied = true
+		status.Satisfiable = true
+		return status
+	}
+
+	needed := required - satisfied
+	if len(candidates) < needed {
+		return status
+	}
+
+	// Completing the sub-keys missing the fewest signatures first gives a minimal set when the sub-keys
+	// don't share public keys
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return len(candidates[i].MissingKeys) < len(candidates[j].MissingKeys)
+	})
+
+	status.Satisfiable = true
+	for _, candidate := range candidates[:needed] {
+		status.MissingKeys = _AppendUniquePublicKeys(status.MissingKeys, candidate.MissingKeys...)
+	}
+
+	return status
+}
+
+func _AppendUniquePublicKeys(keys []PublicKey, others ...PublicKey) []PublicKey {
+	for _, other := range others {
+		found := false
+		for _, key := range keys {
+			if key.String() == other.String() {
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			keys = append(keys, other)
+		}
+	}
+
+	return keys
+}
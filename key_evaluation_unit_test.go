@@ -0,0 +1,215 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+)
+
+func _GenerateEvaluationKeys(t *testing.T, count int) []PrivateKey {
+	keys := make([]PrivateKey, count)
+	for i := range keys {
+		key, err := PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		keys[i] = key
+	}
+
+	return keys
+}
+
+func _SignEvaluationMessage(message []byte, keys ...PrivateKey) map[*PublicKey][]byte {
+	signatures := make(map[*PublicKey][]byte)
+	for _, key := range keys {
+		publicKey := key.PublicKey()
+		signatures[&publicKey] = key.Sign(message)
+	}
+
+	return signatures
+}
+
+func TestUnitEvaluateKeySignaturesPublicKey(t *testing.T) {
+	keys := _GenerateEvaluationKeys(t, 2)
+	message := []byte("message")
+
+	status := EvaluateKeySignatures(keys[0].PublicKey(), message, _SignEvaluationMessage(message, keys[0]))
+	require.True(t, status.Satisfied)
+	require.True(t, status.Satisfiable)
+	require.Equal(t, []PublicKey{keys[0].PublicKey()}, status.SignedKeys)
+	require.Empty(t, status.MissingKeys)
+
+	status = EvaluateKeySignatures(keys[0].PublicKey(), message, _SignEvaluationMessage(message, keys[1]))
+	require.False(t, status.Satisfied)
+	require.True(t, status.Satisfiable)
+	require.Empty(t, status.SignedKeys)
+	require.Equal(t, []PublicKey{keys[0].PublicKey()}, status.MissingKeys)
+}
+
+func TestUnitEvaluateKeySignaturesIgnoresInvalidSignatures(t *testing.T) {
+	keys := _GenerateEvaluationKeys(t, 1)
+
+	status := EvaluateKeySignatures(keys[0].PublicKey(), []byte("message"), _SignEvaluationMessage([]byte("other"), keys[0]))
+	require.False(t, status.Satisfied)
+	require.Equal(t, []PublicKey{keys[0].PublicKey()}, status.MissingKeys)
+}
+
+func TestUnitEvaluateKeySignaturesThreshold(t *testing.T) {
+	keys := _GenerateEvaluationKeys(t, 3)
+	message := []byte("message")
+
+	threshold := KeyListWithThreshold(2).
+		AddAllPublicKeys([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[2].PublicKey()})
+
+	status := EvaluateKeySignatures(threshold, message, _SignEvaluationMessage(message, keys[0]))
+	require.False(t, status.Satisfied)
+	require.True(t, status.Satisfiable)
+	require.Equal(t, []PublicKey{keys[0].PublicKey()}, status.SignedKeys)
+	require.Len(t, status.MissingKeys, 1)
+
+	status = EvaluateKeySignatures(threshold, message, _SignEvaluationMessage(message, keys[0], keys[2]))
+	require.True(t, status.Satisfied)
+	require.Empty(t, status.MissingKeys)
+
+	all := NewKeyList().
+		AddAllPublicKeys([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[2].PublicKey()})
+
+	status = EvaluateKeySignatures(all, message, _SignEvaluationMessage(message, keys[0]))
+	require.False(t, status.Satisfied)
+	require.Equal(t, []PublicKey{keys[1].PublicKey(), keys[2].PublicKey()}, status.MissingKeys)
+}
+
+func TestUnitEvaluateKeySignaturesNestedMinimalMissingKeys(t *testing.T) {
+	keys := _GenerateEvaluationKeys(t, 4)
+	message := []byte("message")
+
+	// 1 of [all of [0, 1, 2], 3]: signing with 3 alone is the cheapest way to satisfy the key
+	key := KeyListWithThreshold(1).
+		Add(NewKeyList().AddAllPublicKeys([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[2].PublicKey()})).
+		Add(keys[3].PublicKey())
+
+	status := EvaluateKeySignatures(key, message, _SignEvaluationMessage(message))
+	require.False(t, status.Satisfied)
+	require.Equal(t, []PublicKey{keys[3].PublicKey()}, status.MissingKeys)
+
+	// Once 0 and 1 signed, only 2 is missing from the nested list
+	status = EvaluateKeySignatures(key, message, _SignEvaluationMessage(message, keys[0], keys[1]))
+	require.False(t, status.Satisfied)
+	require.Len(t, status.SignedKeys, 2)
+	require.Len(t, status.MissingKeys, 1)
+}
+
+func TestUnitEvaluateKeySignaturesContractKeys(t *testing.T) {
+	keys := _GenerateEvaluationKeys(t, 1)
+	message := []byte("message")
+
+	status := EvaluateKeySignatures(ContractID{Contract: 5}, message, _SignEvaluationMessage(message, keys[0]))
+	require.False(t, status.Satisfied)
+	require.False(t, status.Satisfiable)
+	require.Empty(t, status.MissingKeys)
+
+	key := KeyListWithThreshold(1).
+		Add(DelegatableContractID{Contract: 5}).
+		Add(keys[0].PublicKey())
+
+	status = EvaluateKeySignatures(key, message, _SignEvaluationMessage(message))
+	require.True(t, status.Satisfiable)
+	require.Equal(t, []PublicKey{keys[0].PublicKey()}, status.MissingKeys)
+
+	status = EvaluateKeySignatures(KeyListWithThreshold(2).Add(ContractID{Contract: 5}).Add(keys[0].PublicKey()), message, _SignEvaluationMessage(message, keys[0]))
+	require.False(t, status.Satisfied)
+	require.False(t, status.Satisfiable)
+}
+
+func TestUnitEvaluateTransactionSignatures(t *testing.T) {
+	keys := _GenerateEvaluationKeys(t, 3)
+
+	threshold := KeyListWithThreshold(2).
+		AddAllPublicKeys([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[2].PublicKey()})
+
+	transaction := _NewMockBundleTransaction(t, []AccountID{{Account: 3}, {Account: 4}}).
+		Sign(keys[0])
+
+	// the key only signs once the transaction is built
+	status, err := EvaluateTransactionSignatures(threshold, transaction)
+	require.NoError(t, err)
+	require.Empty(t, status.SignedKeys)
+
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+
+	status, err = EvaluateTransactionSignatures(threshold, transaction)
+	require.NoError(t, err)
+	require.False(t, status.Satisfied)
+	require.Len(t, status.MissingKeys, 1)
+
+	transaction.Sign(keys[1])
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+
+	status, err = EvaluateTransactionSignatures(threshold, transaction)
+	require.NoError(t, err)
+	require.True(t, status.Satisfied)
+	require.Len(t, status.SignedKeys, 2)
+
+	_, err = EvaluateTransactionSignatures(threshold, NewTransferTransaction())
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+}
+
+func TestUnitEvaluateTransactionSignaturesEveryChunk(t *testing.T) {
+	keys := _GenerateEvaluationKeys(t, 2)
+
+	transaction, err := NewFileAppendTransaction().
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		SetFileID(FileID{File: 5}).
+		SetMaxChunkSize(10).
+		SetContents(make([]byte, 25)).
+		Freeze()
+	require.NoError(t, err)
+
+	_, err = transaction.Sign(keys[0]).ToBytes()
+	require.NoError(t, err)
+
+	// three chunks for two nodes
+	signedTransactions := transaction.signedTransactions.slice
+	require.Len(t, signedTransactions, 6)
+
+	// the second key only signs the bodies of the first chunk
+	for _, value := range signedTransactions[:2] {
+		signedTransaction := value.(*services.SignedTransaction)
+		signedTransaction.SigMap.SigPair = append(signedTransaction.SigMap.SigPair,
+			keys[1].PublicKey()._ToSignaturePairProtobuf(keys[1].Sign(signedTransaction.BodyBytes)))
+	}
+
+	status, err := EvaluateTransactionSignatures(keys[0].PublicKey(), transaction)
+	require.NoError(t, err)
+	require.True(t, status.Satisfied)
+
+	status, err = EvaluateTransactionSignatures(keys[1].PublicKey(), transaction)
+	require.NoError(t, err)
+	require.False(t, status.Satisfied)
+	require.Equal(t, []PublicKey{keys[1].PublicKey()}, status.MissingKeys)
+}
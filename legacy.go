@@ -2100,7 +2100,7 @@ var legacy = [...]string{
 	"lodge",
 	"loft",
 	"lofty",
-	"github.com/rs/zerolog/log",
+	"log",
 	"logic",
 	"logo",
 	"london",
@@ -2257,7 +2257,7 @@ var legacy = [...]string{
 	"met",
 	"metal",
 	"meter",
-	"_Method",
+	"method",
 	"methyl",
 	"metric",
 	"metro",
@@ -2431,7 +2431,7 @@ var legacy = [...]string{
 	"nobel",
 	"noble",
 	"nobody",
-	"_Node",
+	"node",
 	"noise",
 	"noisy",
 	"none",
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -98,7 +99,14 @@ func (query *LiveHashQuery) _Build() *services.Query_CryptoGetLiveHash {
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *LiveHashQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -126,6 +134,7 @@ func (query *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_LiveHashQueryShouldRetry,
@@ -150,8 +159,8 @@ func (query *LiveHashQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _LiveHashQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetCryptoGetLiveHash().Header.NodeTransactionPrecheckCode))
+func _LiveHashQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetCryptoGetLiveHash().Header.NodeTransactionPrecheckCode))
 }
 
 func _LiveHashQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -166,7 +175,14 @@ func _LiveHashQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *LiveHashQuery) ExecuteWithContext(ctx context.Context, client *Client) (LiveHash, error) {
 	if client == nil || client.operator == nil {
 		return LiveHash{}, errNoClientProvided
 	}
@@ -192,7 +208,7 @@ func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return LiveHash{}, err
 		}
@@ -230,6 +246,7 @@ func (query *LiveHashQuery) Execute(client *Client) (LiveHash, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_LiveHashQueryShouldRetry,
@@ -275,6 +292,12 @@ func (query *LiveHashQuery) SetNodeAccountIDs(accountID []AccountID) *LiveHashQu
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *LiveHashQuery) SetRetryPolicy(policy RetryPolicy) *LiveHashQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *LiveHashQuery) SetMaxBackoff(max time.Duration) *LiveHashQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -0,0 +1,74 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import "strings"
+
+// LogLevel is the minimum severity a Logger writes.
+type LogLevel string
+
+const (
+	LoggerLevelTrace    LogLevel = "TRACE"
+	LoggerLevelDebug    LogLevel = "DEBUG"
+	LoggerLevelInfo     LogLevel = "INFO"
+	LoggerLevelWarn     LogLevel = "WARN"
+	LoggerLevelError    LogLevel = "ERROR"
+	LoggerLevelDisabled LogLevel = "DISABLED"
+)
+
+// Logger is the interface the SDK writes all of its diagnostics through. Every method takes a
+// message followed by alternating key/value pairs, e.g.
+//
+//	logger.Trace("executing gRPC call", "requestId", logID, "nodeAccountID", "0.0.3")
+type Logger interface {
+	Trace(msg string, keysAndValues ...interface{})
+	Debug(msg string, keysAndValues ...interface{})
+	Info(msg string, keysAndValues ...interface{})
+	Warn(msg string, keysAndValues ...interface{})
+	Error(msg string, keysAndValues ...interface{})
+}
+
+// LogLevelFromString parses a level such as "DEBUG" or "trace", returning LoggerLevelDisabled
+// for anything it doesn't recognize.
+func LogLevelFromString(level string) LogLevel {
+	switch LogLevel(strings.ToUpper(level)) {
+	case LoggerLevelTrace:
+		return LoggerLevelTrace
+	case LoggerLevelDebug:
+		return LoggerLevelDebug
+	case LoggerLevelInfo:
+		return LoggerLevelInfo
+	case LoggerLevelWarn:
+		return LoggerLevelWarn
+	case LoggerLevelError:
+		return LoggerLevelError
+	}
+
+	return LoggerLevelDisabled
+}
+
+type _NoopLogger struct{}
+
+func (_NoopLogger) Trace(string, ...interface{}) {}
+func (_NoopLogger) Debug(string, ...interface{}) {}
+func (_NoopLogger) Info(string, ...interface{})  {}
+func (_NoopLogger) Warn(string, ...interface{})  {}
+func (_NoopLogger) Error(string, ...interface{}) {}
@@ -0,0 +1,165 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type _RecordingLogger struct {
+	sync.Mutex
+	entries []string
+}
+
+func (logger *_RecordingLogger) record(level string, msg string) {
+	logger.Lock()
+	defer logger.Unlock()
+	logger.entries = append(logger.entries, level+" "+msg)
+}
+
+func (logger *_RecordingLogger) Trace(msg string, _ ...interface{}) { logger.record("TRACE", msg) }
+func (logger *_RecordingLogger) Debug(msg string, _ ...interface{}) { logger.record("DEBUG", msg) }
+func (logger *_RecordingLogger) Info(msg string, _ ...interface{})  { logger.record("INFO", msg) }
+func (logger *_RecordingLogger) Warn(msg string, _ ...interface{})  { logger.record("WARN", msg) }
+func (logger *_RecordingLogger) Error(msg string, _ ...interface{}) { logger.record("ERROR", msg) }
+
+func TestUnitLogLevelFromString(t *testing.T) {
+	assert.Equal(t, LoggerLevelTrace, LogLevelFromString("trace"))
+	assert.Equal(t, LoggerLevelDebug, LogLevelFromString("DEBUG"))
+	assert.Equal(t, LoggerLevelInfo, LogLevelFromString("Info"))
+	assert.Equal(t, LoggerLevelWarn, LogLevelFromString("WARN"))
+	assert.Equal(t, LoggerLevelError, LogLevelFromString("ERROR"))
+	assert.Equal(t, LoggerLevelDisabled, LogLevelFromString(""))
+	assert.Equal(t, LoggerLevelDisabled, LogLevelFromString("verbose"))
+}
+
+func TestUnitDefaultLoggerWritesJSON(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLoggerWithWriter(&buf, "test", LoggerLevelTrace)
+
+	logger.Trace("trace message", "requestId", "abc", "attempt", 2)
+	logger.Error("error message")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 2, len(lines))
+
+	var entry map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(lines[0]), &entry))
+	assert.Equal(t, "trace", entry["level"])
+	assert.Equal(t, "test", entry["module"])
+	assert.Equal(t, "trace message", entry["message"])
+	assert.Equal(t, "abc", entry["requestId"])
+	assert.Equal(t, float64(2), entry["attempt"])
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &entry))
+	assert.Equal(t, "error", entry["level"])
+}
+
+func TestUnitDefaultLoggerLevel(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewLoggerWithWriter(&buf, "test", LoggerLevelWarn)
+
+	logger.Trace("trace")
+	logger.Debug("debug")
+	logger.Info("info")
+	assert.Equal(t, 0, buf.Len())
+
+	logger.Warn("warn")
+	assert.NotEqual(t, 0, buf.Len())
+
+	buf.Reset()
+	logger.SetLevel(LoggerLevelDisabled)
+	logger.Error("error")
+	assert.Equal(t, 0, buf.Len())
+	assert.Equal(t, LoggerLevelDisabled, logger.GetLevel())
+}
+
+func TestUnitDefaultLoggerIgnoresGlobalLevel(t *testing.T) {
+	defer zerolog.SetGlobalLevel(zerolog.GlobalLevel())
+	zerolog.SetGlobalLevel(zerolog.ErrorLevel)
+
+	var buf bytes.Buffer
+	logger := NewLoggerWithWriter(&buf, "test", LoggerLevelTrace)
+
+	logger.Trace("trace")
+	logger.Debug("debug")
+	logger.Info("info")
+	logger.Warn("warn")
+	logger.Error("error")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Equal(t, 5, len(lines))
+	for i, expected := range []string{"trace", "debug", "info", "warn", "error"} {
+		var entry map[string]interface{}
+		require.NoError(t, json.Unmarshal([]byte(lines[i]), &entry))
+		assert.Equal(t, expected, entry["level"])
+		assert.Equal(t, expected, entry["message"])
+	}
+
+	buf.Reset()
+	zerolog.SetGlobalLevel(zerolog.Disabled)
+	logger.Trace("trace")
+	logger.Error("error")
+	assert.Equal(t, 0, buf.Len())
+}
+
+func TestUnitClientSetLogger(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header:    &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK, ResponseType: services.ResponseType_ANSWER_ONLY},
+					AccountID: &services.AccountID{ShardNum: 0, RealmNum: 0, Account: &services.AccountID_AccountNum{AccountNum: 1800}},
+					Balance:   2000,
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	logger := &_RecordingLogger{}
+	client.SetLogger(logger)
+	assert.Equal(t, logger, client.GetLogger())
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Contains(t, logger.entries, "TRACE executing gRPC call")
+
+	client.SetLogger(nil)
+	assert.NotNil(t, client.GetLogger())
+}
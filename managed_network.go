@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"crypto/rand"
 	"math"
 	"math/big"
@@ -41,6 +42,7 @@ type _ManagedNetwork struct {
 	minNodeReadmitPeriod   time.Duration
 	maxNodeReadmitPeriod   time.Duration
 	earliestReadmitTime    time.Time
+	meter                  Meter
 }
 
 func _NewManagedNetwork() _ManagedNetwork {
@@ -57,13 +59,21 @@ func _NewManagedNetwork() _ManagedNetwork {
 		verifyCertificate:      false,
 		minNodeReadmitPeriod:   8 * time.Second,
 		maxNodeReadmitPeriod:   1 * time.Hour,
+		meter:                  _NoopMeter{},
 	}
 }
 
 func (this *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error {
+	return this._SetNetworkNodes(network, func(node _IManagedNode) error {
+		return node._Close()
+	})
+}
+
+// _SetNetworkNodes replaces the network's nodes, keeping the existing node for every entry with the
+// same key and address so its connection and health are preserved. closeNode is called for each node
+// which is no longer part of the network.
+func (this *_ManagedNetwork) _SetNetworkNodes(network map[string]_IManagedNode, closeNode func(node _IManagedNode) error) error {
 	newNodes := make([]_IManagedNode, len(this.nodes))
-	newNodeKeys := map[string]bool{}
-	newNodeValues := map[string]bool{}
 
 	// Copy all the nodes into the `newNodes` list
 	copy(newNodes, this.nodes)
@@ -72,7 +82,7 @@ func (this *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error
 	for _, index := range _GetNodesToRemove(network, newNodes) {
 		node := newNodes[index]
 
-		if err := node._Close(); err != nil {
+		if err := closeNode(node); err != nil {
 			return err
 		}
 
@@ -83,16 +93,8 @@ func (this *_ManagedNetwork) _SetNetwork(network map[string]_IManagedNode) error
 		}
 	}
 
-	for _, node := range newNodes {
-		newNodeKeys[node._GetKey()] = true
-		newNodeValues[node._GetAddress()] = true
-	}
-
-	for key, value := range network {
-		_, keyOk := newNodeKeys[key]
-		_, valueOk := newNodeValues[value._GetAddress()]
-
-		if keyOk && valueOk {
+	for _, value := range network {
+		if _ContainsNode(newNodes, value) {
 			continue
 		}
 
@@ -133,6 +135,9 @@ outer:
 
 		if node._IsHealthy() {
 			this.healthyNodes = append(this.healthyNodes, node)
+			if node._GetReadmitTime() != nil {
+				this.meter.AddCounter(context.Background(), MetricNodeReadmitted, 1, Attr(AttributeNode, node._GetKey()))
+			}
 		}
 	}
 }
@@ -196,6 +201,10 @@ func (this *_ManagedNetwork) _GetNode() _IManagedNode {
 	return this.healthyNodes[index.Int64()]
 }
 
+func (this *_ManagedNetwork) _SetMeter(meter Meter) {
+	this.meter = meter
+}
+
 func (this *_ManagedNetwork) _GetMinBackoff() time.Duration {
 	return this.minBackoff
 }
@@ -281,9 +290,13 @@ func (this *_ManagedNetwork) _SetTransportSecurity(transportSecurity bool) (err
 
 func _GetNodesToRemove(network map[string]_IManagedNode, nodes []_IManagedNode) []int {
 	nodeIndices := []int{}
+	values := make([]_IManagedNode, 0, len(network))
+	for _, value := range network {
+		values = append(values, value)
+	}
 
 	for i := len(nodes) - 1; i >= 0; i-- {
-		if _, ok := network[nodes[i]._GetKey()]; !ok {
+		if !_ContainsNode(values, nodes[i]) {
 			nodeIndices = append(nodeIndices, i)
 		}
 	}
@@ -291,6 +304,17 @@ func _GetNodesToRemove(network map[string]_IManagedNode, nodes []_IManagedNode)
 	return nodeIndices
 }
 
+// _ContainsNode reports whether nodes contain a node with the same key and address as node
+func _ContainsNode(nodes []_IManagedNode, node _IManagedNode) bool {
+	for _, other := range nodes {
+		if other._GetKey() == node._GetKey() && other._GetAddress() == node._GetAddress() {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (this *_ManagedNetwork) _SetVerifyCertificate(verify bool) *_ManagedNetwork {
 	for _, node := range this.nodes {
 		node._SetVerifyCertificate(verify)
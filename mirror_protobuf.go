@@ -0,0 +1,340 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protowire"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// The generated `hedera-protobufs-go/mirror` package registers its `consensus_service.proto`
+// under the same name as the one in `hedera-protobufs-go/services`, which makes the protobuf
+// runtime panic as soon as both packages are linked into a binary. The mirror API only uses a
+// handful of small messages, so they are encoded here by hand and sent with `_MirrorCodec`.
+
+const (
+	mirrorConsensusServiceSubscribeTopic = "/com.hedera.mirror.api.proto.ConsensusService/subscribeTopic"
+	mirrorNetworkServiceGetNodes         = "/com.hedera.mirror.api.proto.NetworkService/getNodes"
+)
+
+type _MirrorMessage interface {
+	_Marshal() ([]byte, error)
+	_Unmarshal(data []byte) error
+}
+
+// _MirrorCodec is a gRPC codec which understands both the hand encoded mirror messages
+// and regular generated protobuf messages (e.g. `services.NodeAddress`).
+type _MirrorCodec struct{}
+
+func (_MirrorCodec) Marshal(v interface{}) ([]byte, error) {
+	switch message := v.(type) {
+	case _MirrorMessage:
+		return message._Marshal()
+	case protobuf.Message:
+		return protobuf.Marshal(message)
+	default:
+		return nil, fmt.Errorf("mirror codec: cannot marshal %T", v)
+	}
+}
+
+func (_MirrorCodec) Unmarshal(data []byte, v interface{}) error {
+	switch message := v.(type) {
+	case _MirrorMessage:
+		return message._Unmarshal(data)
+	case protobuf.Message:
+		return protobuf.Unmarshal(data, message)
+	default:
+		return fmt.Errorf("mirror codec: cannot unmarshal into %T", v)
+	}
+}
+
+func (_MirrorCodec) Name() string {
+	return "proto"
+}
+
+// _MirrorConsensusTopicQuery mirrors `com.hedera.mirror.api.proto.ConsensusTopicQuery`
+type _MirrorConsensusTopicQuery struct {
+	TopicID            *services.TopicID
+	ConsensusStartTime *services.Timestamp
+	ConsensusEndTime   *services.Timestamp
+	Limit              uint64
+}
+
+func (query *_MirrorConsensusTopicQuery) _Marshal() (data []byte, err error) {
+	if data, err = _MirrorAppendMessage(data, 1, query.TopicID); err != nil {
+		return nil, err
+	}
+	if data, err = _MirrorAppendMessage(data, 2, query.ConsensusStartTime); err != nil {
+		return nil, err
+	}
+	if data, err = _MirrorAppendMessage(data, 3, query.ConsensusEndTime); err != nil {
+		return nil, err
+	}
+	if query.Limit != 0 {
+		data = protowire.AppendTag(data, 4, protowire.VarintType)
+		data = protowire.AppendVarint(data, query.Limit)
+	}
+
+	return data, nil
+}
+
+func (query *_MirrorConsensusTopicQuery) _Unmarshal(data []byte) error {
+	*query = _MirrorConsensusTopicQuery{}
+
+	return _MirrorConsumeFields(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			query.TopicID = &services.TopicID{}
+			return protobuf.Unmarshal(value, query.TopicID)
+		case num == 2 && typ == protowire.BytesType:
+			query.ConsensusStartTime = &services.Timestamp{}
+			return protobuf.Unmarshal(value, query.ConsensusStartTime)
+		case num == 3 && typ == protowire.BytesType:
+			query.ConsensusEndTime = &services.Timestamp{}
+			return protobuf.Unmarshal(value, query.ConsensusEndTime)
+		case num == 4 && typ == protowire.VarintType:
+			query.Limit = varint
+		}
+		return nil
+	})
+}
+
+// _MirrorConsensusTopicResponse mirrors `com.hedera.mirror.api.proto.ConsensusTopicResponse`
+type _MirrorConsensusTopicResponse struct {
+	ConsensusTimestamp *services.Timestamp
+	Message            []byte
+	RunningHash        []byte
+	SequenceNumber     uint64
+	RunningHashVersion uint64
+	ChunkInfo          *services.ConsensusMessageChunkInfo
+}
+
+func (resp *_MirrorConsensusTopicResponse) _Marshal() (data []byte, err error) {
+	if data, err = _MirrorAppendMessage(data, 1, resp.ConsensusTimestamp); err != nil {
+		return nil, err
+	}
+	if len(resp.Message) > 0 {
+		data = protowire.AppendTag(data, 2, protowire.BytesType)
+		data = protowire.AppendBytes(data, resp.Message)
+	}
+	if len(resp.RunningHash) > 0 {
+		data = protowire.AppendTag(data, 3, protowire.BytesType)
+		data = protowire.AppendBytes(data, resp.RunningHash)
+	}
+	if resp.SequenceNumber != 0 {
+		data = protowire.AppendTag(data, 4, protowire.VarintType)
+		data = protowire.AppendVarint(data, resp.SequenceNumber)
+	}
+	if resp.RunningHashVersion != 0 {
+		data = protowire.AppendTag(data, 5, protowire.VarintType)
+		data = protowire.AppendVarint(data, resp.RunningHashVersion)
+	}
+	if data, err = _MirrorAppendMessage(data, 6, resp.ChunkInfo); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (resp *_MirrorConsensusTopicResponse) _Unmarshal(data []byte) error {
+	*resp = _MirrorConsensusTopicResponse{}
+
+	return _MirrorConsumeFields(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			resp.ConsensusTimestamp = &services.Timestamp{}
+			return protobuf.Unmarshal(value, resp.ConsensusTimestamp)
+		case num == 2 && typ == protowire.BytesType:
+			resp.Message = append([]byte{}, value...)
+		case num == 3 && typ == protowire.BytesType:
+			resp.RunningHash = append([]byte{}, value...)
+		case num == 4 && typ == protowire.VarintType:
+			resp.SequenceNumber = varint
+		case num == 5 && typ == protowire.VarintType:
+			resp.RunningHashVersion = varint
+		case num == 6 && typ == protowire.BytesType:
+			resp.ChunkInfo = &services.ConsensusMessageChunkInfo{}
+			return protobuf.Unmarshal(value, resp.ChunkInfo)
+		}
+		return nil
+	})
+}
+
+// _MirrorConsensusServiceClient is the client side of `com.hedera.mirror.api.proto.ConsensusService`
+type _MirrorConsensusServiceClient struct {
+	conn *grpc.ClientConn
+}
+
+func (client _MirrorConsensusServiceClient) SubscribeTopic(ctx context.Context, query *_MirrorConsensusTopicQuery) (*_MirrorConsensusTopicStream, error) {
+	stream, err := _MirrorNewServerStream(ctx, client.conn, "subscribeTopic", mirrorConsensusServiceSubscribeTopic, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &_MirrorConsensusTopicStream{stream}, nil
+}
+
+type _MirrorConsensusTopicStream struct {
+	grpc.ClientStream
+}
+
+func (stream *_MirrorConsensusTopicStream) Recv() (*_MirrorConsensusTopicResponse, error) {
+	resp := &_MirrorConsensusTopicResponse{}
+	if err := stream.ClientStream.RecvMsg(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// _MirrorAddressBookQuery mirrors `com.hedera.mirror.api.proto.AddressBookQuery`
+type _MirrorAddressBookQuery struct {
+	FileID *services.FileID
+	Limit  int32
+}
+
+func (query *_MirrorAddressBookQuery) _Marshal() (data []byte, err error) {
+	if data, err = _MirrorAppendMessage(data, 1, query.FileID); err != nil {
+		return nil, err
+	}
+	if query.Limit != 0 {
+		data = protowire.AppendTag(data, 2, protowire.VarintType)
+		data = protowire.AppendVarint(data, uint64(query.Limit))
+	}
+
+	return data, nil
+}
+
+func (query *_MirrorAddressBookQuery) _Unmarshal(data []byte) error {
+	*query = _MirrorAddressBookQuery{}
+
+	return _MirrorConsumeFields(data, func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error {
+		switch {
+		case num == 1 && typ == protowire.BytesType:
+			query.FileID = &services.FileID{}
+			return protobuf.Unmarshal(value, query.FileID)
+		case num == 2 && typ == protowire.VarintType:
+			query.Limit = int32(varint)
+		}
+		return nil
+	})
+}
+
+// _MirrorNetworkServiceClient is the client side of `com.hedera.mirror.api.proto.NetworkService`
+type _MirrorNetworkServiceClient struct {
+	conn *grpc.ClientConn
+}
+
+func (client _MirrorNetworkServiceClient) GetNodes(ctx context.Context, query *_MirrorAddressBookQuery) (*_MirrorNodeAddressStream, error) {
+	stream, err := _MirrorNewServerStream(ctx, client.conn, "getNodes", mirrorNetworkServiceGetNodes, query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &_MirrorNodeAddressStream{stream}, nil
+}
+
+type _MirrorNodeAddressStream struct {
+	grpc.ClientStream
+}
+
+func (stream *_MirrorNodeAddressStream) Recv() (*services.NodeAddress, error) {
+	resp := &services.NodeAddress{}
+	if err := stream.ClientStream.RecvMsg(resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func _MirrorNewServerStream(ctx context.Context, conn *grpc.ClientConn, name string, method string, request interface{}) (grpc.ClientStream, error) {
+	desc := &grpc.StreamDesc{
+		StreamName:    name,
+		ServerStreams: true,
+	}
+
+	stream, err := conn.NewStream(ctx, desc, method, grpc.ForceCodec(_MirrorCodec{}))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := stream.SendMsg(request); err != nil {
+		return nil, err
+	}
+
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+func _MirrorAppendMessage(data []byte, num protowire.Number, message protobuf.Message) ([]byte, error) {
+	if message == nil || !message.ProtoReflect().IsValid() {
+		return data, nil
+	}
+
+	bytes, err := protobuf.Marshal(message)
+	if err != nil {
+		return nil, err
+	}
+
+	data = protowire.AppendTag(data, num, protowire.BytesType)
+	return protowire.AppendBytes(data, bytes), nil
+}
+
+func _MirrorConsumeFields(data []byte, field func(num protowire.Number, typ protowire.Type, value []byte, varint uint64) error) error {
+	for len(data) > 0 {
+		num, typ, n := protowire.ConsumeTag(data)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		var value []byte
+		var varint uint64
+
+		switch typ {
+		case protowire.BytesType:
+			value, n = protowire.ConsumeBytes(data)
+		case protowire.VarintType:
+			varint, n = protowire.ConsumeVarint(data)
+		default:
+			n = protowire.ConsumeFieldValue(num, typ, data)
+		}
+
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		data = data[n:]
+
+		if err := field(num, typ, value, varint); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -38,6 +38,27 @@ func (m Mnemonic) ToPrivateKey(passPhrase string) (PrivateKey, error) {
 	return PrivateKeyFromMnemonic(m, passPhrase)
 }
 
+// ToStandardECDSAsecp256k1PrivateKey derives the ECDSA(secp256k1) key at the standard Ethereum path
+// m/44'/60'/0'/0/index, matching the accounts of wallets such as MetaMask.
+func (m Mnemonic) ToStandardECDSAsecp256k1PrivateKey(passPhrase string, index uint32) (PrivateKey, error) {
+	if index&_HardenedIndex != 0 {
+		return PrivateKey{}, _NewErrBadKeyf("index must be below %v", _HardenedIndex)
+	}
+
+	return m.ToECDSAsecp256k1PrivateKeyWithPath(passPhrase, fmt.Sprintf("m/44'/60'/0'/0/%d", index))
+}
+
+// ToECDSAsecp256k1PrivateKeyWithPath derives the ECDSA(secp256k1) key at an arbitrary BIP-32 path from the BIP-39
+// seed of this mnemonic.
+func (m Mnemonic) ToECDSAsecp256k1PrivateKeyWithPath(passPhrase string, path string) (PrivateKey, error) {
+	key, err := PrivateKeyFromSeedECDSAsecp256k1(bip39.NewSeed(m.words, passPhrase))
+	if err != nil {
+		return PrivateKey{}, err
+	}
+
+	return key.DerivePath(path)
+}
+
 // GenerateMnemonic generates a random 24-word mnemonic
 func GenerateMnemonic24() (Mnemonic, error) {
 	entropy, err := bip39.NewEntropy(256)
@@ -104,6 +104,22 @@ func TestUnitNew12MnemonicFromGeneratedMnemonic(t *testing.T) {
 	assert.Equal(t, gKey.ed25519PrivateKey.keyData, stKey.ed25519PrivateKey.keyData)
 }
 
+func TestUnitLegacyMnemonicWordList(t *testing.T) {
+	// log, method and node were once corrupted in the word list, making mnemonics with them unusable
+	legacyString := "jolly kidnap tom lawn drunk chick optic lust mutter log bride galley dense method sage neural widow node curb aboard margin axis"
+
+	mnemonic, err := MnemonicFromString(legacyString)
+	require.NoError(t, err)
+
+	key, err := mnemonic.ToLegacyPrivateKey()
+	require.NoError(t, err)
+	assert.Equal(t, "302e020100300506032b65700422042087457215954cb39077698abf603aedef9757c8aa4e4cdbaa08ab98c7e2f47532", key.String())
+
+	for _, word := range legacy {
+		assert.Regexp(t, "^[a-z]+$", word)
+	}
+}
+
 func TestUnitNewMnemonic(t *testing.T) {
 	legacyString := "obvious favorite remain caution remove laptop base vacant increase video erase pass sniff sausage knock grid argue salt romance way alone fever slush dune"
 
@@ -152,3 +168,23 @@ func TestUnitMnemonicBreaksWithBadLength(t *testing.T) {
 	_, err = NewMnemonic(strings.Split(shortMnemonic, " "))
 	assert.Error(t, err)
 }
+
+func TestUnitMnemonicToStandardECDSAsecp256k1PrivateKey(t *testing.T) {
+	mnemonic, err := MnemonicFromString("test test test test test test test test test test test junk")
+	require.NoError(t, err)
+
+	key, err := mnemonic.ToStandardECDSAsecp256k1PrivateKey("", 0)
+	require.NoError(t, err)
+	assert.Equal(t, "ac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", key.StringRaw())
+
+	key, err = mnemonic.ToStandardECDSAsecp256k1PrivateKey("", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d", key.StringRaw())
+
+	pathKey, err := mnemonic.ToECDSAsecp256k1PrivateKeyWithPath("", "m/44'/60'/0'/0/1")
+	require.NoError(t, err)
+	assert.Equal(t, key.StringRaw(), pathKey.StringRaw())
+
+	_, err = mnemonic.ToStandardECDSAsecp256k1PrivateKey("", _HardenedIndex)
+	assert.Error(t, err)
+}
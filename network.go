@@ -21,22 +21,33 @@ package hedera
  */
 
 import (
+	"sync"
 	"time"
 )
 
 type _Network struct {
 	_ManagedNetwork
-	addressBook map[AccountID]NodeAddress
+	// Guards the node lists, which a background address book update may replace at any time
+	mutex         *sync.Mutex
+	addressBook   map[AccountID]NodeAddress
+	nodeSelector  NodeSelector
+	pinnedNodes   []AccountID
+	excludedNodes []AccountID
 }
 
 func _NewNetwork() _Network {
 	return _Network{
 		_ManagedNetwork: _NewManagedNetwork(),
+		mutex:           &sync.Mutex{},
 		addressBook:     nil,
+		nodeSelector:    NewRandomNodeSelector(),
 	}
 }
 
 func (network *_Network) SetNetwork(net map[string]AccountID) (err error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
 	newNetwork := make(map[string]_IManagedNode)
 
 	for url, id := range net {
@@ -51,6 +62,9 @@ func (network *_Network) SetNetwork(net map[string]AccountID) (err error) {
 }
 
 func (network *_Network) _GetNetwork() map[string]AccountID {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
 	temp := make(map[string]AccountID)
 	for _, node := range network._ManagedNetwork.nodes {
 		switch n := node.(type) { //nolint
@@ -63,9 +77,12 @@ func (network *_Network) _GetNetwork() map[string]AccountID {
 }
 
 func (network *_Network) _IncreaseBackoff(node *_Node) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
 	node._IncreaseBackoff()
 
-	index := 0
+	index := -1
 	for i, healthyNode := range network.healthyNodes {
 		if node == healthyNode {
 			index = i
@@ -73,6 +90,10 @@ func (network *_Network) _IncreaseBackoff(node *_Node) {
 		}
 	}
 
+	if index < 0 {
+		return
+	}
+
 	if index == len(network.healthyNodes)-1 {
 		network.healthyNodes = network.healthyNodes[:index]
 	} else {
@@ -81,12 +102,164 @@ func (network *_Network) _IncreaseBackoff(node *_Node) {
 }
 
 func (network *_Network) _GetNodeForAccountID(id AccountID) (*_Node, bool) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
 	node, ok := network.network[id.String()]
+	if !ok || len(node) == 0 {
+		return nil, false
+	}
+
 	return node[0].(*_Node), ok
 }
 
-func (network *_Network) _GetNode() *_Node {
-	return network._ManagedNetwork._GetNode().(*_Node)
+// _GetNode returns the node to send the next request to, as chosen by the node selector, or an error if the pinned
+// and excluded node account IDs leave no node to choose from
+func (network *_Network) _GetNode() (*_Node, error) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	candidates, nodes := network._GetNodeCandidates()
+	if len(candidates) == 0 {
+		return nil, errNoNodeAllowed
+	}
+
+	selected := network.nodeSelector.Select(candidates, 1)
+	if len(selected) > 0 {
+		if node := _PickNodeForAccountID(nodes[_NodeSelectorKey(selected[0])]); node != nil {
+			return node, nil
+		}
+	}
+
+	return _PickNodeForAccountID(nodes[_NodeSelectorKey(candidates[0].AccountID)]), nil
+}
+
+// _GetNodeCandidates returns one NodeCandidate per node account ID allowed by the pinned and excluded
+// lists, along with the nodes serving each account ID
+func (network *_Network) _GetNodeCandidates() ([]NodeCandidate, map[AccountID][]*_Node) {
+	network._ReadmitNodes()
+
+	candidates := make([]NodeCandidate, 0)
+	nodes := make(map[AccountID][]*_Node)
+	indexes := make(map[AccountID]int)
+
+	for _, managedNode := range network._ManagedNetwork.nodes {
+		node, ok := managedNode.(*_Node)
+		if !ok || !network._IsNodeAllowed(node.accountID) {
+			continue
+		}
+
+		key := _NodeSelectorKey(node.accountID)
+		nodes[key] = append(nodes[key], node)
+
+		index, ok := indexes[key]
+		if !ok {
+			index = len(candidates)
+			indexes[key] = index
+			candidates = append(candidates, NodeCandidate{AccountID: key, Address: node._GetAddress()})
+		}
+
+		candidate := &candidates[index]
+		if node._IsHealthy() && !candidate.Healthy {
+			candidate.Healthy = true
+			candidate.Address = node._GetAddress()
+		}
+		candidate.UseCount += node._GetUseCount()
+		candidate.FailureCount += node._GetAttempts()
+		if node._GetLastUsed().After(candidate.LastUsed) {
+			candidate.LastUsed = node._GetLastUsed()
+		}
+	}
+
+	return candidates, nodes
+}
+
+func (network *_Network) _IsNodeAllowed(accountID AccountID) bool {
+	key := _NodeSelectorKey(accountID)
+
+	for _, excluded := range network.excludedNodes {
+		if _NodeSelectorKey(excluded) == key {
+			return false
+		}
+	}
+
+	if len(network.pinnedNodes) == 0 {
+		return true
+	}
+
+	for _, pinned := range network.pinnedNodes {
+		if _NodeSelectorKey(pinned) == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// _PickNodeForAccountID picks a random healthy node among those serving the same account ID,
+// or any of them if none are healthy
+func _PickNodeForAccountID(nodes []*_Node) *_Node {
+	healthy := make([]*_Node, 0, len(nodes))
+	for _, node := range nodes {
+		if node._IsHealthy() {
+			healthy = append(healthy, node)
+		}
+	}
+
+	if len(healthy) == 0 {
+		healthy = nodes
+	}
+
+	if len(healthy) == 0 {
+		return nil
+	}
+
+	return healthy[_RandomIndex(len(healthy))]
+}
+
+func (network *_Network) _ObserveNode(node *_Node, latency time.Duration, failed bool) {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	network.nodeSelector.Observe(NodeObservation{
+		AccountID: node.accountID,
+		Latency:   latency,
+		Failed:    failed,
+	})
+}
+
+func (network *_Network) _SetNodeSelector(selector NodeSelector) {
+	if selector == nil {
+		selector = NewRandomNodeSelector()
+	}
+
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	network.nodeSelector = selector
+}
+
+func (network *_Network) _GetNodeSelector() NodeSelector {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	return network.nodeSelector
+}
+
+func (network *_Network) _SetPinnedNodes(accountIDs []AccountID) {
+	network.pinnedNodes = append([]AccountID(nil), accountIDs...)
+}
+
+func (network *_Network) _GetPinnedNodes() []AccountID {
+	return append([]AccountID(nil), network.pinnedNodes...)
+}
+
+func (network *_Network) _SetExcludedNodes(accountIDs []AccountID) {
+	network.excludedNodes = append([]AccountID(nil), accountIDs...)
+}
+
+func (network *_Network) _GetExcludedNodes() []AccountID {
+	return append([]AccountID(nil), network.excludedNodes...)
 }
 
 func (network *_Network) _GetNetworkName() *NetworkName {
@@ -138,6 +311,81 @@ func (network *_Network) _SetLedgerID(id LedgerID) {
 	}
 }
 
+// _SetAddressBook updates the network to match an address book fetched from the network. Nodes
+// which are unchanged are kept as is, new endpoints are added and nodes no longer listed are
+// removed. Removed nodes are closed after closeDelay so requests already using them can finish.
+func (network *_Network) _SetAddressBook(book NodeAddressBook, closeDelay time.Duration) error {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
+	newNetwork := make(map[string]_IManagedNode)
+	for address, accountID := range _NetworkFromAddressBook(book, network.transportSecurity) {
+		node, err := _NewNode(accountID, address, network.minBackoff)
+		if err != nil {
+			return err
+		}
+		node._SetMaxBackoff(network.maxBackoff)
+		node._SetVerifyCertificate(network.verifyCertificate)
+		newNetwork[address] = node
+	}
+
+	if len(newNetwork) == 0 {
+		return errEmptyAddressBook
+	}
+
+	ledgerID := network.ledgerID
+	err := network._ManagedNetwork._SetNetworkNodes(newNetwork, func(node _IManagedNode) error {
+		time.AfterFunc(closeDelay, func() {
+			_ = node._Close()
+		})
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	network.ledgerID = ledgerID
+
+	network.addressBook = book._ToMap()
+	for _, node := range network._ManagedNetwork.nodes {
+		if node, ok := node.(*_Node); ok {
+			if address, ok := network.addressBook[_NodeSelectorKey(node.accountID)]; ok {
+				node.addressBook = &address
+			}
+		}
+	}
+
+	return nil
+}
+
+// _NetworkFromAddressBook returns the node endpoints listed in an address book, using the TLS port of
+// each endpoint when transport security is enabled and the plaintext port otherwise
+func _NetworkFromAddressBook(book NodeAddressBook, transportSecurity bool) map[string]AccountID {
+	network := make(map[string]AccountID)
+
+	for _, nodeAddress := range book.NodeAddresses {
+		if nodeAddress.AccountID == nil {
+			continue
+		}
+
+		for _, endpoint := range nodeAddress.Addresses {
+			address, err := _ManagedNodeAddressFromString(endpoint.String())
+			if err != nil {
+				continue
+			}
+
+			if transportSecurity {
+				address = address._ToSecure()
+			} else {
+				address = address._ToInsecure()
+			}
+
+			network[address._String()] = _NodeSelectorKey(*nodeAddress.AccountID)
+		}
+	}
+
+	return network
+}
+
 func (network *_Network) _SetNetworkName(net NetworkName) {
 	ledger, err := LedgerIDFromNetworkName(net)
 	if err != nil {
@@ -148,13 +396,20 @@ func (network *_Network) _SetNetworkName(net NetworkName) {
 }
 
 func (network *_Network) _GetNodeAccountIDsForExecute() []AccountID { //nolint
-	nodes := make([]AccountID, 0)
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
 
-	for i := 0; i < network._GetNumberOfNodesForTransaction(); i++ {
-		nodes = append(nodes, network.healthyNodes[i].(*_Node).accountID)
+	candidates, _ := network._GetNodeCandidates()
+	if len(candidates) == 0 {
+		return []AccountID{}
 	}
 
-	return nodes
+	count := network._GetNumberOfNodesForTransaction()
+	if count < 1 {
+		count = 1
+	}
+
+	return network.nodeSelector.Select(candidates, count)
 }
 
 func (network *_Network) _SetMaxNodesPerTransaction(max int) {
@@ -186,6 +441,9 @@ func (network *_Network) _GetNodeMaxBackoff() time.Duration {
 }
 
 func (network *_Network) _SetTransportSecurity(transportSecurity bool) *_Network {
+	network.mutex.Lock()
+	defer network.mutex.Unlock()
+
 	_ = network._ManagedNetwork._SetTransportSecurity(transportSecurity)
 	return network
 }
@@ -25,7 +25,9 @@ package hedera
 
 import (
 	"testing"
+	"time"
 
+	"github.com/hashgraph/hedera-protobufs-go/services"
 	"github.com/stretchr/testify/require"
 )
 
@@ -41,3 +43,89 @@ func TestUnitNetworkAddressBookGetsSet(t *testing.T) {
 
 	require.True(t, network.addressBook != nil)
 }
+
+func _MockNodeAddress(account int64, certHash []byte, ips ...[]byte) *services.NodeAddress {
+	endpoints := make([]*services.ServiceEndpoint, 0, len(ips))
+	for _, ip := range ips {
+		endpoints = append(endpoints, &services.ServiceEndpoint{IpAddressV4: ip, Port: 50211})
+	}
+
+	return &services.NodeAddress{
+		NodeAccountId:   &services.AccountID{Account: &services.AccountID_AccountNum{AccountNum: account}},
+		NodeCertHash:    certHash,
+		ServiceEndpoint: endpoints,
+	}
+}
+
+func TestUnitNetworkFromAddressBook(t *testing.T) {
+	book := NodeAddressBook{NodeAddresses: []NodeAddress{
+		_NodeAddressFromProtobuf(_MockNodeAddress(3, nil, []byte{1, 2, 3, 4}, []byte{1, 2, 3, 5})),
+		_NodeAddressFromProtobuf(_MockNodeAddress(4, nil, []byte{1, 2, 3, 6})),
+		{Addresses: []_Endpoint{_EndpointFromProtobuf(&services.ServiceEndpoint{IpAddressV4: []byte{1, 2, 3, 7}})}},
+	}}
+
+	require.Equal(t, map[string]AccountID{
+		"1.2.3.4:50211": {Account: 3},
+		"1.2.3.5:50211": {Account: 3},
+		"1.2.3.6:50211": {Account: 4},
+	}, _NetworkFromAddressBook(book, false))
+
+	require.Equal(t, map[string]AccountID{
+		"1.2.3.4:50212": {Account: 3},
+		"1.2.3.5:50212": {Account: 3},
+		"1.2.3.6:50212": {Account: 4},
+	}, _NetworkFromAddressBook(book, true))
+}
+
+func TestUnitNetworkSetAddressBook(t *testing.T) {
+	network := _NewNetwork()
+	err := network.SetNetwork(map[string]AccountID{
+		"1.2.3.4:50211": {Account: 3},
+		"1.2.3.5:50211": {Account: 4},
+	})
+	require.NoError(t, err)
+
+	kept, ok := network._GetNodeForAccountID(AccountID{Account: 3})
+	require.True(t, ok)
+
+	book := NodeAddressBook{NodeAddresses: []NodeAddress{
+		_NodeAddressFromProtobuf(_MockNodeAddress(3, []byte{1}, []byte{1, 2, 3, 4})),
+		_NodeAddressFromProtobuf(_MockNodeAddress(5, []byte{2}, []byte{1, 2, 3, 6})),
+	}}
+
+	err = network._SetAddressBook(book, time.Hour)
+	require.NoError(t, err)
+
+	require.Equal(t, map[string]AccountID{
+		"1.2.3.4:50211": {Account: 3},
+		"1.2.3.6:50211": {Account: 5},
+	}, network._GetNetwork())
+
+	node, ok := network._GetNodeForAccountID(AccountID{Account: 3})
+	require.True(t, ok)
+	require.Same(t, kept, node)
+	require.NotNil(t, node.addressBook)
+	require.Equal(t, []byte{1}, node.addressBook.CertHash)
+
+	_, ok = network._GetNodeForAccountID(AccountID{Account: 4})
+	require.False(t, ok)
+
+	node, ok = network._GetNodeForAccountID(AccountID{Account: 5})
+	require.True(t, ok)
+	require.Equal(t, []byte{2}, node.addressBook.CertHash)
+}
+
+func TestUnitNetworkSetAddressBookEmpty(t *testing.T) {
+	network := _NewNetwork()
+	err := network.SetNetwork(map[string]AccountID{
+		"1.2.3.4:50211": {Account: 3},
+	})
+	require.NoError(t, err)
+
+	err = network._SetAddressBook(NodeAddressBook{}, time.Hour)
+	require.ErrorIs(t, err, errEmptyAddressBook)
+
+	require.Equal(t, map[string]AccountID{
+		"1.2.3.4:50211": {Account: 3},
+	}, network._GetNetwork())
+}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -43,7 +44,14 @@ func (query *NetworkVersionInfoQuery) SetGrpcDeadline(deadline *time.Duration) *
 	return query
 }
 
+// GetCost returns the cost of the Query
 func (query *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *NetworkVersionInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -57,6 +65,7 @@ func (query *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_NetworkVersionInfoQueryShouldRetry,
@@ -84,8 +93,8 @@ func (query *NetworkVersionInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _NetworkVersionInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetNetworkGetVersionInfo().Header.NodeTransactionPrecheckCode))
+func _NetworkVersionInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetNetworkGetVersionInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _NetworkVersionInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -100,7 +109,14 @@ func _NetworkVersionInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *NetworkVersionInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (NetworkVersionInfo, error) {
 	if client == nil || client.operator == nil {
 		return NetworkVersionInfo{}, errNoClientProvided
 	}
@@ -121,7 +137,7 @@ func (query *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInf
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return NetworkVersionInfo{}, err
 		}
@@ -162,6 +178,7 @@ func (query *NetworkVersionInfoQuery) Execute(client *Client) (NetworkVersionInf
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_NetworkVersionInfoQueryShouldRetry,
@@ -207,6 +224,12 @@ func (query *NetworkVersionInfoQuery) SetMaxRetry(count int) *NetworkVersionInfo
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *NetworkVersionInfoQuery) SetRetryPolicy(policy RetryPolicy) *NetworkVersionInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *NetworkVersionInfoQuery) SetMaxBackoff(max time.Duration) *NetworkVersionInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -119,7 +119,7 @@ func (node *_Node) _GetReadmitTime() *time.Time {
 	return node._ManagedNode._GetReadmitTime()
 }
 
-func (node *_Node) _GetChannel() (*_Channel, error) {
+func (node *_Node) _GetChannel(logger Logger) (*_Channel, error) {
 	if node.channel != nil {
 		return node.channel, nil
 	}
@@ -134,14 +134,14 @@ func (node *_Node) _GetChannel() (*_Channel, error) {
 	var err error
 	security := grpc.WithInsecure() //nolint
 	if !node.verifyCertificate {
-		println("skipping certificate check")
+		logger.Trace("skipping certificate check", "nodeAccountID", node.accountID.String())
 	}
 	if node._ManagedNode.address._IsTransportSecurity() {
 		security = grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
 			InsecureSkipVerify: true, // nolint
 			VerifyPeerCertificate: func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
 				if node.addressBook == nil {
-					logCtx.Warn().Msg("skipping certificate check since no cert hash was found")
+					logger.Warn("skipping certificate check since no cert hash was found", "nodeAccountID", node.accountID.String())
 					return nil
 				}
 
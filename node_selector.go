@@ -0,0 +1,334 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+)
+
+// NodeCandidate describes a consensus node a NodeSelector may choose.
+type NodeCandidate struct {
+	AccountID AccountID
+	// Address of one of the node's healthy endpoints, or of any endpoint if none are healthy
+	Address string
+	// Whether at least one of the node's endpoints isn't backing off
+	Healthy bool
+	// Number of requests sent to the node
+	UseCount int64
+	// Last time a request was sent to the node
+	LastUsed time.Time
+	// Number of gRPC failures which made the node back off
+	FailureCount int64
+}
+
+// NodeObservation is the outcome of a single call to a node, reported to NodeSelector.Observe.
+type NodeObservation struct {
+	AccountID AccountID
+	// Time spent waiting on the node; zero if no call could be made
+	Latency time.Duration
+	// Whether the call failed at the transport level, e.g. a gRPC error or an unreachable node
+	Failed bool
+}
+
+// NodeSelector chooses which consensus nodes the Client sends requests to when the nodes weren't set
+// explicitly with `SetNodeAccountIDs()`.
+//
+// A Client calls Select and Observe while holding its network's lock, so the calls one Client makes are never
+// concurrent and a selector used by a single Client needs no locking of its own. They must not call back into the
+// Client. A selector shared between several Clients has to lock itself, as the selectors of the SDK do.
+type NodeSelector interface {
+	// Select returns up to count node account IDs from candidates, most preferred first.
+	// Candidates always contain at least one node and count is always at least one.
+	Select(candidates []NodeCandidate, count int) []AccountID
+	// Observe is called after every call the Client makes to a node.
+	Observe(observation NodeObservation)
+}
+
+// RandomNodeSelector picks healthy nodes uniformly at random. It's the Client's default.
+type RandomNodeSelector struct{}
+
+func NewRandomNodeSelector() *RandomNodeSelector {
+	return &RandomNodeSelector{}
+}
+
+func (selector *RandomNodeSelector) Select(candidates []NodeCandidate, count int) []AccountID {
+	candidates = _HealthyNodeCandidates(candidates)
+	_ShuffleNodeCandidates(candidates)
+
+	return _NodeCandidateAccountIDs(candidates, count)
+}
+
+func (selector *RandomNodeSelector) Observe(NodeObservation) {}
+
+// RoundRobinNodeSelector cycles through the healthy nodes in account ID order.
+type RoundRobinNodeSelector struct {
+	mutex sync.Mutex
+	next  uint64
+}
+
+func NewRoundRobinNodeSelector() *RoundRobinNodeSelector {
+	return &RoundRobinNodeSelector{}
+}
+
+func (selector *RoundRobinNodeSelector) Select(candidates []NodeCandidate, count int) []AccountID {
+	candidates = _HealthyNodeCandidates(candidates)
+	_SortNodeCandidates(candidates)
+
+	selector.mutex.Lock()
+	start := int(selector.next % uint64(len(candidates)))
+	selector.next++
+	selector.mutex.Unlock()
+
+	return _NodeCandidateAccountIDs(append(candidates[start:], candidates[:start]...), count)
+}
+
+func (selector *RoundRobinNodeSelector) Observe(NodeObservation) {}
+
+// LeastRecentlyFailedNodeSelector prefers the nodes whose last failure is the oldest, nodes which
+// never failed coming first. Ties are broken at random.
+type LeastRecentlyFailedNodeSelector struct {
+	mutex       sync.Mutex
+	lastFailure map[AccountID]time.Time
+}
+
+func NewLeastRecentlyFailedNodeSelector() *LeastRecentlyFailedNodeSelector {
+	return &LeastRecentlyFailedNodeSelector{
+		lastFailure: make(map[AccountID]time.Time),
+	}
+}
+
+func (selector *LeastRecentlyFailedNodeSelector) Select(candidates []NodeCandidate, count int) []AccountID {
+	candidates = _HealthyNodeCandidates(candidates)
+	_ShuffleNodeCandidates(candidates)
+
+	selector.mutex.Lock()
+	defer selector.mutex.Unlock()
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return selector.lastFailure[_NodeSelectorKey(candidates[i].AccountID)].Before(selector.lastFailure[_NodeSelectorKey(candidates[j].AccountID)])
+	})
+
+	return _NodeCandidateAccountIDs(candidates, count)
+}
+
+func (selector *LeastRecentlyFailedNodeSelector) Observe(observation NodeObservation) {
+	if !observation.Failed {
+		return
+	}
+
+	selector.mutex.Lock()
+	defer selector.mutex.Unlock()
+
+	selector.lastFailure[_NodeSelectorKey(observation.AccountID)] = time.Now()
+}
+
+// EwmaLatencyNodeSelector picks healthy nodes at random, weighted by the inverse of an exponentially
+// weighted moving average of their observed latency, so faster nodes receive most of the traffic
+// while slower ones are still probed occasionally. A failed call counts as a call taking the
+// penalty latency. Nodes without observations are treated as being as fast as the fastest node.
+type EwmaLatencyNodeSelector struct {
+	mutex   sync.Mutex
+	alpha   float64
+	penalty time.Duration
+	latency map[AccountID]float64
+}
+
+// NewEwmaLatencyNodeSelector creates an EwmaLatencyNodeSelector. alpha, between 0 and 1, is the
+// weight given to each new observation; values outside that range fall back to 0.3.
+func NewEwmaLatencyNodeSelector(alpha float64) *EwmaLatencyNodeSelector {
+	if alpha <= 0 || alpha > 1 {
+		alpha = 0.3
+	}
+
+	return &EwmaLatencyNodeSelector{
+		alpha:   alpha,
+		penalty: 10 * time.Second,
+		latency: make(map[AccountID]float64),
+	}
+}
+
+// SetFailurePenalty sets the latency recorded for a failed call. Defaults to 10 seconds.
+func (selector *EwmaLatencyNodeSelector) SetFailurePenalty(penalty time.Duration) *EwmaLatencyNodeSelector {
+	selector.mutex.Lock()
+	defer selector.mutex.Unlock()
+
+	selector.penalty = penalty
+	return selector
+}
+
+func (selector *EwmaLatencyNodeSelector) GetFailurePenalty() time.Duration {
+	selector.mutex.Lock()
+	defer selector.mutex.Unlock()
+
+	return selector.penalty
+}
+
+// GetLatency returns the current moving average latency of a node, and whether it has been observed.
+func (selector *EwmaLatencyNodeSelector) GetLatency(accountID AccountID) (time.Duration, bool) {
+	selector.mutex.Lock()
+	defer selector.mutex.Unlock()
+
+	latency, ok := selector.latency[_NodeSelectorKey(accountID)]
+	return time.Duration(latency), ok
+}
+
+func (selector *EwmaLatencyNodeSelector) Select(candidates []NodeCandidate, count int) []AccountID {
+	candidates = _HealthyNodeCandidates(candidates)
+
+	selector.mutex.Lock()
+	fastest := math.MaxFloat64
+	for _, candidate := range candidates {
+		if latency, ok := selector.latency[_NodeSelectorKey(candidate.AccountID)]; ok && latency < fastest {
+			fastest = latency
+		}
+	}
+
+	weights := make([]float64, len(candidates))
+	for i, candidate := range candidates {
+		latency, ok := selector.latency[_NodeSelectorKey(candidate.AccountID)]
+		if !ok {
+			latency = fastest
+		}
+		// Floor at a microsecond so a node observed at zero latency doesn't take every request
+		weights[i] = 1 / math.Max(latency, float64(time.Microsecond))
+	}
+	selector.mutex.Unlock()
+
+	selected := make([]AccountID, 0, count)
+	for len(selected) < count && len(candidates) > 0 {
+		index := _WeightedRandomIndex(weights)
+		selected = append(selected, candidates[index].AccountID)
+
+		candidates = append(candidates[:index], candidates[index+1:]...)
+		weights = append(weights[:index], weights[index+1:]...)
+	}
+
+	return selected
+}
+
+func (selector *EwmaLatencyNodeSelector) Observe(observation NodeObservation) {
+	selector.mutex.Lock()
+	defer selector.mutex.Unlock()
+
+	sample := float64(observation.Latency)
+	if observation.Failed {
+		sample = float64(selector.penalty)
+	} else if observation.Latency <= 0 {
+		return
+	}
+
+	key := _NodeSelectorKey(observation.AccountID)
+	if latency, ok := selector.latency[key]; ok {
+		selector.latency[key] = selector.alpha*sample + (1-selector.alpha)*latency
+	} else {
+		selector.latency[key] = sample
+	}
+}
+
+// _HealthyNodeCandidates returns a copy of the healthy candidates, or of every candidate if none are healthy
+func _HealthyNodeCandidates(candidates []NodeCandidate) []NodeCandidate {
+	healthy := make([]NodeCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		if candidate.Healthy {
+			healthy = append(healthy, candidate)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return append(healthy, candidates...)
+	}
+
+	return healthy
+}
+
+func _SortNodeCandidates(candidates []NodeCandidate) {
+	sort.Slice(candidates, func(i, j int) bool {
+		return _AccountIDLess(candidates[i].AccountID, candidates[j].AccountID)
+	})
+}
+
+func _ShuffleNodeCandidates(candidates []NodeCandidate) {
+	for i := len(candidates) - 1; i > 0; i-- {
+		j := _RandomIndex(i + 1)
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	}
+}
+
+func _NodeCandidateAccountIDs(candidates []NodeCandidate, count int) []AccountID {
+	if count > len(candidates) {
+		count = len(candidates)
+	}
+
+	accountIDs := make([]AccountID, 0, count)
+	for _, candidate := range candidates[:count] {
+		accountIDs = append(accountIDs, candidate.AccountID)
+	}
+
+	return accountIDs
+}
+
+func _WeightedRandomIndex(weights []float64) int {
+	total := 0.0
+	for _, weight := range weights {
+		total += weight
+	}
+
+	// Resolution of one in a billion is plenty to pick between a handful of nodes
+	target := float64(_RandomIndex(1_000_000_000)) / 1_000_000_000 * total
+	for i, weight := range weights {
+		if target < weight {
+			return i
+		}
+		target -= weight
+	}
+
+	return len(weights) - 1
+}
+
+func _RandomIndex(n int) int {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0
+	}
+
+	return int(index.Int64())
+}
+
+func _AccountIDLess(a AccountID, b AccountID) bool {
+	if a.Shard != b.Shard {
+		return a.Shard < b.Shard
+	}
+	if a.Realm != b.Realm {
+		return a.Realm < b.Realm
+	}
+
+	return a.Account < b.Account
+}
+
+// _NodeSelectorKey strips the alias and checksum from a node account ID so it can be used as a map key
+func _NodeSelectorKey(accountID AccountID) AccountID {
+	return AccountID{Shard: accountID.Shard, Realm: accountID.Realm, Account: accountID.Account}
+}
@@ -0,0 +1,237 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func _TestNodeCandidates() []NodeCandidate {
+	return []NodeCandidate{
+		{AccountID: AccountID{Account: 5}, Healthy: true},
+		{AccountID: AccountID{Account: 3}, Healthy: true},
+		{AccountID: AccountID{Account: 6}, Healthy: false},
+		{AccountID: AccountID{Account: 4}, Healthy: true},
+	}
+}
+
+func TestUnitRandomNodeSelector(t *testing.T) {
+	selector := NewRandomNodeSelector()
+
+	for i := 0; i < 20; i++ {
+		selected := selector.Select(_TestNodeCandidates(), 10)
+		require.Equal(t, 3, len(selected))
+		assert.NotContains(t, selected, AccountID{Account: 6})
+	}
+
+	selected := selector.Select([]NodeCandidate{{AccountID: AccountID{Account: 6}}}, 1)
+	assert.Equal(t, []AccountID{{Account: 6}}, selected)
+}
+
+func TestUnitRoundRobinNodeSelector(t *testing.T) {
+	selector := NewRoundRobinNodeSelector()
+
+	assert.Equal(t, []AccountID{{Account: 3}}, selector.Select(_TestNodeCandidates(), 1))
+	assert.Equal(t, []AccountID{{Account: 4}}, selector.Select(_TestNodeCandidates(), 1))
+	assert.Equal(t, []AccountID{{Account: 5}, {Account: 3}}, selector.Select(_TestNodeCandidates(), 2))
+	assert.Equal(t, []AccountID{{Account: 3}}, selector.Select(_TestNodeCandidates(), 1))
+}
+
+func TestUnitLeastRecentlyFailedNodeSelector(t *testing.T) {
+	selector := NewLeastRecentlyFailedNodeSelector()
+
+	selector.Observe(NodeObservation{AccountID: AccountID{Account: 3}, Failed: true})
+	time.Sleep(time.Millisecond)
+	selector.Observe(NodeObservation{AccountID: AccountID{Account: 5}, Failed: true})
+	selector.Observe(NodeObservation{AccountID: AccountID{Account: 4}, Latency: time.Second})
+
+	assert.Equal(t, []AccountID{{Account: 4}, {Account: 3}, {Account: 5}}, selector.Select(_TestNodeCandidates(), 3))
+}
+
+func TestUnitEwmaLatencyNodeSelector(t *testing.T) {
+	selector := NewEwmaLatencyNodeSelector(0.5)
+
+	selector.Observe(NodeObservation{AccountID: AccountID{Account: 3}, Latency: 10 * time.Millisecond})
+	selector.Observe(NodeObservation{AccountID: AccountID{Account: 3}, Latency: 30 * time.Millisecond})
+	selector.Observe(NodeObservation{AccountID: AccountID{Account: 4}, Latency: time.Second})
+	selector.Observe(NodeObservation{AccountID: AccountID{Account: 5}, Failed: true})
+
+	latency, ok := selector.GetLatency(AccountID{Account: 3})
+	require.True(t, ok)
+	assert.Equal(t, 20*time.Millisecond, latency)
+
+	latency, ok = selector.GetLatency(AccountID{Account: 5})
+	require.True(t, ok)
+	assert.Equal(t, selector.GetFailurePenalty(), latency)
+
+	_, ok = selector.GetLatency(AccountID{Account: 6})
+	assert.False(t, ok)
+
+	fastest := 0
+	for i := 0; i < 200; i++ {
+		selected := selector.Select(_TestNodeCandidates(), 3)
+		require.Equal(t, 3, len(selected))
+		if selected[0] == (AccountID{Account: 3}) {
+			fastest++
+		}
+	}
+
+	// Node 3 carries about 98% of the weight
+	assert.Greater(t, fastest, 150)
+}
+
+func TestUnitNetworkPinnedAndExcludedNodes(t *testing.T) {
+	network := _NewNetwork()
+	err := network.SetNetwork(map[string]AccountID{
+		"127.0.0.1:50211": {Account: 3},
+		"127.0.0.1:50212": {Account: 4},
+		"127.0.0.1:50213": {Account: 5},
+	})
+	require.NoError(t, err)
+
+	network._SetPinnedNodes([]AccountID{{Account: 3}, {Account: 4}})
+	network._SetExcludedNodes([]AccountID{{Account: 3}})
+
+	for i := 0; i < 10; i++ {
+		node, err := network._GetNode()
+		require.NoError(t, err)
+		assert.Equal(t, AccountID{Account: 4}, node.accountID)
+	}
+	assert.Equal(t, []AccountID{{Account: 4}}, network._GetNodeAccountIDsForExecute())
+
+	network._SetPinnedNodes(nil)
+	network._SetNodeSelector(NewRoundRobinNodeSelector())
+	for _, expected := range []AccountID{{Account: 4}, {Account: 5}} {
+		node, err := network._GetNode()
+		require.NoError(t, err)
+		assert.Equal(t, expected, node.accountID)
+	}
+
+	network._SetExcludedNodes([]AccountID{{Account: 3}, {Account: 4}, {Account: 5}})
+	assert.Empty(t, network._GetNodeAccountIDsForExecute())
+	_, err = network._GetNode()
+	assert.Equal(t, errNoNodeAllowed, err)
+}
+
+// _CountingNodeSelector has no locking of its own and relies on the network to serialize its calls
+type _CountingNodeSelector struct {
+	selected int
+	observed int
+}
+
+func (selector *_CountingNodeSelector) Select(candidates []NodeCandidate, count int) []AccountID {
+	selector.selected++
+	return []AccountID{candidates[0].AccountID}
+}
+
+func (selector *_CountingNodeSelector) Observe(observation NodeObservation) {
+	selector.observed++
+}
+
+func TestUnitNetworkNodeSelectorIsNotCalledConcurrently(t *testing.T) {
+	network := _NewNetwork()
+	err := network.SetNetwork(map[string]AccountID{
+		"127.0.0.1:50211": {Account: 3},
+		"127.0.0.1:50212": {Account: 4},
+	})
+	require.NoError(t, err)
+
+	selector := &_CountingNodeSelector{}
+	network._SetNodeSelector(selector)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				node, err := network._GetNode()
+				require.NoError(t, err)
+				network._ObserveNode(node, time.Millisecond, false)
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				network._SetNodeSelector(network._GetNodeSelector())
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 400, selector.selected)
+	assert.Equal(t, 400, selector.observed)
+}
+
+func TestUnitClientPinnedNode(t *testing.T) {
+	responses := [][]interface{}{
+		{_MockAccountBalanceResponse(3000)},
+		{_MockAccountBalanceResponse(4000)},
+	}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.SetPinnedNodeAccountIDs([]AccountID{{Account: 4}})
+	assert.Equal(t, []AccountID{{Account: 4}}, client.GetPinnedNodeAccountIDs())
+
+	balance, err := NewAccountBalanceQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.NoError(t, err)
+	assert.Equal(t, HbarFromTinybar(4000), balance.Hbars)
+}
+
+func TestUnitClientNoNodeAllowed(t *testing.T) {
+	client, server := NewMockClientAndServer([][]interface{}{{}, {}})
+	defer server.Close()
+
+	// pinned to a node the network doesn't have
+	client.SetPinnedNodeAccountIDs([]AccountID{{Account: 99}})
+
+	_, err := NewAccountBalanceQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	assert.Equal(t, errNoNodeAllowed, err)
+
+	_, err = NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 1800}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		Execute(client)
+	assert.Equal(t, errNoNodeAllowed, err)
+
+	// every node excluded
+	client.SetPinnedNodeAccountIDs(nil)
+	client.SetExcludedNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}})
+
+	_, err = NewAccountBalanceQuery().
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	assert.Equal(t, errNoNodeAllowed, err)
+}
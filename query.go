@@ -41,6 +41,7 @@ type Query struct {
 	paymentTransactions []*services.Transaction
 
 	isPaymentRequired bool
+	retryPolicy       RetryPolicy
 
 	maxBackoff   *time.Duration
 	minBackoff   *time.Duration
@@ -119,8 +120,19 @@ func (this *Query) SetMaxRetry(count int) *Query {
 	return this
 }
 
-func _QueryShouldRetry(logID string, status Status) _ExecutionState {
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("query precheck status received")
+// GetRetryPolicy returns the RetryPolicy set on this query, or nil if the Client's is used.
+func (this *Query) GetRetryPolicy() RetryPolicy {
+	return this.retryPolicy
+}
+
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (this *Query) SetRetryPolicy(policy RetryPolicy) *Query {
+	this.retryPolicy = policy
+	return this
+}
+
+func _QueryShouldRetry(logger Logger, logID string, status Status) _ExecutionState {
+	logger.Trace("query precheck status received", "requestId", logID, "status", status.String())
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusBusy:
 		return executionStateRetry
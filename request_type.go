@@ -22,6 +22,9 @@ package hedera
 
 import (
 	"fmt"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	protobuf "google.golang.org/protobuf/proto"
 )
 
 type RequestType uint32
@@ -146,6 +149,26 @@ const (
 	RequestTypeScheduleSign RequestType = 72
 	// Get Scheduled Transaction Information
 	RequestTypeScheduleGetInfo RequestType = 73
+	// Get Account Details
+	RequestTypeTokenGetAccountNftInfos RequestType = 74
+	// Get NFT Info
+	RequestTypeTokenGetNftInfo RequestType = 75
+	// Get NFT Infos
+	RequestTypeTokenGetNftInfos RequestType = 76
+	// Update a token's custom fee schedule
+	RequestTypeTokenFeeScheduleUpdate RequestType = 77
+	// Get execution time(s) by TransactionID
+	RequestTypeNetworkGetExecutionTime RequestType = 78
+	// Pause the Token
+	RequestTypeTokenPause RequestType = 79
+	// Unpause the Token
+	RequestTypeTokenUnpause RequestType = 80
+	// Approve allowance for a spender relative to the payer account
+	RequestTypeCryptoApproveAllowance RequestType = 81
+	// Deletes granted allowances on owner account
+	RequestTypeCryptoDeleteAllowance RequestType = 82
+	// Submit a raw Ethereum transaction
+	RequestTypeEthereumTransaction RequestType = 84
 )
 
 // String() returns a string representation of the status
@@ -271,7 +294,206 @@ func (requestType RequestType) String() string { // nolint
 		return "SCHEDULE_SIGN"
 	case RequestTypeScheduleGetInfo:
 		return "SCHEDULE_GET_INFO"
+	case RequestTypeTokenGetAccountNftInfos:
+		return "TOKEN_GET_ACCOUNT_NFT_INFOS"
+	case RequestTypeTokenGetNftInfo:
+		return "TOKEN_GET_NFT_INFO"
+	case RequestTypeTokenGetNftInfos:
+		return "TOKEN_GET_NFT_INFOS"
+	case RequestTypeTokenFeeScheduleUpdate:
+		return "TOKEN_FEE_SCHEDULE_UPDATE"
+	case RequestTypeNetworkGetExecutionTime:
+		return "NETWORK_GET_EXECUTION_TIME"
+	case RequestTypeTokenPause:
+		return "TOKEN_PAUSE"
+	case RequestTypeTokenUnpause:
+		return "TOKEN_UNPAUSE"
+	case RequestTypeCryptoApproveAllowance:
+		return "CRYPTO_APPROVE_ALLOWANCE"
+	case RequestTypeCryptoDeleteAllowance:
+		return "CRYPTO_DELETE_ALLOWANCE"
+	case RequestTypeEthereumTransaction:
+		return "ETHEREUM_TRANSACTION"
 	}
 
 	panic(fmt.Sprintf("unreachable: RequestType.String() switch statement is non-exhaustive. RequestType: %v", uint32(requestType)))
 }
+
+// _RequestTypeForProtobuf returns the RequestType of a `*services.Transaction` or `*services.Query`
+// about to be sent to a node, or RequestTypeNone if it can't be determined.
+func _RequestTypeForProtobuf(request interface{}) RequestType {
+	switch req := request.(type) {
+	case *services.Transaction:
+		return _RequestTypeForTransaction(req)
+	case *services.Query:
+		return _RequestTypeForQuery(req)
+	}
+
+	return RequestTypeNone
+}
+
+func _RequestTypeForTransaction(transaction *services.Transaction) RequestType {
+	bodyBytes := transaction.GetBodyBytes() // nolint
+	if len(transaction.GetSignedTransactionBytes()) > 0 {
+		var signedTransaction services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.GetSignedTransactionBytes(), &signedTransaction); err != nil {
+			return RequestTypeNone
+		}
+		bodyBytes = signedTransaction.GetBodyBytes()
+	}
+
+	var body services.TransactionBody
+	if err := protobuf.Unmarshal(bodyBytes, &body); err != nil {
+		return RequestTypeNone
+	}
+
+	return _RequestTypeForTransactionBody(&body)
+}
+
+func _RequestTypeForTransactionBody(body *services.TransactionBody) RequestType {
+	switch body.Data.(type) {
+	case *services.TransactionBody_ContractCall:
+		return RequestTypeContractCall
+	case *services.TransactionBody_ContractCreateInstance:
+		return RequestTypeContractCreate
+	case *services.TransactionBody_ContractUpdateInstance:
+		return RequestTypeContractUpdate
+	case *services.TransactionBody_ContractDeleteInstance:
+		return RequestTypeContractDelete
+	case *services.TransactionBody_CryptoAddLiveHash:
+		return RequestTypeCryptoAddLiveHash
+	case *services.TransactionBody_CryptoApproveAllowance:
+		return RequestTypeCryptoApproveAllowance
+	case *services.TransactionBody_CryptoDeleteAllowance:
+		return RequestTypeCryptoDeleteAllowance
+	case *services.TransactionBody_CryptoCreateAccount:
+		return RequestTypeCryptoCreate
+	case *services.TransactionBody_CryptoDelete:
+		return RequestTypeCryptoDelete
+	case *services.TransactionBody_CryptoDeleteLiveHash:
+		return RequestTypeCryptoDeleteLiveHash
+	case *services.TransactionBody_CryptoTransfer:
+		return RequestTypeCryptoTransfer
+	case *services.TransactionBody_CryptoUpdateAccount:
+		return RequestTypeCryptoUpdate
+	case *services.TransactionBody_FileAppend:
+		return RequestTypeFileAppend
+	case *services.TransactionBody_FileCreate:
+		return RequestTypeFileCreate
+	case *services.TransactionBody_FileDelete:
+		return RequestTypeFileDelete
+	case *services.TransactionBody_FileUpdate:
+		return RequestTypeFileUpdate
+	case *services.TransactionBody_SystemDelete:
+		return RequestTypeSystemDelete
+	case *services.TransactionBody_SystemUndelete:
+		return RequestTypeSystemUndelete
+	case *services.TransactionBody_Freeze:
+		return RequestTypeFreeze
+	case *services.TransactionBody_ConsensusCreateTopic:
+		return RequestTypeConsensusCreateTopic
+	case *services.TransactionBody_ConsensusUpdateTopic:
+		return RequestTypeConsensusUpdateTopic
+	case *services.TransactionBody_ConsensusDeleteTopic:
+		return RequestTypeConsensusDeleteTopic
+	case *services.TransactionBody_ConsensusSubmitMessage:
+		return RequestTypeConsensusSubmitMessage
+	case *services.TransactionBody_UncheckedSubmit:
+		return RequestTypeUncheckedSubmit
+	case *services.TransactionBody_TokenCreation:
+		return RequestTypeTokenCreate
+	case *services.TransactionBody_TokenFreeze:
+		return RequestTypeTokenFreezeAccount
+	case *services.TransactionBody_TokenUnfreeze:
+		return RequestTypeTokenUnfreezeAccount
+	case *services.TransactionBody_TokenGrantKyc:
+		return RequestTypeTokenGrantKycToAccount
+	case *services.TransactionBody_TokenRevokeKyc:
+		return RequestTypeTokenRevokeKycFromAccount
+	case *services.TransactionBody_TokenDeletion:
+		return RequestTypeTokenDelete
+	case *services.TransactionBody_TokenUpdate:
+		return RequestTypeTokenUpdate
+	case *services.TransactionBody_TokenMint:
+		return RequestTypeTokenMint
+	case *services.TransactionBody_TokenBurn:
+		return RequestTypeTokenBurn
+	case *services.TransactionBody_TokenWipe:
+		return RequestTypeTokenAccountWipe
+	case *services.TransactionBody_TokenAssociate:
+		return RequestTypeTokenAssociateToAccount
+	case *services.TransactionBody_TokenDissociate:
+		return RequestTypeTokenDissociateFromAccount
+	case *services.TransactionBody_TokenFeeScheduleUpdate:
+		return RequestTypeTokenFeeScheduleUpdate
+	case *services.TransactionBody_TokenPause:
+		return RequestTypeTokenPause
+	case *services.TransactionBody_TokenUnpause:
+		return RequestTypeTokenUnpause
+	case *services.TransactionBody_ScheduleCreate:
+		return RequestTypeScheduleCreate
+	case *services.TransactionBody_ScheduleDelete:
+		return RequestTypeScheduleDelete
+	case *services.TransactionBody_ScheduleSign:
+		return RequestTypeScheduleSign
+	case nil:
+		if ethereum, _ := _GetEthereumTransactionBody(body); ethereum != nil {
+			return RequestTypeEthereumTransaction
+		}
+	}
+
+	return RequestTypeNone
+}
+
+func _RequestTypeForQuery(query *services.Query) RequestType {
+	switch query.Query.(type) {
+	case *services.Query_GetByKey:
+		return RequestTypeGetByKey
+	case *services.Query_GetBySolidityID:
+		return RequestTypeGetBySolidityID
+	case *services.Query_ContractCallLocal:
+		return RequestTypeContractCallLocal
+	case *services.Query_ContractGetInfo:
+		return RequestTypeContractGetInfo
+	case *services.Query_ContractGetBytecode:
+		return RequestTypeContractGetBytecode
+	case *services.Query_ContractGetRecords:
+		return RequestTypeContractGetRecords
+	case *services.Query_CryptogetAccountBalance:
+		return RequestTypeCryptoGetAccountBalance
+	case *services.Query_CryptoGetAccountRecords:
+		return RequestTypeCryptoGetAccountRecords
+	case *services.Query_CryptoGetInfo:
+		return RequestTypeCryptoGetInfo
+	case *services.Query_CryptoGetLiveHash:
+		return RequestTypeCryptoGetLiveHash
+	case *services.Query_CryptoGetProxyStakers:
+		return RequestTypeCryptoGetStakers
+	case *services.Query_FileGetContents:
+		return RequestTypeFileGetContents
+	case *services.Query_FileGetInfo:
+		return RequestTypeFileGetInfo
+	case *services.Query_TransactionGetReceipt:
+		return RequestTypeTransactionGetReceipt
+	case *services.Query_TransactionGetRecord, *services.Query_TransactionGetFastRecord:
+		return RequestTypeTransactionGetRecord
+	case *services.Query_ConsensusGetTopicInfo:
+		return RequestTypeConsensusGetTopicInfo
+	case *services.Query_NetworkGetVersionInfo:
+		return RequestTypeGetVersionInfo
+	case *services.Query_TokenGetInfo:
+		return RequestTypeTokenGetInfo
+	case *services.Query_ScheduleGetInfo:
+		return RequestTypeScheduleGetInfo
+	case *services.Query_TokenGetAccountNftInfos:
+		return RequestTypeTokenGetAccountNftInfos
+	case *services.Query_TokenGetNftInfo:
+		return RequestTypeTokenGetNftInfo
+	case *services.Query_TokenGetNftInfos:
+		return RequestTypeTokenGetNftInfos
+	case *services.Query_NetworkGetExecutionTime:
+		return RequestTypeNetworkGetExecutionTime
+	}
+
+	return RequestTypeNone
+}
@@ -0,0 +1,227 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"crypto/rand"
+	"math"
+	"math/big"
+	"time"
+)
+
+// RetryDecision is the outcome a RetryPolicy chooses for a failed attempt.
+type RetryDecision uint32
+
+const (
+	// RetryDecisionDefault defers to the SDK's built in handling of the error or status.
+	RetryDecisionDefault RetryDecision = iota
+	// RetryDecisionRetry retries the request, after the policy's delay, on the next node.
+	RetryDecisionRetry
+	// RetryDecisionFail stops executing and returns the error to the caller.
+	RetryDecisionFail
+	// RetryDecisionRegenerateTransactionID retries a transaction with a newly generated
+	// transaction ID, as is done by default for `TRANSACTION_EXPIRED`, after either an error or a
+	// status. Queries, and transactions whose ID was set or can't be regenerated, fail instead.
+	RetryDecisionRegenerateTransactionID
+)
+
+func (decision RetryDecision) String() string {
+	switch decision {
+	case RetryDecisionDefault:
+		return "DEFAULT"
+	case RetryDecisionRetry:
+		return "RETRY"
+	case RetryDecisionFail:
+		return "FAIL"
+	case RetryDecisionRegenerateTransactionID:
+		return "REGENERATE_TRANSACTION_ID"
+	}
+
+	panic("unreachable: RetryDecision.String() switch statement is non-exhaustive")
+}
+
+// RetryPolicy decides whether a transaction or query is retried and how long to wait
+// before doing so. A policy can be set on the Client and overridden per transaction or query.
+// Policies may be shared between concurrent executions and must not keep per-request state.
+type RetryPolicy interface {
+	// ShouldRetryError is called when the gRPC call to a node failed. Returning
+	// RetryDecisionDefault retries on `ResourceExhausted`, `Unavailable` and RST_STREAM errors.
+	ShouldRetryError(attempt int64, err error) RetryDecision
+	// ShouldRetryStatus is called when a node answered with a status other than success.
+	// Returning RetryDecisionDefault keeps the request type's built in handling.
+	ShouldRetryStatus(attempt int64, status Status) RetryDecision
+	// Delay returns how long to wait before retrying after the given (zero based) attempt.
+	Delay(attempt int64, minBackoff time.Duration, maxBackoff time.Duration) time.Duration
+}
+
+// _DefaultRetryPolicy is used when neither the request nor the client has a policy set;
+// it keeps the SDK's historical behavior of un-jittered exponential backoff.
+type _DefaultRetryPolicy struct{}
+
+func (_DefaultRetryPolicy) ShouldRetryError(int64, error) RetryDecision {
+	return RetryDecisionDefault
+}
+
+func (_DefaultRetryPolicy) ShouldRetryStatus(int64, Status) RetryDecision {
+	return RetryDecisionDefault
+}
+
+func (_DefaultRetryPolicy) Delay(attempt int64, minBackoff time.Duration, maxBackoff time.Duration) time.Duration {
+	return _ExponentialBackoff(attempt, minBackoff, maxBackoff)
+}
+
+// JitteredExponentialRetryPolicy waits a random duration between zero and the exponential
+// backoff for the attempt ("full jitter"), so concurrent clients don't retry in lockstep.
+type JitteredExponentialRetryPolicy struct {
+	statuses map[Status]RetryDecision
+}
+
+func NewJitteredExponentialRetryPolicy() *JitteredExponentialRetryPolicy {
+	return &JitteredExponentialRetryPolicy{
+		statuses: make(map[Status]RetryDecision),
+	}
+}
+
+// SetStatusDecision overrides the decision taken when a node answers with status.
+func (policy *JitteredExponentialRetryPolicy) SetStatusDecision(status Status, decision RetryDecision) *JitteredExponentialRetryPolicy {
+	policy.statuses[status] = decision
+	return policy
+}
+
+func (policy *JitteredExponentialRetryPolicy) ShouldRetryError(int64, error) RetryDecision {
+	return RetryDecisionDefault
+}
+
+func (policy *JitteredExponentialRetryPolicy) ShouldRetryStatus(_ int64, status Status) RetryDecision {
+	return policy.statuses[status]
+}
+
+func (policy *JitteredExponentialRetryPolicy) Delay(attempt int64, minBackoff time.Duration, maxBackoff time.Duration) time.Duration {
+	backoff := _ExponentialBackoff(attempt, minBackoff, maxBackoff)
+	if backoff <= 0 {
+		return 0
+	}
+
+	jitter, err := rand.Int(rand.Reader, big.NewInt(int64(backoff)+1))
+	if err != nil {
+		return backoff
+	}
+
+	return time.Duration(jitter.Int64())
+}
+
+// FixedBudgetRetryPolicy retries with a constant delay until the time spent waiting would
+// exceed its budget, at which point the last error is returned.
+type FixedBudgetRetryPolicy struct {
+	delay    time.Duration
+	budget   time.Duration
+	statuses map[Status]RetryDecision
+}
+
+func NewFixedBudgetRetryPolicy(delay time.Duration, budget time.Duration) *FixedBudgetRetryPolicy {
+	if delay < 0 || budget < 0 {
+		panic("delay and budget must be positive durations")
+	}
+
+	return &FixedBudgetRetryPolicy{
+		delay:    delay,
+		budget:   budget,
+		statuses: make(map[Status]RetryDecision),
+	}
+}
+
+// SetStatusDecision overrides the decision taken when a node answers with status.
+func (policy *FixedBudgetRetryPolicy) SetStatusDecision(status Status, decision RetryDecision) *FixedBudgetRetryPolicy {
+	policy.statuses[status] = decision
+	return policy
+}
+
+func (policy *FixedBudgetRetryPolicy) GetDelay() time.Duration {
+	return policy.delay
+}
+
+func (policy *FixedBudgetRetryPolicy) GetBudget() time.Duration {
+	return policy.budget
+}
+
+func (policy *FixedBudgetRetryPolicy) _Exhausted(attempt int64) bool {
+	return time.Duration(attempt+1)*policy.delay > policy.budget
+}
+
+func (policy *FixedBudgetRetryPolicy) ShouldRetryError(attempt int64, _ error) RetryDecision {
+	if policy._Exhausted(attempt) {
+		return RetryDecisionFail
+	}
+
+	return RetryDecisionDefault
+}
+
+func (policy *FixedBudgetRetryPolicy) ShouldRetryStatus(attempt int64, status Status) RetryDecision {
+	decision := policy.statuses[status]
+	if decision != RetryDecisionFail && policy._Exhausted(attempt) {
+		return RetryDecisionFail
+	}
+
+	return decision
+}
+
+func (policy *FixedBudgetRetryPolicy) Delay(int64, time.Duration, time.Duration) time.Duration {
+	return policy.delay
+}
+
+func _ExponentialBackoff(attempt int64, minBackoff time.Duration, maxBackoff time.Duration) time.Duration {
+	// 0.25s, 0.5s, 1s, 2s, ...
+	return time.Duration(math.Min(float64(minBackoff)*math.Pow(2, float64(attempt)), float64(maxBackoff)))
+}
+
+// _GetRetryPolicy returns the policy set on the request, falling back to the client's
+func _GetRetryPolicy(client *Client, request interface{}) RetryPolicy {
+	switch request := request.(type) {
+	case *Transaction:
+		if request.retryPolicy != nil {
+			return request.retryPolicy
+		}
+	case *Query:
+		if request.retryPolicy != nil {
+			return request.retryPolicy
+		}
+	}
+
+	if client != nil && client.retryPolicy != nil {
+		return client.retryPolicy
+	}
+
+	return _DefaultRetryPolicy{}
+}
+
+// _ApplyRetryDecision maps a policy decision onto the execution state chosen by the request type
+func _ApplyRetryDecision(decision RetryDecision, state _ExecutionState) _ExecutionState {
+	switch decision {
+	case RetryDecisionRetry:
+		return executionStateRetry
+	case RetryDecisionFail:
+		return executionStateError
+	case RetryDecisionRegenerateTransactionID:
+		return executionStateExpired
+	default:
+		return state
+	}
+}
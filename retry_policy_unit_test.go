@@ -0,0 +1,167 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func TestUnitRetryPolicyDefaultDelay(t *testing.T) {
+	policy := _DefaultRetryPolicy{}
+
+	assert.Equal(t, 250*time.Millisecond, policy.Delay(0, 250*time.Millisecond, 8*time.Second))
+	assert.Equal(t, 1*time.Second, policy.Delay(2, 250*time.Millisecond, 8*time.Second))
+	assert.Equal(t, 8*time.Second, policy.Delay(10, 250*time.Millisecond, 8*time.Second))
+}
+
+func TestUnitRetryPolicyJitteredExponentialDelay(t *testing.T) {
+	policy := NewJitteredExponentialRetryPolicy()
+
+	for attempt := int64(0); attempt < 8; attempt++ {
+		delay := policy.Delay(attempt, 250*time.Millisecond, 8*time.Second)
+		assert.True(t, delay >= 0)
+		assert.True(t, delay <= _ExponentialBackoff(attempt, 250*time.Millisecond, 8*time.Second))
+	}
+
+	assert.Equal(t, time.Duration(0), policy.Delay(3, 0, 0))
+}
+
+func TestUnitRetryPolicyJitteredExponentialStatusDecision(t *testing.T) {
+	policy := NewJitteredExponentialRetryPolicy().
+		SetStatusDecision(StatusBusy, RetryDecisionFail)
+
+	assert.Equal(t, RetryDecisionFail, policy.ShouldRetryStatus(0, StatusBusy))
+	assert.Equal(t, RetryDecisionDefault, policy.ShouldRetryStatus(0, StatusPlatformTransactionNotCreated))
+	assert.Equal(t, RetryDecisionDefault, policy.ShouldRetryError(0, errors.New("error")))
+}
+
+func TestUnitRetryPolicyFixedBudget(t *testing.T) {
+	policy := NewFixedBudgetRetryPolicy(time.Second, 3*time.Second).
+		SetStatusDecision(StatusPlatformTransactionNotCreated, RetryDecisionRetry)
+
+	assert.Equal(t, time.Second, policy.Delay(5, 0, 0))
+	assert.Equal(t, RetryDecisionRetry, policy.ShouldRetryStatus(0, StatusPlatformTransactionNotCreated))
+	assert.Equal(t, RetryDecisionDefault, policy.ShouldRetryStatus(2, StatusBusy))
+	assert.Equal(t, RetryDecisionFail, policy.ShouldRetryStatus(3, StatusPlatformTransactionNotCreated))
+	assert.Equal(t, RetryDecisionFail, policy.ShouldRetryError(3, errors.New("error")))
+}
+
+func TestUnitRetryPolicyApplyDecision(t *testing.T) {
+	assert.Equal(t, executionStateError, _ApplyRetryDecision(RetryDecisionDefault, executionStateError))
+	assert.Equal(t, executionStateRetry, _ApplyRetryDecision(RetryDecisionRetry, executionStateError))
+	assert.Equal(t, executionStateError, _ApplyRetryDecision(RetryDecisionFail, executionStateRetry))
+	assert.Equal(t, executionStateExpired, _ApplyRetryDecision(RetryDecisionRegenerateTransactionID, executionStateRetry))
+}
+
+func TestUnitRetryPolicyPrecedence(t *testing.T) {
+	client := ClientForNetwork(map[string]AccountID{})
+	assert.Equal(t, _DefaultRetryPolicy{}, _GetRetryPolicy(client, &NewAccountBalanceQuery().Query))
+
+	clientPolicy := NewJitteredExponentialRetryPolicy()
+	client.SetRetryPolicy(clientPolicy)
+	assert.Equal(t, clientPolicy, _GetRetryPolicy(client, &NewAccountBalanceQuery().Query))
+
+	queryPolicy := NewFixedBudgetRetryPolicy(0, 0)
+	query := NewAccountBalanceQuery().SetRetryPolicy(queryPolicy)
+	assert.Equal(t, queryPolicy, _GetRetryPolicy(client, &query.Query))
+	assert.Equal(t, queryPolicy, query.GetRetryPolicy())
+}
+
+func TestUnitMockRetryPolicyFailsOnBusy(t *testing.T) {
+	busy := &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY, ResponseType: services.ResponseType_ANSWER_ONLY},
+			},
+		},
+	}
+	responses := [][]interface{}{{busy, busy}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	client.SetRetryPolicy(NewJitteredExponentialRetryPolicy().SetStatusDecision(StatusBusy, RetryDecisionFail))
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.Error(t, err)
+
+	var precheck ErrHederaPreCheckStatus
+	require.True(t, errors.As(err, &precheck))
+	assert.Equal(t, StatusBusy, precheck.Status)
+}
+
+type _RegenerateOnErrorRetryPolicy struct {
+	_DefaultRetryPolicy
+}
+
+func (_RegenerateOnErrorRetryPolicy) ShouldRetryError(int64, error) RetryDecision {
+	return RetryDecisionRegenerateTransactionID
+}
+
+func TestUnitMockRetryPolicyRegeneratesOnError(t *testing.T) {
+	var sentTransactionID TransactionID
+	call := func(request *services.Transaction) *services.TransactionResponse {
+		var signedTransaction services.SignedTransaction
+		require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTransaction))
+
+		var body services.TransactionBody
+		require.NoError(t, protobuf.Unmarshal(signedTransaction.BodyBytes, &body))
+		sentTransactionID = _TransactionIDFromProtobuf(body.TransactionID)
+
+		return &services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK}
+	}
+	responses := [][]interface{}{{status.New(codes.Internal, "node failed").Err(), call}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	// Internal errors aren't retried by default
+	client.SetRetryPolicy(_RegenerateOnErrorRetryPolicy{})
+
+	transaction, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-1)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(1)).
+		FreezeWith(client)
+	require.NoError(t, err)
+	firstTransactionID := transaction.GetTransactionID()
+
+	response, err := transaction.Execute(client)
+	require.NoError(t, err)
+
+	assert.NotEqual(t, firstTransactionID.String(), sentTransactionID.String())
+	assert.Equal(t, sentTransactionID.String(), response.TransactionID.String())
+}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -217,9 +218,39 @@ func (transaction *ScheduleCreateTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *ScheduleCreateTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *ScheduleCreateTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere to a transaction with a single node account ID.
+func (transaction *ScheduleCreateTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	transaction._AddSignature(publicKey, signature)
+	return transaction
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *ScheduleCreateTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *ScheduleCreateTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *ScheduleCreateTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -246,6 +277,7 @@ func (transaction *ScheduleCreateTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -305,6 +337,11 @@ func (transaction *ScheduleCreateTransaction) FreezeWith(client *Client) (*Sched
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *ScheduleCreateTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *ScheduleCreateTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -374,6 +411,12 @@ func (transaction *ScheduleCreateTransaction) SetMaxRetry(count int) *ScheduleCr
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *ScheduleCreateTransaction) SetRetryPolicy(policy RetryPolicy) *ScheduleCreateTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *ScheduleCreateTransaction) SetMaxBackoff(max time.Duration) *ScheduleCreateTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
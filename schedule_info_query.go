@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -85,7 +86,14 @@ func (query *ScheduleInfoQuery) _Build() *services.Query_ScheduleGetInfo {
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *ScheduleInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *ScheduleInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -113,6 +121,7 @@ func (query *ScheduleInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ScheduleInfoQueryShouldRetry,
@@ -140,8 +149,8 @@ func (query *ScheduleInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _ScheduleInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetScheduleGetInfo().Header.NodeTransactionPrecheckCode))
+func _ScheduleInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetScheduleGetInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _ScheduleInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -156,7 +165,14 @@ func _ScheduleInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *ScheduleInfoQuery) Execute(client *Client) (ScheduleInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *ScheduleInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (ScheduleInfo, error) {
 	if client == nil || client.operator == nil {
 		return ScheduleInfo{}, errNoClientProvided
 	}
@@ -182,7 +198,7 @@ func (query *ScheduleInfoQuery) Execute(client *Client) (ScheduleInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return ScheduleInfo{}, err
 		}
@@ -220,6 +236,7 @@ func (query *ScheduleInfoQuery) Execute(client *Client) (ScheduleInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_ScheduleInfoQueryShouldRetry,
@@ -269,6 +286,12 @@ func (query *ScheduleInfoQuery) SetMaxRetry(count int) *ScheduleInfoQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *ScheduleInfoQuery) SetRetryPolicy(policy RetryPolicy) *ScheduleInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *ScheduleInfoQuery) SetMaxBackoff(max time.Duration) *ScheduleInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -0,0 +1,317 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"bytes"
+
+	"github.com/hashgraph/hedera-protobufs-go/sdk"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/pkg/errors"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+// SignatureBundle carries the frozen body bytes of a transaction for every node it can be submitted to,
+// together with the signatures collected for them so far. It lets signers on separate, possibly air-gapped,
+// machines each sign a copy of the bundle and the copies be merged back before the transaction is executed.
+//
+// A bundle serializes to the same bytes as ToBytes on the transaction, so TransactionFromBytes accepts it.
+type SignatureBundle struct {
+	signedTransactions []*services.SignedTransaction
+}
+
+// NewSignatureBundle creates a bundle from a frozen transaction, keeping the signatures already on it.
+func NewSignatureBundle(transaction interface{}) (*SignatureBundle, error) {
+	tx, err := _TransactionAsSignable(transaction)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tx.IsFrozen() {
+		return nil, errTransactionIsNotFrozen
+	}
+
+	data, err := tx.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return SignatureBundleFromBytes(data)
+}
+
+// SignatureBundleFromBytes deserializes a bundle, or the bytes of a frozen transaction. Every signature is
+// verified against the body bytes it signs.
+func SignatureBundleFromBytes(data []byte) (*SignatureBundle, error) {
+	list := sdk.TransactionList{}
+	if err := protobuf.Unmarshal(data, &list); err != nil {
+		return nil, errors.Wrap(err, "error deserializing from bytes to SignatureBundle")
+	}
+
+	if len(list.TransactionList) == 0 {
+		return nil, errNoTransactionInBytes
+	}
+
+	comp, err := _TransactionCompare(&list)
+	if err != nil {
+		return nil, err
+	}
+
+	if !comp {
+		return nil, errors.New("failed to validate transaction bodies")
+	}
+
+	bundle := SignatureBundle{
+		signedTransactions: make([]*services.SignedTransaction, 0, len(list.TransactionList)),
+	}
+
+	for _, transaction := range list.TransactionList {
+		var signedTransaction services.SignedTransaction
+		if err := protobuf.Unmarshal(transaction.SignedTransactionBytes, &signedTransaction); err != nil {
+			return nil, errors.Wrap(err, "error deserializing SignedTransactionBytes in SignatureBundleFromBytes")
+		}
+
+		sigPairs := signedTransaction.GetSigMap().GetSigPair()
+		signedTransaction.SigMap = &services.SignatureMap{
+			SigPair: make([]*services.SignaturePair, 0, len(sigPairs)),
+		}
+
+		for _, sigPair := range sigPairs {
+			if err := _VerifySignaturePair(signedTransaction.BodyBytes, sigPair); err != nil {
+				return nil, err
+			}
+
+			if !_SigMapContainsKey(signedTransaction.SigMap, sigPair.PubKeyPrefix) {
+				signedTransaction.SigMap.SigPair = append(signedTransaction.SigMap.SigPair, sigPair)
+			}
+		}
+
+		bundle.signedTransactions = append(bundle.signedTransactions, &signedTransaction)
+	}
+
+	return &bundle, nil
+}
+
+// ToBytes serializes the bundle, in the same format as ToBytes on a transaction.
+func (bundle *SignatureBundle) ToBytes() ([]byte, error) {
+	list := sdk.TransactionList{
+		TransactionList: make([]*services.Transaction, 0, len(bundle.signedTransactions)),
+	}
+
+	for _, signedTransaction := range bundle.signedTransactions {
+		signedTransactionBytes, err := protobuf.Marshal(signedTransaction)
+		if err != nil {
+			return nil, errors.Wrap(err, "error serializing SignatureBundle")
+		}
+
+		list.TransactionList = append(list.TransactionList, &services.Transaction{
+			SignedTransactionBytes: signedTransactionBytes,
+		})
+	}
+
+	data, err := protobuf.Marshal(&list)
+	if err != nil {
+		return nil, errors.Wrap(err, "error serializing SignatureBundle")
+	}
+
+	return data, nil
+}
+
+// Sign signs the body bytes for every node with privateKey.
+func (bundle *SignatureBundle) Sign(privateKey PrivateKey) *SignatureBundle {
+	return bundle.SignWith(privateKey.PublicKey(), privateKey.Sign)
+}
+
+// SignWith signs the body bytes for every node with signer, skipping bodies publicKey already signed.
+func (bundle *SignatureBundle) SignWith(publicKey PublicKey, signer TransactionSigner) *SignatureBundle {
+	prefix := publicKey._ToSignaturePairProtobuf(nil).PubKeyPrefix
+
+	for _, signedTransaction := range bundle.signedTransactions {
+		if _SigMapContainsKey(signedTransaction.SigMap, prefix) {
+			continue
+		}
+
+		signedTransaction.SigMap.SigPair = append(
+			signedTransaction.SigMap.SigPair,
+			publicKey._ToSignaturePairProtobuf(signer(signedTransaction.BodyBytes)),
+		)
+	}
+
+	return bundle
+}
+
+// Merge adds the signatures collected in others to this bundle. Every bundle must carry the same body
+// bytes, i.e. come from the same frozen transaction.
+func (bundle *SignatureBundle) Merge(others ...*SignatureBundle) error {
+	for _, other := range others {
+		if len(other.signedTransactions) != len(bundle.signedTransactions) {
+			return errSignatureBundleMismatch
+		}
+
+		for i, signedTransaction := range other.signedTransactions {
+			if !bytes.Equal(signedTransaction.BodyBytes, bundle.signedTransactions[i].BodyBytes) {
+				return errSignatureBundleMismatch
+			}
+		}
+	}
+
+	for _, other := range others {
+		for i, signedTransaction := range other.signedTransactions {
+			sigMap := bundle.signedTransactions[i].SigMap
+
+			for _, sigPair := range signedTransaction.SigMap.GetSigPair() {
+				if !_SigMapContainsKey(sigMap, sigPair.PubKeyPrefix) {
+					sigMap.SigPair = append(sigMap.SigPair, sigPair)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// GetSignerPublicKeys returns the keys which signed the body bytes for every node.
+func (bundle *SignatureBundle) GetSignerPublicKeys() []PublicKey {
+	signers := make([]PublicKey, 0)
+	if len(bundle.signedTransactions) == 0 {
+		return signers
+	}
+
+	for _, sigPair := range bundle.signedTransactions[0].SigMap.GetSigPair() {
+		key, err := PublicKeyFromBytes(sigPair.PubKeyPrefix)
+		if err != nil {
+			continue
+		}
+
+		signedAll := true
+		for _, signedTransaction := range bundle.signedTransactions[1:] {
+			if !_SigMapContainsKey(signedTransaction.SigMap, sigPair.PubKeyPrefix) {
+				signedAll = false
+				break
+			}
+		}
+
+		if signedAll {
+			signers = append(signers, key)
+		}
+	}
+
+	return signers
+}
+
+// GetMissingKeys reports whether the signatures collected so far satisfy required and, if they don't, a
+// minimal set of keys which still need to sign. See EvaluateKey.
+func (bundle *SignatureBundle) GetMissingKeys(required Key) (missing []PublicKey, satisfied bool) {
+	status := bundle.EvaluateKey(required)
+	return status.MissingKeys, status.Satisfied
+}
+
+// EvaluateKey checks the signatures collected so far against key, as EvaluateKeySignatures does. A public key
+// only counts as signed if it has a valid signature on the body for every node.
+func (bundle *SignatureBundle) EvaluateKey(key Key) KeySignatureStatus {
+	signed := make(map[string]bool)
+	for i, signedTransaction := range bundle.signedTransactions {
+		valid := make(map[string]bool)
+		for _, sigPair := range signedTransaction.SigMap.GetSigPair() {
+			if sigPair.GetEd25519() == nil && sigPair.GetECDSASecp256K1() == nil {
+				continue
+			}
+
+			publicKey, err := PublicKeyFromBytes(sigPair.PubKeyPrefix)
+			if err != nil || _VerifySignaturePair(signedTransaction.BodyBytes, sigPair) != nil {
+				continue
+			}
+
+			if i == 0 || signed[publicKey.String()] {
+				valid[publicKey.String()] = true
+			}
+		}
+
+		signed = valid
+	}
+
+	return _EvaluateKey(key, signed)
+}
+
+// GetSignatures returns the signatures collected so far for each node, as GetSignatures on the transaction does.
+func (bundle *SignatureBundle) GetSignatures() (map[AccountID]map[*PublicKey][]byte, error) {
+	transaction, err := bundle.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.GetSignatures()
+}
+
+// GetTransactionHashPerNode returns the hash of the transaction submitted to each node, which doesn't change
+// as signatures are added.
+func (bundle *SignatureBundle) GetTransactionHashPerNode() (map[AccountID][]byte, error) {
+	transaction, err := bundle.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction.GetTransactionHashPerNode()
+}
+
+// ToTransaction returns the transaction carried by the bundle, with every signature collected so far,
+// ready to be executed.
+func (bundle *SignatureBundle) ToTransaction() (SignableTransaction, error) {
+	data, err := bundle.ToBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	return TransactionFromBytes(data)
+}
+
+func _SigMapContainsKey(sigMap *services.SignatureMap, prefix []byte) bool {
+	for _, sigPair := range sigMap.GetSigPair() {
+		if bytes.Equal(sigPair.PubKeyPrefix, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func _VerifySignaturePair(bodyBytes []byte, sigPair *services.SignaturePair) error {
+	var signature []byte
+	switch sig := sigPair.Signature.(type) {
+	case *services.SignaturePair_Ed25519:
+		signature = sig.Ed25519
+	case *services.SignaturePair_ECDSASecp256K1:
+		signature = sig.ECDSASecp256K1
+	default:
+		// Only keys this SDK can sign with are checked
+		return nil
+	}
+
+	key, err := PublicKeyFromBytes(sigPair.PubKeyPrefix)
+	if err != nil {
+		return err
+	}
+
+	if !key.Verify(bodyBytes, signature) {
+		return errors.Wrapf(errInvalidSignature, "signature of %s", key.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,186 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashgraph/hedera-protobufs-go/sdk"
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/require"
+	protobuf "google.golang.org/protobuf/proto"
+)
+
+func _NewMockBundleTransaction(t *testing.T, nodeAccountIDs []AccountID) *TransferTransaction {
+	transaction, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionID(TransactionIDGenerate(AccountID{Account: 2})).
+		SetNodeAccountIDs(nodeAccountIDs).
+		Freeze()
+	require.NoError(t, err)
+
+	return transaction
+}
+
+func TestUnitSignatureBundleOfflineSigning(t *testing.T) {
+	keyA, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+	keyB, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+	keyC, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	transaction := _NewMockBundleTransaction(t, []AccountID{{Account: 3}, {Account: 4}})
+
+	coordinator, err := NewSignatureBundle(transaction)
+	require.NoError(t, err)
+
+	unsigned, err := coordinator.ToBytes()
+	require.NoError(t, err)
+
+	// Each signer receives the unsigned bundle and sends back its own copy
+	signed := make([][]byte, 0)
+	for _, key := range []PrivateKey{keyA, keyB} {
+		bundle, err := SignatureBundleFromBytes(unsigned)
+		require.NoError(t, err)
+
+		data, err := bundle.Sign(key).ToBytes()
+		require.NoError(t, err)
+		signed = append(signed, data)
+	}
+
+	for _, data := range signed {
+		bundle, err := SignatureBundleFromBytes(data)
+		require.NoError(t, err)
+		require.NoError(t, coordinator.Merge(bundle))
+	}
+
+	require.Len(t, coordinator.GetSignerPublicKeys(), 2)
+
+	threshold := KeyListWithThreshold(2).
+		AddAllPublicKeys([]PublicKey{keyA.PublicKey(), keyB.PublicKey(), keyC.PublicKey()})
+	missing, satisfied := coordinator.GetMissingKeys(threshold)
+	require.True(t, satisfied)
+	require.Empty(t, missing)
+
+	all := NewKeyList().
+		AddAllPublicKeys([]PublicKey{keyA.PublicKey(), keyB.PublicKey(), keyC.PublicKey()})
+	missing, satisfied = coordinator.GetMissingKeys(all)
+	require.False(t, satisfied)
+	require.Equal(t, []PublicKey{keyC.PublicKey()}, missing)
+
+	signatures, err := coordinator.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures, 2)
+	for _, nodeSignatures := range signatures {
+		require.Len(t, nodeSignatures, 2)
+	}
+
+	hashes, err := coordinator.GetTransactionHashPerNode()
+	require.NoError(t, err)
+	require.Len(t, hashes, 2)
+	require.NotEqual(t, hashes[AccountID{Account: 3}], hashes[AccountID{Account: 4}])
+
+	final, err := coordinator.ToTransaction()
+	require.NoError(t, err)
+	require.IsType(t, &TransferTransaction{}, final)
+	require.Equal(t, transaction.GetTransactionID().String(), final.GetTransactionID().String())
+}
+
+func TestUnitSignatureBundleExecute(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	responses := [][]interface{}{{
+		&services.TransactionResponse{NodeTransactionPrecheckCode: services.ResponseCodeEnum_OK},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	bundle, err := NewSignatureBundle(_NewMockBundleTransaction(t, []AccountID{{Account: 3}}))
+	require.NoError(t, err)
+
+	var request *services.Transaction
+	client.AddInterceptor(func(ctx context.Context, req *NodeRequest, next NodeInvoker) NodeResponse {
+		request = req.Request.(*services.Transaction)
+		return next(ctx, req)
+	})
+
+	final, err := bundle.Sign(key).ToTransaction()
+	require.NoError(t, err)
+
+	_, err = final.Execute(client)
+	require.NoError(t, err)
+
+	var signedTransaction services.SignedTransaction
+	require.NoError(t, protobuf.Unmarshal(request.SignedTransactionBytes, &signedTransaction))
+	require.Len(t, signedTransaction.SigMap.SigPair, 1)
+	require.NoError(t, _VerifySignaturePair(signedTransaction.BodyBytes, signedTransaction.SigMap.SigPair[0]))
+}
+
+func TestUnitSignatureBundleMergeMismatch(t *testing.T) {
+	first, err := NewSignatureBundle(_NewMockBundleTransaction(t, []AccountID{{Account: 3}}))
+	require.NoError(t, err)
+
+	second, err := NewSignatureBundle(_NewMockBundleTransaction(t, []AccountID{{Account: 3}}))
+	require.NoError(t, err)
+
+	third, err := NewSignatureBundle(_NewMockBundleTransaction(t, []AccountID{{Account: 3}, {Account: 4}}))
+	require.NoError(t, err)
+
+	require.ErrorIs(t, first.Merge(second), errSignatureBundleMismatch)
+	require.ErrorIs(t, first.Merge(third), errSignatureBundleMismatch)
+}
+
+func TestUnitSignatureBundleRejectsInvalidSignature(t *testing.T) {
+	key, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	bundle, err := NewSignatureBundle(_NewMockBundleTransaction(t, []AccountID{{Account: 3}}))
+	require.NoError(t, err)
+
+	bundle.SignWith(key.PublicKey(), func(message []byte) []byte {
+		return key.Sign([]byte("something else"))
+	})
+
+	data, err := bundle.ToBytes()
+	require.NoError(t, err)
+
+	_, err = SignatureBundleFromBytes(data)
+	require.ErrorIs(t, err, errInvalidSignature)
+}
+
+func TestUnitSignatureBundleRequiresFrozenTransaction(t *testing.T) {
+	_, err := NewSignatureBundle(NewTransferTransaction())
+	require.ErrorIs(t, err, errTransactionIsNotFrozen)
+
+	data, err := protobuf.Marshal(&sdk.TransactionList{})
+	require.NoError(t, err)
+
+	_, err = SignatureBundleFromBytes(data)
+	require.ErrorIs(t, err, errNoTransactionInBytes)
+}
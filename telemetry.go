@@ -0,0 +1,129 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"time"
+)
+
+// Span and metric names emitted by the SDK
+const (
+	// SpanExecute covers a single call to `Execute`, from the first attempt to the final response
+	SpanExecute = "hedera.execute"
+	// SpanAttempt covers a single attempt against one node, and is a child of SpanExecute
+	SpanAttempt = "hedera.attempt"
+
+	// MetricRequestDuration is a histogram of how long `Execute` took, in milliseconds
+	MetricRequestDuration = "hedera.request.duration"
+	// MetricRequestRetries counts retried attempts, by node and retry reason
+	MetricRequestRetries = "hedera.request.retries"
+	// MetricNodeLatency is a histogram of the time spent waiting on a node, in milliseconds
+	MetricNodeLatency = "hedera.node.latency"
+	// MetricNodeBackoff is a histogram of a node's backoff after each call, in milliseconds
+	MetricNodeBackoff = "hedera.node.backoff"
+	// MetricNodeReadmitted counts nodes returned to the healthy set after backing off
+	MetricNodeReadmitted = "hedera.node.readmitted"
+)
+
+// Attribute keys used on spans and metrics
+const (
+	AttributeRequestID     = "hedera.request_id"
+	AttributeRequestType   = "hedera.request_type"
+	AttributeNodeAccountID = "hedera.node_account_id"
+	AttributeNode          = "hedera.node"
+	AttributeAttempt       = "hedera.attempt"
+	AttributeStatus        = "hedera.status"
+	AttributeGrpcCode      = "hedera.grpc_code"
+	AttributeRetryReason   = "hedera.retry_reason"
+	AttributeBackoff       = "hedera.backoff"
+)
+
+// Reasons reported with AttributeRetryReason
+const (
+	RetryReasonNodeUnhealthy      = "node_unhealthy"
+	RetryReasonChannelError       = "channel_error"
+	RetryReasonGrpcError          = "grpc_error"
+	RetryReasonStatus             = "status"
+	RetryReasonTransactionExpired = "transaction_expired"
+)
+
+// Attribute is a key/value pair attached to a span or a metric measurement.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Attr creates an Attribute.
+func Attr(key string, value interface{}) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Tracer starts spans. It mirrors the shape of an OpenTelemetry tracer so one can be adapted
+// with a few lines of code.
+type Tracer interface {
+	// Start begins a span as a child of any span carried by ctx, returning a context carrying
+	// the new span.
+	Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span)
+}
+
+// Span is a single timed operation started by a Tracer.
+type Span interface {
+	SetAttributes(attributes ...Attribute)
+	AddEvent(name string, attributes ...Attribute)
+	RecordError(err error)
+	End()
+}
+
+// Meter records the SDK's metrics.
+type Meter interface {
+	// AddCounter adds value to the counter with the given name
+	AddCounter(ctx context.Context, name string, value int64, attributes ...Attribute)
+	// RecordHistogram records a measurement into the histogram with the given name
+	RecordHistogram(ctx context.Context, name string, value float64, attributes ...Attribute)
+}
+
+type _NoopTracer struct{}
+
+func (_NoopTracer) Start(ctx context.Context, _ string, _ ...Attribute) (context.Context, Span) {
+	return ctx, _NoopSpan{}
+}
+
+type _NoopSpan struct{}
+
+func (_NoopSpan) SetAttributes(...Attribute)    {}
+func (_NoopSpan) AddEvent(string, ...Attribute) {}
+func (_NoopSpan) RecordError(error)             {}
+func (_NoopSpan) End()                          {}
+
+type _NoopMeter struct{}
+
+func (_NoopMeter) AddCounter(context.Context, string, int64, ...Attribute)        {}
+func (_NoopMeter) RecordHistogram(context.Context, string, float64, ...Attribute) {}
+
+func _DurationMilliseconds(duration time.Duration) float64 {
+	return float64(duration) / float64(time.Millisecond)
+}
+
+// _RecordNodeBackoff reports a node's current backoff after it was increased or decreased
+func _RecordNodeBackoff(ctx context.Context, meter Meter, node *_Node) {
+	meter.RecordHistogram(ctx, MetricNodeBackoff, _DurationMilliseconds(node.currentBackoff), Attr(AttributeNode, node._GetKey()))
+}
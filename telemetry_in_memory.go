@@ -0,0 +1,241 @@
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// SpanData is a span captured by an InMemoryExporter.
+type SpanData struct {
+	Name       string
+	SpanID     uint64
+	ParentID   uint64
+	StartTime  time.Time
+	EndTime    time.Time
+	Attributes map[string]interface{}
+	Events     []SpanEvent
+	Errors     []error
+}
+
+// SpanEvent is an event added to a span.
+type SpanEvent struct {
+	Name       string
+	Time       time.Time
+	Attributes map[string]interface{}
+}
+
+// MetricPoint is a single counter increment or histogram measurement captured by an InMemoryExporter.
+type MetricPoint struct {
+	Name       string
+	Value      float64
+	Attributes map[string]interface{}
+}
+
+// InMemoryExporter is a Tracer and Meter keeping everything it receives in memory, meant for tests.
+type InMemoryExporter struct {
+	mutex      sync.Mutex
+	nextSpanID uint64
+	spans      []SpanData
+	counters   []MetricPoint
+	histograms []MetricPoint
+}
+
+// NewInMemoryExporter creates an empty InMemoryExporter, usable with both Client.SetTracer and Client.SetMeter.
+func NewInMemoryExporter() *InMemoryExporter {
+	return &InMemoryExporter{}
+}
+
+type _InMemorySpanKey struct{}
+
+type _InMemorySpan struct {
+	exporter *InMemoryExporter
+	data     SpanData
+	ended    bool
+}
+
+func (exporter *InMemoryExporter) Start(ctx context.Context, name string, attributes ...Attribute) (context.Context, Span) {
+	exporter.mutex.Lock()
+	exporter.nextSpanID++
+	spanID := exporter.nextSpanID
+	exporter.mutex.Unlock()
+
+	span := &_InMemorySpan{
+		exporter: exporter,
+		data: SpanData{
+			Name:       name,
+			SpanID:     spanID,
+			StartTime:  time.Now(),
+			Attributes: _AttributesToMap(nil, attributes),
+		},
+	}
+
+	if parent, ok := ctx.Value(_InMemorySpanKey{}).(*_InMemorySpan); ok && parent.exporter == exporter {
+		span.data.ParentID = parent.data.SpanID
+	}
+
+	return context.WithValue(ctx, _InMemorySpanKey{}, span), span
+}
+
+func (span *_InMemorySpan) SetAttributes(attributes ...Attribute) {
+	span.exporter.mutex.Lock()
+	defer span.exporter.mutex.Unlock()
+
+	span.data.Attributes = _AttributesToMap(span.data.Attributes, attributes)
+}
+
+func (span *_InMemorySpan) AddEvent(name string, attributes ...Attribute) {
+	span.exporter.mutex.Lock()
+	defer span.exporter.mutex.Unlock()
+
+	span.data.Events = append(span.data.Events, SpanEvent{
+		Name:       name,
+		Time:       time.Now(),
+		Attributes: _AttributesToMap(nil, attributes),
+	})
+}
+
+func (span *_InMemorySpan) RecordError(err error) {
+	if err == nil {
+		return
+	}
+
+	span.exporter.mutex.Lock()
+	defer span.exporter.mutex.Unlock()
+
+	span.data.Errors = append(span.data.Errors, err)
+}
+
+func (span *_InMemorySpan) End() {
+	span.exporter.mutex.Lock()
+	defer span.exporter.mutex.Unlock()
+
+	if span.ended {
+		return
+	}
+
+	span.ended = true
+	span.data.EndTime = time.Now()
+	span.exporter.spans = append(span.exporter.spans, span.data)
+}
+
+func (exporter *InMemoryExporter) AddCounter(_ context.Context, name string, value int64, attributes ...Attribute) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	exporter.counters = append(exporter.counters, MetricPoint{
+		Name:       name,
+		Value:      float64(value),
+		Attributes: _AttributesToMap(nil, attributes),
+	})
+}
+
+func (exporter *InMemoryExporter) RecordHistogram(_ context.Context, name string, value float64, attributes ...Attribute) {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	exporter.histograms = append(exporter.histograms, MetricPoint{
+		Name:       name,
+		Value:      value,
+		Attributes: _AttributesToMap(nil, attributes),
+	})
+}
+
+// GetSpans returns every span which has ended, in the order they ended.
+func (exporter *InMemoryExporter) GetSpans() []SpanData {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	return append([]SpanData(nil), exporter.spans...)
+}
+
+// GetSpansByName returns the ended spans with the given name.
+func (exporter *InMemoryExporter) GetSpansByName(name string) []SpanData {
+	spans := make([]SpanData, 0)
+	for _, span := range exporter.GetSpans() {
+		if span.Name == name {
+			spans = append(spans, span)
+		}
+	}
+
+	return spans
+}
+
+// GetCounter returns every increment of the named counter.
+func (exporter *InMemoryExporter) GetCounter(name string) []MetricPoint {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	return _FilterMetricPoints(exporter.counters, name)
+}
+
+// GetCounterTotal returns the sum of every increment of the named counter.
+func (exporter *InMemoryExporter) GetCounterTotal(name string) int64 {
+	var total int64
+	for _, point := range exporter.GetCounter(name) {
+		total += int64(point.Value)
+	}
+
+	return total
+}
+
+// GetHistogram returns every measurement recorded into the named histogram.
+func (exporter *InMemoryExporter) GetHistogram(name string) []MetricPoint {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	return _FilterMetricPoints(exporter.histograms, name)
+}
+
+// Reset drops everything captured so far.
+func (exporter *InMemoryExporter) Reset() {
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+
+	exporter.spans = nil
+	exporter.counters = nil
+	exporter.histograms = nil
+}
+
+func _FilterMetricPoints(points []MetricPoint, name string) []MetricPoint {
+	filtered := make([]MetricPoint, 0)
+	for _, point := range points {
+		if point.Name == name {
+			filtered = append(filtered, point)
+		}
+	}
+
+	return filtered
+}
+
+func _AttributesToMap(existing map[string]interface{}, attributes []Attribute) map[string]interface{} {
+	if existing == nil {
+		existing = make(map[string]interface{}, len(attributes))
+	}
+
+	for _, attribute := range attributes {
+		existing[attribute.Key] = attribute.Value
+	}
+
+	return existing
+}
@@ -0,0 +1,176 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnitInMemoryExporterSpans(t *testing.T) {
+	exporter := NewInMemoryExporter()
+
+	ctx, parent := exporter.Start(context.Background(), "parent", Attr("key", "value"))
+	_, child := exporter.Start(ctx, "child")
+	child.AddEvent("event", Attr("count", 1))
+	child.RecordError(errors.New("failed"))
+	child.End()
+	child.End()
+	parent.SetAttributes(Attr("other", 2))
+	parent.End()
+
+	spans := exporter.GetSpans()
+	require.Equal(t, 2, len(spans))
+	assert.Equal(t, "child", spans[0].Name)
+	assert.Equal(t, spans[1].SpanID, spans[0].ParentID)
+	assert.Equal(t, 1, len(spans[0].Events))
+	assert.Equal(t, 1, len(spans[0].Errors))
+	assert.Equal(t, "parent", spans[1].Name)
+	assert.Equal(t, uint64(0), spans[1].ParentID)
+	assert.Equal(t, "value", spans[1].Attributes["key"])
+	assert.Equal(t, 2, spans[1].Attributes["other"])
+
+	exporter.Reset()
+	assert.Equal(t, 0, len(exporter.GetSpans()))
+}
+
+func TestUnitInMemoryExporterMetrics(t *testing.T) {
+	exporter := NewInMemoryExporter()
+
+	exporter.AddCounter(context.Background(), "counter", 2)
+	exporter.AddCounter(context.Background(), "counter", 3, Attr("key", "value"))
+	exporter.RecordHistogram(context.Background(), "histogram", 1.5)
+
+	assert.Equal(t, int64(5), exporter.GetCounterTotal("counter"))
+	assert.Equal(t, "value", exporter.GetCounter("counter")[1].Attributes["key"])
+	assert.Equal(t, []MetricPoint{{Name: "histogram", Value: 1.5, Attributes: map[string]interface{}{}}}, exporter.GetHistogram("histogram"))
+	assert.Equal(t, 0, len(exporter.GetHistogram("missing")))
+}
+
+func TestUnitTelemetryExecute(t *testing.T) {
+	busy := &services.Response{
+		Response: &services.Response_CryptogetAccountBalance{
+			CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+				Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_BUSY, ResponseType: services.ResponseType_ANSWER_ONLY},
+			},
+		},
+	}
+	responses := [][]interface{}{{
+		busy, _MockAccountBalanceResponse(2000),
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	exporter := NewInMemoryExporter()
+	client.SetTracer(exporter).SetMeter(exporter)
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.NoError(t, err)
+
+	executeSpans := exporter.GetSpansByName(SpanExecute)
+	require.Equal(t, 1, len(executeSpans))
+	assert.Empty(t, executeSpans[0].Errors)
+
+	attemptSpans := exporter.GetSpansByName(SpanAttempt)
+	require.Equal(t, 2, len(attemptSpans))
+	for _, span := range attemptSpans {
+		assert.Equal(t, executeSpans[0].SpanID, span.ParentID)
+		assert.Equal(t, "0.0.3", span.Attributes[AttributeNodeAccountID])
+		assert.Equal(t, RequestTypeCryptoGetAccountBalance.String(), span.Attributes[AttributeRequestType])
+	}
+	assert.Equal(t, StatusBusy.String(), attemptSpans[0].Attributes[AttributeStatus])
+	assert.Equal(t, RetryReasonStatus, attemptSpans[0].Attributes[AttributeRetryReason])
+	assert.NotEmpty(t, attemptSpans[0].Attributes[AttributeBackoff])
+	assert.Equal(t, StatusOk.String(), attemptSpans[1].Attributes[AttributeStatus])
+	assert.Nil(t, attemptSpans[1].Attributes[AttributeRetryReason])
+
+	assert.Equal(t, int64(1), exporter.GetCounterTotal(MetricRequestRetries))
+	assert.Equal(t, 2, len(exporter.GetHistogram(MetricNodeLatency)))
+	assert.Equal(t, 2, len(exporter.GetHistogram(MetricNodeBackoff)))
+	assert.Equal(t, 1, len(exporter.GetHistogram(MetricRequestDuration)))
+}
+
+func TestUnitTelemetryExecuteError(t *testing.T) {
+	responses := [][]interface{}{{
+		&services.Response{
+			Response: &services.Response_CryptogetAccountBalance{
+				CryptogetAccountBalance: &services.CryptoGetAccountBalanceResponse{
+					Header: &services.ResponseHeader{NodeTransactionPrecheckCode: services.ResponseCodeEnum_INVALID_ACCOUNT_ID, ResponseType: services.ResponseType_ANSWER_ONLY},
+				},
+			},
+		},
+	}}
+
+	client, server := NewMockClientAndServer(responses)
+	defer server.Close()
+
+	exporter := NewInMemoryExporter()
+	client.SetTracer(exporter)
+
+	_, err := NewAccountBalanceQuery().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetAccountID(AccountID{Account: 1800}).
+		Execute(client)
+	require.Error(t, err)
+
+	executeSpans := exporter.GetSpansByName(SpanExecute)
+	require.Equal(t, 1, len(executeSpans))
+	require.Equal(t, 1, len(executeSpans[0].Errors))
+	assert.Equal(t, err, executeSpans[0].Errors[0])
+
+	attemptSpans := exporter.GetSpansByName(SpanAttempt)
+	require.Equal(t, 1, len(attemptSpans))
+	assert.Equal(t, StatusInvalidAccountID.String(), attemptSpans[0].Attributes[AttributeStatus])
+	assert.Equal(t, 1, len(attemptSpans[0].Errors))
+}
+
+func TestUnitTelemetryNodeReadmitted(t *testing.T) {
+	node, err := _NewNode(AccountID{Account: 3}, "127.0.0.1:50211", 0)
+	require.NoError(t, err)
+
+	readmitTime := time.Now().Add(-time.Second)
+	node.readmitTime = &readmitTime
+
+	exporter := NewInMemoryExporter()
+	network := _NewManagedNetwork()
+	network._SetMeter(exporter)
+	network.nodes = []_IManagedNode{node}
+
+	network._ReadmitNodes()
+
+	points := exporter.GetCounter(MetricNodeReadmitted)
+	require.Equal(t, 1, len(points))
+	assert.Equal(t, "0.0.3", points[0].Attributes[AttributeNode])
+	assert.Equal(t, 1, len(network.healthyNodes))
+}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -33,7 +34,8 @@ type TokenInfoQuery struct {
 }
 
 // NewTopicInfoQuery creates a TopicInfoQuery query which can be used to construct and execute a
-//  Get Topic Info Query.
+//
+//	Get Topic Info Query.
 func NewTokenInfoQuery() *TokenInfoQuery {
 	header := services.QueryHeader{}
 	return &TokenInfoQuery{
@@ -87,7 +89,14 @@ func (query *TokenInfoQuery) _Build() *services.Query_TokenGetInfo {
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *TokenInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *TokenInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -115,6 +124,7 @@ func (query *TokenInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TokenInfoQueryShouldRetry,
@@ -142,8 +152,8 @@ func (query *TokenInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TokenInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetTokenGetInfo().Header.NodeTransactionPrecheckCode))
+func _TokenInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetTokenGetInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _TokenInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -160,6 +170,12 @@ func _TokenInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 
 // Execute executes the TopicInfoQuery using the provided client
 func (query *TokenInfoQuery) Execute(client *Client) (TokenInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *TokenInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (TokenInfo, error) {
 	if client == nil || client.operator == nil {
 		return TokenInfo{}, errNoClientProvided
 	}
@@ -185,7 +201,7 @@ func (query *TokenInfoQuery) Execute(client *Client) (TokenInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return TokenInfo{}, err
 		}
@@ -223,6 +239,7 @@ func (query *TokenInfoQuery) Execute(client *Client) (TokenInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TokenInfoQueryShouldRetry,
@@ -270,6 +287,12 @@ func (query *TokenInfoQuery) SetMaxRetry(count int) *TokenInfoQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *TokenInfoQuery) SetRetryPolicy(policy RetryPolicy) *TokenInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *TokenInfoQuery) SetMaxBackoff(max time.Duration) *TokenInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -142,7 +143,14 @@ func (query *TokenNftInfoQuery) _BuildByNft() *services.Query_TokenGetNftInfo {
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *TokenNftInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -171,6 +179,7 @@ func (query *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
 
 	var resp interface{}
 	resp, err = _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TokenNftInfoQueryShouldRetry,
@@ -197,8 +206,8 @@ func (query *TokenNftInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TokenNftInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetTokenGetNftInfo().Header.NodeTransactionPrecheckCode))
+func _TokenNftInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetTokenGetNftInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _TokenNftInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -213,7 +222,14 @@ func _TokenNftInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 	}
 }
 
+// Execute executes the Query with the provided client
 func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *TokenNftInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) ([]TokenNftInfo, error) {
 	if client == nil || client.operator == nil {
 		return []TokenNftInfo{}, errNoClientProvided
 	}
@@ -239,7 +255,7 @@ func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error)
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return []TokenNftInfo{}, err
 		}
@@ -279,6 +295,7 @@ func (query *TokenNftInfoQuery) Execute(client *Client) ([]TokenNftInfo, error)
 	var resp interface{}
 	tokenInfos := make([]TokenNftInfo, 0)
 	resp, err = _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TokenNftInfoQueryShouldRetry,
@@ -325,6 +342,12 @@ func (query *TokenNftInfoQuery) SetMaxRetry(count int) *TokenNftInfoQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *TokenNftInfoQuery) SetRetryPolicy(policy RetryPolicy) *TokenNftInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *TokenNftInfoQuery) SetMaxBackoff(max time.Duration) *TokenNftInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
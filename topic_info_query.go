@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -33,7 +34,8 @@ type TopicInfoQuery struct {
 }
 
 // NewTopicInfoQuery creates a TopicInfoQuery query which can be used to construct and execute a
-//  Get Topic Info Query.
+//
+//	Get Topic Info Query.
 func NewTopicInfoQuery() *TopicInfoQuery {
 	header := services.QueryHeader{}
 	return &TopicInfoQuery{
@@ -88,7 +90,14 @@ func (query *TopicInfoQuery) _Build() *services.Query_ConsensusGetTopicInfo {
 	}
 }
 
+// GetCost returns the cost of the Query
 func (query *TopicInfoQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Query, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *TopicInfoQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -116,6 +125,7 @@ func (query *TopicInfoQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TopicInfoQueryShouldRetry,
@@ -143,8 +153,8 @@ func (query *TopicInfoQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TopicInfoQueryShouldRetry(logID string, _ interface{}, response interface{}) _ExecutionState {
-	return _QueryShouldRetry(logID, Status(response.(*services.Response).GetConsensusGetTopicInfo().Header.NodeTransactionPrecheckCode))
+func _TopicInfoQueryShouldRetry(logger Logger, logID string, _ interface{}, response interface{}) _ExecutionState {
+	return _QueryShouldRetry(logger, logID, Status(response.(*services.Response).GetConsensusGetTopicInfo().Header.NodeTransactionPrecheckCode))
 }
 
 func _TopicInfoQueryMapStatusError(_ interface{}, response interface{}) error {
@@ -161,6 +171,12 @@ func _TopicInfoQueryGetMethod(_ interface{}, channel *_Channel) _Method {
 
 // Execute executes the TopicInfoQuery using the provided client
 func (query *TopicInfoQuery) Execute(client *Client) (TopicInfo, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Query with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *TopicInfoQuery) ExecuteWithContext(ctx context.Context, client *Client) (TopicInfo, error) {
 	if client == nil || client.operator == nil {
 		return TopicInfo{}, errNoClientProvided
 	}
@@ -186,7 +202,7 @@ func (query *TopicInfoQuery) Execute(client *Client) (TopicInfo, error) {
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return TopicInfo{}, err
 		}
@@ -224,6 +240,7 @@ func (query *TopicInfoQuery) Execute(client *Client) (TopicInfo, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TopicInfoQueryShouldRetry,
@@ -269,6 +286,12 @@ func (query *TopicInfoQuery) SetMaxRetry(count int) *TopicInfoQuery {
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *TopicInfoQuery) SetRetryPolicy(policy RetryPolicy) *TopicInfoQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *TopicInfoQuery) SetMaxBackoff(max time.Duration) *TopicInfoQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
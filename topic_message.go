@@ -20,56 +20,60 @@ package hedera
  *
  */
 
-// type TopicMessage struct {
-// 	ConsensusTimestamp time.Time
-// 	Contents           []byte
-// 	RunningHash        []byte
-// 	SequenceNumber     uint64
-// 	Chunks             []TopicMessageChunk
-// 	TransactionID      *TransactionID
-// }
+import (
+	"time"
+)
 
-// func _TopicMessageOfSingle(resp *mirror.ConsensusTopicResponse) TopicMessage {
-// 	return TopicMessage{
-// 		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
-// 		Contents:           resp.Message,
-// 		RunningHash:        resp.RunningHash,
-// 		SequenceNumber:     resp.SequenceNumber,
-// 		Chunks:             nil,
-// 		TransactionID:      nil,
-// 	}
-// }
+type TopicMessage struct {
+	ConsensusTimestamp time.Time
+	Contents           []byte
+	RunningHash        []byte
+	SequenceNumber     uint64
+	Chunks             []TopicMessageChunk
+	TransactionID      *TransactionID
+}
 
-// func _TopicMessageOfMany(message []*mirror.ConsensusTopicResponse) TopicMessage {
-// 	length := len(message)
-// 	size := uint64(0)
-// 	chunks := make([]TopicMessageChunk, length)
-// 	messages := make([][]byte, length)
-// 	var transactionID *TransactionID = nil
+func _TopicMessageOfSingle(resp *_MirrorConsensusTopicResponse) TopicMessage {
+	return TopicMessage{
+		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
+		Contents:           resp.Message,
+		RunningHash:        resp.RunningHash,
+		SequenceNumber:     resp.SequenceNumber,
+		Chunks:             nil,
+		TransactionID:      nil,
+	}
+}
 
-// 	for _, m := range message {
-// 		if transactionID == nil {
-// 			value := _TransactionIDFromProtobuf(m.ChunkInfo.InitialTransactionID)
-// 			transactionID = &value
-// 		}
+func _TopicMessageOfMany(message []*_MirrorConsensusTopicResponse) TopicMessage {
+	length := len(message)
+	size := uint64(0)
+	chunks := make([]TopicMessageChunk, length)
+	messages := make([][]byte, length)
+	var transactionID *TransactionID = nil
 
-// 		chunks[m.ChunkInfo.Number-1] = _NewTopicMessageChunk(m)
-// 		messages[m.ChunkInfo.Number-1] = m.Message
-// 		size += uint64(len(m.Message))
-// 	}
+	for _, m := range message {
+		if transactionID == nil {
+			value := _TransactionIDFromProtobuf(m.ChunkInfo.InitialTransactionID)
+			transactionID = &value
+		}
 
-// 	finalMessage := make([]byte, 0, size)
+		chunks[m.ChunkInfo.Number-1] = _NewTopicMessageChunk(m)
+		messages[m.ChunkInfo.Number-1] = m.Message
+		size += uint64(len(m.Message))
+	}
 
-// 	for _, m := range messages {
-// 		finalMessage = append(finalMessage, m...)
-// 	}
+	finalMessage := make([]byte, 0, size)
 
-// 	return TopicMessage{
-// 		ConsensusTimestamp: _TimeFromProtobuf(message[length-1].ConsensusTimestamp),
-// 		RunningHash:        message[length-1].RunningHash,
-// 		SequenceNumber:     message[length-1].SequenceNumber,
-// 		Contents:           finalMessage,
-// 		Chunks:             chunks,
-// 		TransactionID:      transactionID,
-// 	}
-// }
+	for _, m := range messages {
+		finalMessage = append(finalMessage, m...)
+	}
+
+	return TopicMessage{
+		ConsensusTimestamp: _TimeFromProtobuf(message[length-1].ConsensusTimestamp),
+		RunningHash:        message[length-1].RunningHash,
+		SequenceNumber:     message[length-1].SequenceNumber,
+		Contents:           finalMessage,
+		Chunks:             chunks,
+		TransactionID:      transactionID,
+	}
+}
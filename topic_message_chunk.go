@@ -31,11 +31,11 @@ type TopicMessageChunk struct {
 	SequenceNumber     uint64
 }
 
-// func _NewTopicMessageChunk(resp *mirror.ConsensusTopicResponse) TopicMessageChunk {
-// 	return TopicMessageChunk{
-// 		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
-// 		ContentSize:        uint64(len(resp.Message)),
-// 		RunningHash:        resp.RunningHash,
-// 		SequenceNumber:     resp.SequenceNumber,
-// 	}
-// }
+func _NewTopicMessageChunk(resp *_MirrorConsensusTopicResponse) TopicMessageChunk {
+	return TopicMessageChunk{
+		ConsensusTimestamp: _TimeFromProtobuf(resp.ConsensusTimestamp),
+		ContentSize:        uint64(len(resp.Message)),
+		RunningHash:        resp.RunningHash,
+		SequenceNumber:     resp.SequenceNumber,
+	}
+}
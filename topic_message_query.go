@@ -21,256 +21,307 @@ package hedera
  */
 
 import (
+	"context"
+	"io"
+	"math"
 	"regexp"
+	"sync"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var rstStream = regexp.MustCompile("(?i)\\brst[^0-9a-zA-Z]stream\\b") //nolint
 
-// type TopicMessageQuery struct {
-// 	errorHandler      func(stat status.Status)
-// 	completionHandler func()
-// 	retryHandler      func(err error) bool
-// 	attempt           uint64
-// 	maxAttempts       uint64
-// 	topicID           *TopicID
-// 	startTime         *time.Time
-// 	endTime           *time.Time
-// 	limit             uint64
-// }
-
-// func NewTopicMessageQuery() *TopicMessageQuery {
-// 	return &TopicMessageQuery{
-// 		maxAttempts:       maxAttempts,
-// 		errorHandler:      _DefaultErrorHandler,
-// 		retryHandler:      _DefaultRetryHandler,
-// 		completionHandler: _DefaultCompletionHandler,
-// 	}
-// }
-
-// func (query *TopicMessageQuery) SetTopicID(topicID TopicID) *TopicMessageQuery {
-// 	query.topicID = &topicID
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) GetTopicID() TopicID {
-// 	if query.topicID == nil {
-// 		return TopicID{}
-// 	}
-
-// 	return *query.topicID
-// }
-
-// func (query *TopicMessageQuery) SetStartTime(startTime time.Time) *TopicMessageQuery {
-// 	query.startTime = &startTime
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) GetStartTime() time.Time {
-// 	if query.startTime != nil {
-// 		return *query.startTime
-// 	}
-
-// 	return time.Time{}
-// }
-
-// func (query *TopicMessageQuery) SetEndTime(endTime time.Time) *TopicMessageQuery {
-// 	query.endTime = &endTime
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) GetEndTime() time.Time {
-// 	if query.endTime != nil {
-// 		return *query.endTime
-// 	}
-
-// 	return time.Time{}
-// }
-
-// func (query *TopicMessageQuery) SetLimit(limit uint64) *TopicMessageQuery {
-// 	query.limit = limit
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) GetLimit() uint64 {
-// 	return query.limit
-// }
-
-// func (query *TopicMessageQuery) SetMaxAttempts(maxAttempts uint64) *TopicMessageQuery {
-// 	query.maxAttempts = maxAttempts
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) GetMaxAttempts() uint64 {
-// 	return query.maxAttempts
-// }
-
-// func (query *TopicMessageQuery) SetErrorHandler(errorHandler func(stat status.Status)) *TopicMessageQuery {
-// 	query.errorHandler = errorHandler
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) SetCompletionHandler(completionHandler func()) *TopicMessageQuery {
-// 	query.completionHandler = completionHandler
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) SetRetryHandler(retryHandler func(err error) bool) *TopicMessageQuery {
-// 	query.retryHandler = retryHandler
-// 	return query
-// }
-
-// func (query *TopicMessageQuery) _ValidateNetworkOnIDs(client *Client) error {
-// 	if client == nil || !client.autoValidateChecksums {
-// 		return nil
-// 	}
-
-// 	if query.topicID != nil {
-// 		if err := query.topicID.ValidateChecksum(client); err != nil {
-// 			return err
-// 		}
-// 	}
-
-// 	return nil
-// }
-
-// func (query *TopicMessageQuery) _Build() *mirror.ConsensusTopicQuery {
-// 	body := &mirror.ConsensusTopicQuery{
-// 		Limit: query.limit,
-// 	}
-// 	if query.topicID != nil {
-// 		body.TopicID = query.topicID._ToProtobuf()
-// 	}
-
-// 	if query.startTime != nil {
-// 		body.ConsensusStartTime = _TimeToProtobuf(*query.startTime)
-// 	} else {
-// 		body.ConsensusStartTime = &services.Timestamp{}
-// 	}
-
-// 	if query.endTime != nil {
-// 		body.ConsensusEndTime = _TimeToProtobuf(*query.endTime)
-// 	}
-
-// 	return body
-// }
-
-// func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessage)) (SubscriptionHandle, error) {
-// 	handle := SubscriptionHandle{}
-
-// 	err := query._ValidateNetworkOnIDs(client)
-// 	if err != nil {
-// 		return SubscriptionHandle{}, err
-// 	}
-
-// 	pb := query._Build()
-
-// 	messages := make(map[string][]*mirror.ConsensusTopicResponse)
-
-// 	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetConsensusServiceClient()
-// 	if err != nil {
-// 		return handle, err
-// 	}
-
-// 	go func() {
-// 		var subClient mirror.ConsensusService_SubscribeTopicClient
-// 		var err error
-
-// 		for {
-// 			if err != nil {
-// 				handle.Unsubscribe()
-
-// 				if grpcErr, ok := status.FromError(err); ok { // nolint
-// 					if query.attempt < query.maxAttempts && query.retryHandler(err) {
-// 						subClient = nil
-
-// 						delay := math.Min(250.0*math.Pow(2.0, float64(query.attempt)), 8000)
-// 						time.Sleep(time.Duration(delay) * time.Millisecond)
-// 						query.attempt++
-// 					} else {
-// 						query.errorHandler(*grpcErr)
-// 						break
-// 					}
-// 				} else if err == io.EOF {
-// 					query.completionHandler()
-// 					break
-// 				} else {
-// 					panic(err)
-// 				}
-// 			}
-
-// 			if subClient == nil {
-// 				ctx, cancel := context.WithCancel(context.TODO())
-// 				handle.onUnsubscribe = cancel
-
-// 				subClient, err = (*channel).SubscribeTopic(ctx, pb)
-
-// 				if err != nil {
-// 					continue
-// 				}
-// 			}
-
-// 			var resp *mirror.ConsensusTopicResponse
-// 			resp, err = subClient.Recv()
-
-// 			if err != nil {
-// 				continue
-// 			}
-
-// 			if resp.ConsensusTimestamp != nil {
-// 				pb.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(resp.ConsensusTimestamp).Add(1 * time.Nanosecond))
-// 			}
-
-// 			if pb.Limit > 0 {
-// 				pb.Limit--
-// 			}
-
-// 			if resp.ChunkInfo == nil || resp.ChunkInfo.Total == 1 {
-// 				onNext(_TopicMessageOfSingle(resp))
-// 			} else {
-// 				txID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID).String()
-// 				message, ok := messages[txID]
-// 				if !ok {
-// 					message = make([]*mirror.ConsensusTopicResponse, 0, resp.ChunkInfo.Total)
-// 				}
-
-// 				message = append(message, resp)
-// 				messages[txID] = message
-
-// 				if int32(len(message)) == resp.ChunkInfo.Total {
-// 					delete(messages, txID)
-
-// 					onNext(_TopicMessageOfMany(message))
-// 				}
-// 			}
-// 		}
-// 	}()
-
-// 	return handle, nil
-// }
-
-// func _DefaultErrorHandler(stat status.Status) {
-// 	println("Failed to subscribe to topic with status", stat.Code().String())
-// }
-
-// func _DefaultCompletionHandler() {
-// 	println("Subscription to topic finished")
-// }
-
-// func _DefaultRetryHandler(err error) bool {
-// 	code := status.Code(err)
-
-// 	switch code {
-// 	case codes.NotFound, codes.ResourceExhausted, codes.Unavailable:
-// 		return true
-// 	case codes.Internal:
-// 		grpcErr, ok := status.FromError(err)
-
-// 		if !ok {
-// 			return false
-// 		}
-
-// 		return rstStream.FindIndex([]byte(grpcErr.Message())) != nil
-// 	default:
-// 		return false
-// 	}
-// }
+// TopicMessageQuery subscribes to the messages submitted to a topic through the mirror network.
+type TopicMessageQuery struct {
+	errorHandler      func(stat status.Status)
+	completionHandler func()
+	retryHandler      func(err error) bool
+	maxAttempts       uint64
+	topicID           *TopicID
+	startTime         *time.Time
+	endTime           *time.Time
+	limit             uint64
+}
+
+func NewTopicMessageQuery() *TopicMessageQuery {
+	return &TopicMessageQuery{
+		maxAttempts:  maxAttempts,
+		retryHandler: _DefaultRetryHandler,
+	}
+}
+
+func (query *TopicMessageQuery) SetTopicID(topicID TopicID) *TopicMessageQuery {
+	query.topicID = &topicID
+	return query
+}
+
+func (query *TopicMessageQuery) GetTopicID() TopicID {
+	if query.topicID == nil {
+		return TopicID{}
+	}
+
+	return *query.topicID
+}
+
+func (query *TopicMessageQuery) SetStartTime(startTime time.Time) *TopicMessageQuery {
+	query.startTime = &startTime
+	return query
+}
+
+func (query *TopicMessageQuery) GetStartTime() time.Time {
+	if query.startTime != nil {
+		return *query.startTime
+	}
+
+	return time.Time{}
+}
+
+func (query *TopicMessageQuery) SetEndTime(endTime time.Time) *TopicMessageQuery {
+	query.endTime = &endTime
+	return query
+}
+
+func (query *TopicMessageQuery) GetEndTime() time.Time {
+	if query.endTime != nil {
+		return *query.endTime
+	}
+
+	return time.Time{}
+}
+
+func (query *TopicMessageQuery) SetLimit(limit uint64) *TopicMessageQuery {
+	query.limit = limit
+	return query
+}
+
+func (query *TopicMessageQuery) GetLimit() uint64 {
+	return query.limit
+}
+
+func (query *TopicMessageQuery) SetMaxAttempts(maxAttempts uint64) *TopicMessageQuery {
+	query.maxAttempts = maxAttempts
+	return query
+}
+
+func (query *TopicMessageQuery) GetMaxAttempts() uint64 {
+	return query.maxAttempts
+}
+
+func (query *TopicMessageQuery) SetErrorHandler(errorHandler func(stat status.Status)) *TopicMessageQuery {
+	query.errorHandler = errorHandler
+	return query
+}
+
+func (query *TopicMessageQuery) SetCompletionHandler(completionHandler func()) *TopicMessageQuery {
+	query.completionHandler = completionHandler
+	return query
+}
+
+func (query *TopicMessageQuery) SetRetryHandler(retryHandler func(err error) bool) *TopicMessageQuery {
+	query.retryHandler = retryHandler
+	return query
+}
+
+func (query *TopicMessageQuery) _ValidateNetworkOnIDs(client *Client) error {
+	if client == nil || !client.autoValidateChecksums {
+		return nil
+	}
+
+	if query.topicID != nil {
+		if err := query.topicID.ValidateChecksum(client); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (query *TopicMessageQuery) _Build() *_MirrorConsensusTopicQuery {
+	body := &_MirrorConsensusTopicQuery{
+		Limit: query.limit,
+	}
+	if query.topicID != nil {
+		body.TopicID = query.topicID._ToProtobuf()
+	}
+
+	if query.startTime != nil {
+		body.ConsensusStartTime = _TimeToProtobuf(*query.startTime)
+	} else {
+		body.ConsensusStartTime = &services.Timestamp{}
+	}
+
+	if query.endTime != nil {
+		body.ConsensusEndTime = _TimeToProtobuf(*query.endTime)
+	}
+
+	return body
+}
+
+// Subscribe opens a stream to the mirror network and calls onNext for every message
+// received on the topic. Chunked messages are reassembled before onNext is called.
+// The subscription is re-established on transient gRPC errors until the query's max
+// attempts are exhausted; it ends when the returned handle is unsubscribed.
+func (query *TopicMessageQuery) Subscribe(client *Client, onNext func(TopicMessage)) (SubscriptionHandle, error) {
+	if client == nil || client.mirrorNetwork == nil || len(client.mirrorNetwork.nodes) == 0 {
+		return SubscriptionHandle{}, errNoMirrorNetwork
+	}
+
+	err := query._ValidateNetworkOnIDs(client)
+	if err != nil {
+		return SubscriptionHandle{}, err
+	}
+
+	channel, err := client.mirrorNetwork._GetNextMirrorNode()._GetConsensusServiceClient()
+	if err != nil {
+		return SubscriptionHandle{}, err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var once sync.Once
+	handle := SubscriptionHandle{
+		onUnsubscribe: func() {
+			once.Do(cancel)
+		},
+	}
+
+	pb := query._Build()
+
+	go query._Subscribe(ctx, client.GetLogger(), channel, pb, onNext)
+
+	return handle, nil
+}
+
+func (query *TopicMessageQuery) _Subscribe(
+	ctx context.Context,
+	logger Logger,
+	channel *_MirrorConsensusServiceClient,
+	pb *_MirrorConsensusTopicQuery,
+	onNext func(TopicMessage),
+) {
+	messages := make(map[string][]*_MirrorConsensusTopicResponse)
+
+	complete := func() {
+		if query.completionHandler != nil {
+			query.completionHandler()
+		} else {
+			logger.Info("subscription to topic finished")
+		}
+	}
+
+	// a non-zero limit is how many responses are left to receive, each subscription asking for those left
+	limited := pb.Limit > 0
+
+	// attempts are counted since the last response received, so a long subscription isn't ended by occasional errors
+	var attempt uint64
+	var subClient *_MirrorConsensusTopicStream
+	var err error
+
+	for {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			subClient = nil
+
+			if err == io.EOF {
+				complete()
+				return
+			}
+
+			grpcErr, ok := status.FromError(err)
+			if !ok {
+				grpcErr = status.New(codes.Unknown, err.Error())
+			}
+
+			if attempt >= query.maxAttempts || !query.retryHandler(err) {
+				if query.errorHandler != nil {
+					query.errorHandler(*grpcErr)
+				} else {
+					logger.Error("failed to subscribe to topic", "status", grpcErr.Code().String())
+				}
+				return
+			}
+
+			delay := math.Min(250.0*math.Pow(2.0, float64(attempt)), 8000)
+			attempt++
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(time.Duration(delay) * time.Millisecond):
+			}
+		}
+
+		if subClient == nil {
+			subClient, err = channel.SubscribeTopic(ctx, pb)
+			if err != nil {
+				continue
+			}
+		}
+
+		var resp *_MirrorConsensusTopicResponse
+		resp, err = subClient.Recv()
+		if err != nil {
+			continue
+		}
+
+		attempt = 0
+
+		if resp.ConsensusTimestamp != nil {
+			pb.ConsensusStartTime = _TimeToProtobuf(_TimeFromProtobuf(resp.ConsensusTimestamp).Add(1 * time.Nanosecond))
+		}
+
+		if pb.Limit > 0 {
+			pb.Limit--
+		}
+
+		if resp.ChunkInfo == nil || resp.ChunkInfo.Total <= 1 {
+			onNext(_TopicMessageOfSingle(resp))
+		} else {
+			txID := _TransactionIDFromProtobuf(resp.ChunkInfo.InitialTransactionID).String()
+			message, ok := messages[txID]
+			if !ok {
+				message = make([]*_MirrorConsensusTopicResponse, 0, resp.ChunkInfo.Total)
+			}
+
+			message = append(message, resp)
+			messages[txID] = message
+
+			if int32(len(message)) == resp.ChunkInfo.Total {
+				delete(messages, txID)
+
+				onNext(_TopicMessageOfMany(message))
+			}
+		}
+
+		// subscribing again with a limit of zero would ask for every message left
+		if limited && pb.Limit == 0 {
+			complete()
+			return
+		}
+	}
+}
+
+func _DefaultRetryHandler(err error) bool {
+	code := status.Code(err)
+
+	switch code {
+	case codes.NotFound, codes.ResourceExhausted, codes.Unavailable:
+		return true
+	case codes.Internal:
+		grpcErr, ok := status.FromError(err)
+
+		if !ok {
+			return false
+		}
+
+		return rstStream.FindIndex([]byte(grpcErr.Message())) != nil
+	default:
+		return false
+	}
+}
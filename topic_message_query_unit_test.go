@@ -0,0 +1,292 @@
+//go:build all || unit
+// +build all unit
+
+package hedera
+
+/*-
+ *
+ * Hedera Go SDK
+ *
+ * Copyright (C) 2020 - 2022 Hedera Hashgraph, LLC
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *      http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ */
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/hashgraph/hedera-protobufs-go/services"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestUnitTopicMessageQueryValidate(t *testing.T) {
+	client := ClientForTestnet()
+	client.SetAutoValidateChecksums(true)
+	topicID, err := TopicIDFromString("0.0.123-esxsf")
+	require.NoError(t, err)
+
+	query := NewTopicMessageQuery().
+		SetTopicID(topicID)
+
+	err = query._ValidateNetworkOnIDs(client)
+	require.NoError(t, err)
+}
+
+func TestUnitTopicMessageQueryValidateWrong(t *testing.T) {
+	client := ClientForTestnet()
+	client.SetAutoValidateChecksums(true)
+	topicID, err := TopicIDFromString("0.0.123-rmkykd")
+	require.NoError(t, err)
+
+	query := NewTopicMessageQuery().
+		SetTopicID(topicID)
+
+	err = query._ValidateNetworkOnIDs(client)
+	assert.Error(t, err)
+}
+
+func TestUnitTopicMessageQueryBuild(t *testing.T) {
+	start := time.Unix(100, 5)
+	end := time.Unix(200, 0)
+
+	pb := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetStartTime(start).
+		SetEndTime(end).
+		SetLimit(3).
+		_Build()
+
+	data, err := pb._Marshal()
+	require.NoError(t, err)
+
+	var decoded _MirrorConsensusTopicQuery
+	require.NoError(t, decoded._Unmarshal(data))
+
+	assert.Equal(t, int64(7), decoded.TopicID.TopicNum)
+	assert.Equal(t, start, _TimeFromProtobuf(decoded.ConsensusStartTime))
+	assert.Equal(t, end, _TimeFromProtobuf(decoded.ConsensusEndTime))
+	assert.Equal(t, uint64(3), decoded.Limit)
+}
+
+func TestUnitTopicMessageQueryNoMirrorNetwork(t *testing.T) {
+	client := ClientForNetwork(map[string]AccountID{})
+
+	_, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		Subscribe(client, func(TopicMessage) {})
+	assert.Equal(t, errNoMirrorNetwork, err)
+}
+
+func TestUnitTopicMessageQuerySubscribe(t *testing.T) {
+	initialTransactionID := TransactionIDGenerate(AccountID{Account: 1800})._ToProtobuf()
+
+	responses := []interface{}{
+		status.New(codes.Unavailable, "mirror node is starting").Err(),
+		&_MirrorConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(time.Unix(10, 0)),
+			Message:            []byte("single"),
+			SequenceNumber:     1,
+		},
+		&_MirrorConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(time.Unix(11, 0)),
+			Message:            []byte("hello "),
+			SequenceNumber:     2,
+			ChunkInfo: &services.ConsensusMessageChunkInfo{
+				InitialTransactionID: initialTransactionID,
+				Total:                2,
+				Number:               1,
+			},
+		},
+		&_MirrorConsensusTopicResponse{
+			ConsensusTimestamp: _TimeToProtobuf(time.Unix(12, 0)),
+			Message:            []byte("world"),
+			SequenceNumber:     3,
+			ChunkInfo: &services.ConsensusMessageChunkInfo{
+				InitialTransactionID: initialTransactionID,
+				Total:                2,
+				Number:               2,
+			},
+		},
+	}
+
+	address, closeServer := newMockMirrorConsensusServer(t, responses)
+	defer closeServer()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	client.SetMirrorNetwork([]string{address})
+	defer client.Close()
+
+	messages := make(chan TopicMessage, 2)
+	done := make(chan struct{})
+
+	handle, err := NewTopicMessageQuery().
+		SetTopicID(TopicID{Topic: 7}).
+		SetStartTime(time.Unix(0, 0)).
+		SetCompletionHandler(func() {
+			close(done)
+		}).
+		SetErrorHandler(func(stat status.Status) {
+			t.Errorf("unexpected error: %v", stat.Err())
+			close(done)
+		}).
+		Subscribe(client, func(message TopicMessage) {
+			messages <- message
+		})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	require.Len(t, messages, 2)
+
+	single := <-messages
+	assert.Equal(t, []byte("single"), single.Contents)
+	assert.Equal(t, uint64(1), single.SequenceNumber)
+	assert.Nil(t, single.Chunks)
+
+	chunked := <-messages
+	assert.Equal(t, []byte("hello world"), chunked.Contents)
+	assert.Equal(t, uint64(3), chunked.SequenceNumber)
+	assert.Len(t, chunked.Chunks, 2)
+	require.NotNil(t, chunked.TransactionID)
+	assert.Equal(t, _TransactionIDFromProtobuf(initialTransactionID).String(), chunked.TransactionID.String())
+}
+
+func _SubscribeTestTopic(t *testing.T, query *TopicMessageQuery, responses []interface{}) []TopicMessage {
+	address, closeServer := newMockMirrorConsensusServer(t, responses)
+	defer closeServer()
+
+	client := ClientForNetwork(map[string]AccountID{})
+	client.SetMirrorNetwork([]string{address})
+	defer client.Close()
+
+	messages := make(chan TopicMessage, len(responses))
+	done := make(chan struct{})
+
+	handle, err := query.
+		SetTopicID(TopicID{Topic: 7}).
+		SetCompletionHandler(func() {
+			close(done)
+		}).
+		SetErrorHandler(func(stat status.Status) {
+			t.Errorf("unexpected error: %v", stat.Err())
+			close(done)
+		}).
+		Subscribe(client, func(message TopicMessage) {
+			messages <- message
+		})
+	require.NoError(t, err)
+	defer handle.Unsubscribe()
+
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("subscription did not complete")
+	}
+
+	close(messages)
+	received := make([]TopicMessage, 0)
+	for message := range messages {
+		received = append(received, message)
+	}
+
+	return received
+}
+
+func TestUnitTopicMessageQueryAttemptsResetByMessages(t *testing.T) {
+	unavailable := status.New(codes.Unavailable, "mirror node is restarting").Err()
+
+	// every error is the first since the last message, so none uses up the only attempt
+	messages := _SubscribeTestTopic(t, NewTopicMessageQuery().SetMaxAttempts(1), []interface{}{
+		unavailable,
+		&_MirrorConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(time.Unix(10, 0)), SequenceNumber: 1},
+		unavailable,
+		&_MirrorConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(time.Unix(11, 0)), SequenceNumber: 2},
+	})
+
+	require.Len(t, messages, 2)
+	assert.Equal(t, uint64(1), messages[0].SequenceNumber)
+	assert.Equal(t, uint64(2), messages[1].SequenceNumber)
+}
+
+func TestUnitTopicMessageQueryLimitReachedAfterResubscribing(t *testing.T) {
+	messages := _SubscribeTestTopic(t, NewTopicMessageQuery().SetLimit(2), []interface{}{
+		&_MirrorConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(time.Unix(10, 0)), SequenceNumber: 1},
+		status.New(codes.Unavailable, "mirror node is restarting").Err(),
+		&_MirrorConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(time.Unix(11, 0)), SequenceNumber: 2},
+		&_MirrorConsensusTopicResponse{ConsensusTimestamp: _TimeToProtobuf(time.Unix(12, 0)), SequenceNumber: 3},
+	})
+
+	require.Len(t, messages, 2)
+	assert.Equal(t, uint64(2), messages[1].SequenceNumber)
+}
+
+// newMockMirrorConsensusServer serves `subscribeTopic` calls, one response per element of
+// responses; an error element ends the current stream with that error.
+func newMockMirrorConsensusServer(t *testing.T, responses []interface{}) (string, func()) {
+	index := 0
+	handler := func(_ interface{}, stream grpc.ServerStream) error {
+		var query _MirrorConsensusTopicQuery
+		if err := stream.RecvMsg(&query); err != nil {
+			return err
+		}
+
+		for index < len(responses) {
+			response := responses[index]
+			index++
+
+			if err, ok := response.(error); ok {
+				return err
+			}
+
+			if err := stream.SendMsg(response); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	server := grpc.NewServer(grpc.ForceServerCodec(_MirrorCodec{}))
+	server.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "com.hedera.mirror.api.proto.ConsensusService",
+		HandlerType: (*interface{})(nil),
+		Streams: []grpc.StreamDesc{
+			{
+				StreamName:    "subscribeTopic",
+				Handler:       handler,
+				ServerStreams: true,
+			},
+		},
+	}, nil)
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	go func() {
+		_ = server.Serve(listener)
+	}()
+
+	return listener.Addr().String(), server.Stop
+}
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -145,6 +146,26 @@ func (transaction *TopicMessageSubmitTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *TopicMessageSubmitTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *TopicMessageSubmitTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *TopicMessageSubmitTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *TopicMessageSubmitTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 func (transaction *TopicMessageSubmitTransaction) _ValidateNetworkOnIDs(client *Client) error {
 	if client == nil || !client.autoValidateChecksums {
 		return nil
@@ -215,8 +236,18 @@ func (transaction *TopicMessageSubmitTransaction) _ConstructScheduleProtobuf() (
 	}, nil
 }
 
+// Execute executes the Transaction with the provided client
 func (transaction *TopicMessageSubmitTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *TopicMessageSubmitTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -226,7 +257,7 @@ func (transaction *TopicMessageSubmitTransaction) Execute(
 		return TransactionResponse{}, transaction.freezeError
 	}
 
-	list, err := transaction.ExecuteAll(client)
+	list, err := transaction.ExecuteAllWithContext(ctx, client)
 
 	if err != nil {
 		return TransactionResponse{}, err
@@ -242,6 +273,15 @@ func (transaction *TopicMessageSubmitTransaction) Execute(
 // ExecuteAll executes the all the Transactions with the provided client
 func (transaction *TopicMessageSubmitTransaction) ExecuteAll(
 	client *Client,
+) ([]TransactionResponse, error) {
+	return transaction.ExecuteAllWithContext(context.Background(), client)
+}
+
+// ExecuteAllWithContext executes all the Transactions with the provided client,
+// stopping any in-flight request or backoff once ctx is done.
+func (transaction *TopicMessageSubmitTransaction) ExecuteAllWithContext(
+	ctx context.Context,
+	client *Client,
 ) ([]TransactionResponse, error) {
 	if !transaction.IsFrozen() {
 		_, err := transaction.FreezeWith(client)
@@ -268,6 +308,7 @@ func (transaction *TopicMessageSubmitTransaction) ExecuteAll(
 
 	for i := 0; i < size; i++ {
 		resp, err := _Execute(
+			ctx,
 			client,
 			&transaction.Transaction,
 			_TransactionShouldRetry,
@@ -378,6 +419,11 @@ func (transaction *TopicMessageSubmitTransaction) FreezeWith(client *Client) (*T
 	return transaction, nil
 }
 
+func (transaction *TopicMessageSubmitTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func _TopicMessageSubmitTransactionGetMethod(request interface{}, channel *_Channel) _Method {
 	return _Method{
 		transaction: channel._GetTopic().SubmitMessage,
@@ -485,6 +531,12 @@ func (transaction *TopicMessageSubmitTransaction) AddSignature(publicKey PublicK
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *TopicMessageSubmitTransaction) SetRetryPolicy(policy RetryPolicy) *TopicMessageSubmitTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *TopicMessageSubmitTransaction) SetMaxBackoff(max time.Duration) *TopicMessageSubmitTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
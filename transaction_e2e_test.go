@@ -65,7 +65,7 @@ func TestIntegrationTransactionAddSignature(t *testing.T) {
 	tx2, err := TransactionFromBytes(updateBytes)
 	require.NoError(t, err)
 
-	if newTx, ok := tx2.(AccountDeleteTransaction); ok {
+	if newTx, ok := tx2.(*AccountDeleteTransaction); ok {
 		resp, err = newTx.AddSignature(newKey.PublicKey(), sig1).Execute(env.Client)
 		require.NoError(t, err)
 	}
@@ -143,7 +143,7 @@ func TestIntegrationTransactionGetHash(t *testing.T) {
 }
 
 func DisabledTestTransactionFromBytes(t *testing.T) { // nolint
-	id := TransactionIDGenerate(AccountID{0, 0, 542348, nil, nil})
+	id := TransactionIDGenerate(AccountID{Account: 542348})
 
 	TransactionBody := services.TransactionBody{
 		TransactionID: &services.TransactionID{
@@ -261,20 +261,20 @@ func DisabledTestTransactionFromBytes(t *testing.T) { // nolint
 	env := NewIntegrationTestEnv(t)
 
 	switch tx := transaction.(type) {
-	case TransferTransaction:
-		assert.Equal(t, tx.GetHbarTransfers()[AccountID{0, 0, 542348, nil, nil}].AsTinybar(), int64(-10))
-		assert.Equal(t, tx.GetHbarTransfers()[AccountID{0, 0, 47439, nil, nil}].AsTinybar(), int64(10))
+	case *TransferTransaction:
+		assert.Equal(t, tx.GetHbarTransfers()[AccountID{Account: 542348}].AsTinybar(), int64(-10))
+		assert.Equal(t, tx.GetHbarTransfers()[AccountID{Account: 47439}].AsTinybar(), int64(10))
 
 		signatures, err := tx.GetSignatures()
 		require.NoError(t, err)
-		assert.Contains(t, signatures[AccountID{0, 0, 3, nil, nil}], &publicKey1)
-		assert.Contains(t, signatures[AccountID{0, 0, 3, nil, nil}], &publicKey2)
-		assert.Contains(t, signatures[AccountID{0, 0, 3, nil, nil}], &publicKey3)
-		assert.Contains(t, signatures[AccountID{0, 0, 3, nil, nil}], &publicKey4)
-		assert.Contains(t, signatures[AccountID{0, 0, 3, nil, nil}], &publicKey5)
+		assert.Contains(t, signatures[AccountID{Account: 3}], &publicKey1)
+		assert.Contains(t, signatures[AccountID{Account: 3}], &publicKey2)
+		assert.Contains(t, signatures[AccountID{Account: 3}], &publicKey3)
+		assert.Contains(t, signatures[AccountID{Account: 3}], &publicKey4)
+		assert.Contains(t, signatures[AccountID{Account: 3}], &publicKey5)
 
 		assert.Equal(t, len(tx.GetNodeAccountIDs()), 1)
-		assert.True(t, tx.GetNodeAccountIDs()[0]._Equals(AccountID{0, 0, 3, nil, nil}))
+		assert.True(t, tx.GetNodeAccountIDs()[0]._Equals(AccountID{Account: 3}))
 
 		resp, err := tx.Execute(env.Client)
 		require.NoError(t, err)
@@ -49,15 +49,20 @@ func _TransactionFeeScheduleFromProtobuf(txFeeSchedule *services.TransactionFeeS
 		feeData = append(feeData, &temp)
 	}
 
-	singleFeeData, err := _FeeDataFromProtobuf(txFeeSchedule.GetFeeData()) // nolint
-	if err != nil {
-		return TransactionFeeSchedule{}, err
+	// newer fee schedules only have Fees
+	var singleFeeData *FeeData
+	if txFeeSchedule.GetFeeData() != nil { // nolint
+		temp, err := _FeeDataFromProtobuf(txFeeSchedule.GetFeeData()) // nolint
+		if err != nil {
+			return TransactionFeeSchedule{}, err
+		}
+		singleFeeData = &temp
 	}
 
 	return TransactionFeeSchedule{
 		RequestType: RequestType(txFeeSchedule.GetHederaFunctionality()),
 		Fees:        feeData,
-		FeeData:     &singleFeeData,
+		FeeData:     singleFeeData,
 	}, nil
 }
 
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"math/rand"
 	"strconv"
@@ -61,9 +62,14 @@ func NewTransactionIDWithValidStart(accountID AccountID, validStart time.Time) T
 // receipt is exceptional an ErrHederaReceiptStatus will be returned alongside the receipt, otherwise only the receipt
 // will be returned.
 func (id TransactionID) GetReceipt(client *Client) (TransactionReceipt, error) {
+	return id.GetReceiptWithContext(context.Background(), client)
+}
+
+// GetReceiptWithContext is GetReceipt, stopping once ctx is done.
+func (id TransactionID) GetReceiptWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	return NewTransactionReceiptQuery().
 		SetTransactionID(id).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 }
 
 // GetRecord queries the _Network for a record corresponding to the TransactionID's transaction. If the status of the
@@ -71,9 +77,14 @@ func (id TransactionID) GetReceipt(client *Client) (TransactionReceipt, error) {
 // record will be returned. If consensus has not been reached, this function will return a HederaReceiptError with a
 // status of StatusBusy.
 func (id TransactionID) GetRecord(client *Client) (TransactionRecord, error) {
+	return id.GetRecordWithContext(context.Background(), client)
+}
+
+// GetRecordWithContext is GetRecord, stopping once ctx is done.
+func (id TransactionID) GetRecordWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
 	_, err := NewTransactionReceiptQuery().
 		SetTransactionID(id).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return TransactionRecord{}, err
@@ -81,7 +92,7 @@ func (id TransactionID) GetRecord(client *Client) (TransactionRecord, error) {
 
 	return NewTransactionRecordQuery().
 		SetTransactionID(id).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 }
 
 // String returns a string representation of the TransactionID in `AccountID@ValidStartSeconds.ValidStartNanos?scheduled_bool/nonce` format
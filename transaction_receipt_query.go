@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -107,7 +108,14 @@ func (query *TransactionReceiptQuery) _Build() *services.Query_TransactionGetRec
 	}
 }
 
+// GetCost returns the cost of the Transaction
 func (query *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Transaction, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *TransactionReceiptQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -137,6 +145,7 @@ func (query *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionReceiptQueryShouldRetry,
@@ -161,9 +170,9 @@ func (query *TransactionReceiptQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TransactionReceiptQueryShouldRetry(logID string, request interface{}, response interface{}) _ExecutionState {
+func _TransactionReceiptQueryShouldRetry(logger Logger, logID string, request interface{}, response interface{}) _ExecutionState {
 	status := Status(response.(*services.Response).GetTransactionGetReceipt().GetHeader().GetNodeTransactionPrecheckCode())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("receipt precheck status received")
+	logger.Trace("receipt precheck status received", "requestId", logID, "status", status.String())
 
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusBusy, StatusUnknown, StatusReceiptNotFound, StatusRecordNotFound:
@@ -175,7 +184,7 @@ func _TransactionReceiptQueryShouldRetry(logID string, request interface{}, resp
 	}
 
 	status = Status(response.(*services.Response).GetTransactionGetReceipt().GetReceipt().GetStatus())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("receipt status received")
+	logger.Trace("receipt status received", "requestId", logID, "status", status.String())
 
 	switch status {
 	case StatusBusy, StatusUnknown, StatusOk, StatusReceiptNotFound, StatusRecordNotFound:
@@ -239,6 +248,12 @@ func (query *TransactionReceiptQuery) SetMaxRetry(count int) *TransactionReceipt
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *TransactionReceiptQuery) SetRetryPolicy(policy RetryPolicy) *TransactionReceiptQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *TransactionReceiptQuery) SetMaxBackoff(max time.Duration) *TransactionReceiptQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -275,7 +290,14 @@ func (query *TransactionReceiptQuery) GetMinBackoff() time.Duration {
 	return 250 * time.Millisecond
 }
 
+// Execute executes the Transaction with the provided client
 func (query *TransactionReceiptQuery) Execute(client *Client) (TransactionReceipt, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *TransactionReceiptQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	if client == nil {
 		return TransactionReceipt{}, errNoClientProvided
 	}
@@ -298,6 +320,7 @@ func (query *TransactionReceiptQuery) Execute(client *Client) (TransactionReceip
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionReceiptQueryShouldRetry,
@@ -322,6 +345,8 @@ func (query *TransactionReceiptQuery) Execute(client *Client) (TransactionReceip
 		return TransactionReceipt{}, err
 	}
 
+	client._UpdateExchangeRatesFromReceipt(resp.(*services.Response).GetTransactionGetReceipt().GetReceipt())
+
 	return _TransactionReceiptFromProtobuf(resp.(*services.Response).GetTransactionGetReceipt()), nil
 }
 
@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -106,7 +107,14 @@ func (query *TransactionRecordQuery) _Build() *services.Query_TransactionGetReco
 	}
 }
 
+// GetCost returns the cost of the Transaction
 func (query *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
+	return query.GetCostWithContext(context.Background(), client)
+}
+
+// GetCostWithContext returns the cost of the Transaction, stopping any in-flight request
+// or backoff once ctx is done.
+func (query *TransactionRecordQuery) GetCostWithContext(ctx context.Context, client *Client) (Hbar, error) {
 	if client == nil || client.operator == nil {
 		return Hbar{}, errNoClientProvided
 	}
@@ -134,6 +142,7 @@ func (query *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionRecordQueryShouldRetry,
@@ -161,9 +170,9 @@ func (query *TransactionRecordQuery) GetCost(client *Client) (Hbar, error) {
 	return HbarFromTinybar(cost), nil
 }
 
-func _TransactionRecordQueryShouldRetry(logID string, request interface{}, response interface{}) _ExecutionState {
+func _TransactionRecordQueryShouldRetry(logger Logger, logID string, request interface{}, response interface{}) _ExecutionState {
 	status := Status(response.(*services.Response).GetTransactionGetRecord().GetHeader().GetNodeTransactionPrecheckCode())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("precheck status received")
+	logger.Trace("precheck status received", "requestId", logID, "status", status.String())
 
 	switch status {
 	case StatusPlatformTransactionNotCreated, StatusBusy, StatusUnknown, StatusReceiptNotFound, StatusRecordNotFound:
@@ -177,7 +186,7 @@ func _TransactionRecordQueryShouldRetry(logID string, request interface{}, respo
 	}
 
 	status = Status(response.(*services.Response).GetTransactionGetRecord().GetTransactionRecord().GetReceipt().GetStatus())
-	logCtx.Trace().Str("requestId", logID).Str("status", status.String()).Msg("record's receipt status received")
+	logger.Trace("record's receipt status received", "requestId", logID, "status", status.String())
 
 	switch status {
 	case StatusBusy, StatusUnknown, StatusOk, StatusReceiptNotFound, StatusRecordNotFound:
@@ -200,8 +209,15 @@ func _TransactionRecordQueryMapStatusError(request interface{}, response interfa
 		}
 	}
 
+	record := query.GetTransactionGetRecord().GetTransactionRecord()
+	result := record.GetContractCallResult()
+	if result == nil {
+		result = record.GetContractCreateResult()
+	}
+
 	return ErrHederaReceiptStatus{
-		Status: Status(query.GetTransactionGetRecord().GetTransactionRecord().GetReceipt().GetStatus()),
+		Status:       Status(record.GetReceipt().GetStatus()),
+		RevertReason: DecodeRevertReason(_RevertData(result.GetErrorMessage(), result.GetContractCallResult())),
 		// TxID:    _TransactionIDFromProtobuf(_Request.query.pb.GetTransactionGetRecord().TransactionID, networkName),
 		Receipt: _TransactionReceiptFromProtobuf(query.GetTransactionGetReceipt()),
 	}
@@ -246,6 +262,12 @@ func (query *TransactionRecordQuery) SetMaxRetry(count int) *TransactionRecordQu
 	return query
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this query, overriding the Client's.
+func (query *TransactionRecordQuery) SetRetryPolicy(policy RetryPolicy) *TransactionRecordQuery {
+	query.Query.SetRetryPolicy(policy)
+	return query
+}
+
 func (query *TransactionRecordQuery) SetMaxBackoff(max time.Duration) *TransactionRecordQuery {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
@@ -282,7 +304,14 @@ func (query *TransactionRecordQuery) GetMinBackoff() time.Duration {
 	return 250 * time.Millisecond
 }
 
+// Execute executes the Transaction with the provided client
 func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord, error) {
+	return query.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (query *TransactionRecordQuery) ExecuteWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
 	if client == nil || client.operator == nil {
 		return TransactionRecord{}, errNoClientProvided
 	}
@@ -308,7 +337,7 @@ func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord,
 			cost = query.maxQueryPayment
 		}
 
-		actualCost, err := query.GetCost(client)
+		actualCost, err := query.GetCostWithContext(ctx, client)
 		if err != nil {
 			return TransactionRecord{}, err
 		}
@@ -346,6 +375,7 @@ func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord,
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&query.Query,
 		_TransactionRecordQueryShouldRetry,
@@ -369,6 +399,8 @@ func (query *TransactionRecordQuery) Execute(client *Client) (TransactionRecord,
 		return TransactionRecord{}, err
 	}
 
+	client._UpdateExchangeRatesFromReceipt(resp.(*services.Response).GetTransactionGetRecord().GetTransactionRecord().GetReceipt())
+
 	return _TransactionRecordFromProtobuf(resp.(*services.Response).GetTransactionGetRecord()), nil
 }
 
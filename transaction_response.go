@@ -20,6 +20,10 @@ package hedera
  *
  */
 
+import (
+	"context"
+)
+
 type TransactionResponse struct {
 	TransactionID          TransactionID
 	ScheduledTransactionId TransactionID // nolint
@@ -28,10 +32,15 @@ type TransactionResponse struct {
 }
 
 func (response TransactionResponse) GetReceipt(client *Client) (TransactionReceipt, error) {
+	return response.GetReceiptWithContext(context.Background(), client)
+}
+
+// GetReceiptWithContext is GetReceipt, stopping once ctx is done.
+func (response TransactionResponse) GetReceiptWithContext(ctx context.Context, client *Client) (TransactionReceipt, error) {
 	receipt, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return receipt, err
@@ -49,10 +58,15 @@ func (response TransactionResponse) GetReceipt(client *Client) (TransactionRecei
 }
 
 func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord, error) {
+	return response.GetRecordWithContext(context.Background(), client)
+}
+
+// GetRecordWithContext is GetRecord, stopping once ctx is done.
+func (response TransactionResponse) GetRecordWithContext(ctx context.Context, client *Client) (TransactionRecord, error) {
 	_, err := NewTransactionReceiptQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 
 	if err != nil {
 		return TransactionRecord{}, err
@@ -61,5 +75,5 @@ func (response TransactionResponse) GetRecord(client *Client) (TransactionRecord
 	return NewTransactionRecordQuery().
 		SetTransactionID(response.TransactionID).
 		SetNodeAccountIDs([]AccountID{response.NodeID}).
-		Execute(client)
+		ExecuteWithContext(ctx, client)
 }
@@ -55,9 +55,9 @@ func TestUnitTransactionSerializationDeserialization(t *testing.T) {
 	deserializedTX, err := TransactionFromBytes(txBytes)
 	require.NoError(t, err)
 
-	var deserializedTXTyped TransferTransaction
+	var deserializedTXTyped *TransferTransaction
 	switch tx := deserializedTX.(type) {
-	case TransferTransaction:
+	case *TransferTransaction:
 		deserializedTXTyped = tx
 	default:
 		panic("Transaction was not TransferTransaction")
@@ -120,8 +120,8 @@ func TestUnitTransactionValidateBodiesEqual(t *testing.T) {
 
 	var deserializedTXTyped *AccountCreateTransaction
 	switch tx := deserializedTX.(type) {
-	case AccountCreateTransaction:
-		deserializedTXTyped = &tx
+	case *AccountCreateTransaction:
+		deserializedTXTyped = tx
 	default:
 		panic("Transaction was not AccountCreateTransaction")
 	}
@@ -266,7 +266,7 @@ func TestUnitTransactionToFromBytes(t *testing.T) {
 
 	newTransaction, err := TransactionFromBytes(txBytes)
 
-	_ = protobuf.Unmarshal(newTransaction.(TransferTransaction).signedTransactions._Get(0).(*services.SignedTransaction).BodyBytes, &tx)
+	_ = protobuf.Unmarshal(newTransaction.(*TransferTransaction).signedTransactions._Get(0).(*services.SignedTransaction).BodyBytes, &tx)
 	require.Equal(t, tx.TransactionID.String(), testTransactionID._ToProtobuf().String())
 	require.Equal(t, tx.NodeAccountID.String(), node[0]._ToProtobuf().String())
 	require.Equal(t, tx.Memo, "go sdk example multi_app_transfer/main.go")
@@ -288,6 +288,80 @@ func TestUnitTransactionToFromBytes(t *testing.T) {
 	})
 }
 
+func TestUnitTransactionToBytesBuildsEveryNode(t *testing.T) {
+	nodeAccountIDs := []AccountID{{Account: 3}, {Account: 4}}
+	transaction, err := NewTransferTransaction().
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs(nodeAccountIDs).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 4}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	txBytes, err := transaction.ToBytes()
+	require.NoError(t, err)
+
+	var list sdk.TransactionList
+	require.NoError(t, protobuf.Unmarshal(txBytes, &list))
+	require.Len(t, list.TransactionList, 2)
+
+	// each transaction of the list is the one built for its node, not the current one
+	for i, tx := range list.TransactionList {
+		var signedTransaction services.SignedTransaction
+		require.NoError(t, protobuf.Unmarshal(tx.SignedTransactionBytes, &signedTransaction))
+
+		var body services.TransactionBody
+		require.NoError(t, protobuf.Unmarshal(signedTransaction.BodyBytes, &body))
+		assert.Equal(t, nodeAccountIDs[i]._ToProtobuf().String(), body.NodeAccountID.String())
+	}
+}
+
+func TestUnitTransactionFromBytesKeepsEveryNode(t *testing.T) {
+	nodeAccountIDs := []AccountID{{Account: 3}, {Account: 4}, {Account: 5}}
+	transaction, err := NewTransferTransaction().
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs(nodeAccountIDs).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 4}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	txBytes, err := transaction.ToBytes()
+	require.NoError(t, err)
+
+	// every node shares the transaction ID, which mustn't stop the nodes after the first being added
+	newTransaction, err := TransactionFromBytes(txBytes)
+	require.NoError(t, err)
+	assert.Equal(t, nodeAccountIDs, newTransaction.GetNodeAccountIDs())
+	assert.Equal(t, testTransactionID.String(), newTransaction.GetTransactionID().String())
+}
+
+func TestUnitTransactionGetSignaturesECDSA(t *testing.T) {
+	key, err := PrivateKeyGenerateEcdsa()
+	require.NoError(t, err)
+
+	transaction, err := NewTransferTransaction().
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		AddHbarTransfer(AccountID{Account: 5}, NewHbar(-1)).
+		AddHbarTransfer(AccountID{Account: 4}, NewHbar(1)).
+		Freeze()
+	require.NoError(t, err)
+
+	_, err = transaction.Sign(key).ToBytes()
+	require.NoError(t, err)
+
+	signatures, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 3}], 1)
+
+	bodyBytes := transaction.signedTransactions._Get(0).(*services.SignedTransaction).BodyBytes
+	for publicKey, signature := range signatures[AccountID{Account: 3}] {
+		assert.Equal(t, key.PublicKey().String(), publicKey.String())
+		assert.True(t, publicKey.Verify(bodyBytes, signature))
+	}
+}
+
 func TestUnitTransactionToFromBytesWithClient(t *testing.T) {
 	operatorID := AccountID{Account: 5}
 	recepientID := AccountID{Account: 4}
@@ -331,7 +405,7 @@ func TestUnitTransactionToFromBytesWithClient(t *testing.T) {
 
 	newTransaction, err := TransactionFromBytes(txBytes)
 
-	_ = protobuf.Unmarshal(newTransaction.(TransferTransaction).signedTransactions._Get(0).(*services.SignedTransaction).BodyBytes, &tx)
+	_ = protobuf.Unmarshal(newTransaction.(*TransferTransaction).signedTransactions._Get(0).(*services.SignedTransaction).BodyBytes, &tx)
 	require.NotNil(t, tx.TransactionID, tx.NodeAccountID)
 	require.Equal(t, tx.TransactionID.String(), initialTxID.String())
 	require.Equal(t, tx.NodeAccountID.String(), initialNode.String())
@@ -397,3 +471,237 @@ func TestUnitQueryRegression(t *testing.T) {
 		},
 	})
 }
+
+func TestUnitTransactionSignableTransactionImplemented(t *testing.T) {
+	transactions := []SignableTransaction{
+		NewAccountAllowanceAdjustTransaction(),
+		NewAccountAllowanceApproveTransaction(),
+		NewAccountAllowanceDeleteTransaction(),
+		NewAccountCreateTransaction(),
+		NewAccountDeleteTransaction(),
+		NewAccountUpdateTransaction(),
+		NewContractCreateTransaction(),
+		NewContractDeleteTransaction(),
+		NewContractExecuteTransaction(),
+		NewEthereumTransaction(),
+		NewContractUpdateTransaction(),
+		NewFileAppendTransaction(),
+		NewFileCreateTransaction(),
+		NewFileDeleteTransaction(),
+		NewFileUpdateTransaction(),
+		NewFreezeTransaction(),
+		NewLiveHashAddTransaction(),
+		NewLiveHashDeleteTransaction(),
+		NewScheduleCreateTransaction(),
+		NewScheduleDeleteTransaction(),
+		NewScheduleSignTransaction(),
+		NewSystemDeleteTransaction(),
+		NewSystemUndeleteTransaction(),
+		NewTokenAssociateTransaction(),
+		NewTokenBurnTransaction(),
+		NewTokenCreateTransaction(),
+		NewTokenDeleteTransaction(),
+		NewTokenDissociateTransaction(),
+		NewTokenFeeScheduleUpdateTransaction(),
+		NewTokenFreezeTransaction(),
+		NewTokenGrantKycTransaction(),
+		NewTokenMintTransaction(),
+		NewTokenPauseTransaction(),
+		NewTokenRevokeKycTransaction(),
+		NewTokenUnfreezeTransaction(),
+		NewTokenUnpauseTransaction(),
+		NewTokenUpdateTransaction(),
+		NewTokenWipeTransaction(),
+		NewTopicCreateTransaction(),
+		NewTopicDeleteTransaction(),
+		NewTopicMessageSubmitTransaction(),
+		NewTopicUpdateTransaction(),
+		NewTransferTransaction(),
+	}
+
+	for _, transaction := range transactions {
+		require.False(t, transaction.IsFrozen(), "%T", transaction)
+
+		_, err := TransactionSetTransactionMemo(transaction, "memo")
+		require.NoError(t, err)
+		require.Equal(t, "memo", transaction.GetTransactionMemo(), "%T", transaction)
+	}
+}
+
+func TestUnitTransactionFromBytesSignable(t *testing.T) {
+	key, err := PrivateKeyFromString(mockPrivateKey)
+	require.NoError(t, err)
+
+	transaction, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 4}}).
+		Freeze()
+	require.NoError(t, err)
+
+	txBytes, err := transaction.ToBytes()
+	require.NoError(t, err)
+
+	deserialized, err := TransactionFromBytes(txBytes)
+	require.NoError(t, err)
+	require.IsType(t, &TransferTransaction{}, deserialized)
+	require.True(t, deserialized.IsFrozen())
+	require.Equal(t, testTransactionID.String(), deserialized.GetTransactionID().String())
+
+	signed, err := TransactionSign(deserialized, key)
+	require.NoError(t, err)
+	require.Same(t, deserialized, signed)
+
+	_, err = signed.ToBytes()
+	require.NoError(t, err)
+
+	signatures, err := signed.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 4}], 1)
+
+	other, err := PrivateKeyGenerateEd25519()
+	require.NoError(t, err)
+
+	signature, err := other.SignTransaction(&deserialized.(*TransferTransaction).Transaction)
+	require.NoError(t, err)
+
+	_, err = TransactionAddSignature(signed, other.PublicKey(), signature)
+	require.NoError(t, err)
+
+	signatures, err = signed.GetSignatures()
+	require.NoError(t, err)
+	require.Len(t, signatures[AccountID{Account: 4}], 2)
+}
+
+func TestUnitTransactionFreezeWithSignable(t *testing.T) {
+	var transaction interface{} = NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 4}})
+
+	frozen, err := TransactionFreezeWith(transaction, nil)
+	require.NoError(t, err)
+	require.True(t, frozen.IsFrozen())
+
+	_, err = TransactionSetTransactionMemo(frozen, "memo")
+	require.NoError(t, err)
+	require.ErrorIs(t, frozen._GetTransaction().freezeError, errTransactionIsFrozen)
+
+	_, err = TransactionFreezeWith(NewTransferTransaction(), nil)
+	require.Error(t, err)
+}
+
+func TestUnitTransactionSignableByValue(t *testing.T) {
+	transaction := NewTransferTransaction().
+		SetTransactionID(testTransactionID)
+
+	transactionID, err := TransactionGetTransactionID(*transaction)
+	require.NoError(t, err)
+	require.Equal(t, testTransactionID.String(), transactionID.String())
+
+	_, err = TransactionGetTransactionID("not a transaction")
+	require.ErrorIs(t, err, errNotSignableTransaction)
+
+	_, err = TransactionExecute(Transaction{}, nil)
+	require.ErrorIs(t, err, errNotSignableTransaction)
+}
+
+func TestUnitTransactionSignersCalledOncePerBody(t *testing.T) {
+	transaction, err := NewTransferTransaction().
+		AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+		AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)).
+		SetTransactionID(testTransactionID).
+		SetNodeAccountIDs([]AccountID{{Account: 3}, {Account: 4}}).
+		Freeze()
+	require.NoError(t, err)
+
+	calls := make([]int, 2)
+	keys := make([]PrivateKey, 2)
+	signers := make([]TransactionSigner, 2)
+	for i := range keys {
+		i := i
+		keys[i], err = PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+		signers[i] = func(message []byte) []byte {
+			calls[i]++
+			return keys[i].Sign(message)
+		}
+	}
+
+	transaction.SignWith(keys[0].PublicKey(), signers[0])
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	_, err = transaction.GetTransactionHash()
+	require.NoError(t, err)
+	// once per node
+	require.Equal(t, []int{2, 0}, calls)
+
+	// a key added after the transaction was built signs it without the earlier signers being called again
+	transaction.SignWith(keys[1].PublicKey(), signers[1])
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, []int{2, 2}, calls)
+
+	signatures, err := transaction.GetSignatures()
+	require.NoError(t, err)
+	for _, nodeAccountID := range []AccountID{{Account: 3}, {Account: 4}} {
+		require.Len(t, signatures[nodeAccountID], 2)
+	}
+
+	// a changed body is signed again, as the old signatures no longer verify
+	transaction.transactionIDs.slice[0] = TransactionIDGenerate(AccountID{Account: 2})
+	transaction.transactions = _NewLockableSlice()
+	_, err = transaction.ToBytes()
+	require.NoError(t, err)
+	require.Equal(t, []int{4, 4}, calls)
+
+	signedTransaction := transaction.signedTransactions._Get(0).(*services.SignedTransaction)
+	require.Len(t, signedTransaction.SigMap.SigPair, 2)
+	for _, sigPair := range signedTransaction.SigMap.SigPair {
+		require.NoError(t, _VerifySignaturePair(signedTransaction.BodyBytes, sigPair))
+	}
+}
+
+func TestUnitSignableTransactionMethods(t *testing.T) {
+	keys := make([]PrivateKey, 3)
+	for i := range keys {
+		var err error
+		keys[i], err = PrivateKeyGenerateEd25519()
+		require.NoError(t, err)
+	}
+
+	for _, transaction := range []SignableTransaction{
+		NewTransferTransaction().
+			AddHbarTransfer(AccountID{Account: 2}, HbarFromTinybar(-100)).
+			AddHbarTransfer(AccountID{Account: 3}, HbarFromTinybar(100)),
+		NewScheduleSignTransaction().SetScheduleID(ScheduleID{Schedule: 5}),
+	} {
+		transaction._GetTransaction().SetTransactionID(testTransactionID)
+		transaction._GetTransaction().SetNodeAccountIDs([]AccountID{{Account: 4}})
+
+		frozen, err := transaction.FreezeTransactionWith(nil)
+		require.NoError(t, err)
+		require.Same(t, transaction, frozen)
+		require.True(t, frozen.IsFrozen())
+
+		signed := frozen.
+			SignTransaction(keys[0]).
+			SignTransactionWith(keys[1].PublicKey(), keys[1].Sign)
+		require.Same(t, transaction, signed)
+
+		_, err = signed.ToBytes()
+		require.NoError(t, err)
+
+		signed = signed.AddTransactionSignature(keys[2].PublicKey(), keys[2].Sign(signed.GetTransactionBodyBytes()))
+		require.Same(t, transaction, signed)
+
+		status, err := EvaluateTransactionSignatures(KeyListWithThreshold(3).
+			AddAllPublicKeys([]PublicKey{keys[0].PublicKey(), keys[1].PublicKey(), keys[2].PublicKey()}), signed)
+		require.NoError(t, err)
+		require.True(t, status.Satisfied, "%T", transaction)
+	}
+}
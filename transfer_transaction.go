@@ -21,6 +21,7 @@ package hedera
  */
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"time"
@@ -420,6 +421,9 @@ func (transaction *TransferTransaction) _ValidateNetworkOnIDs(client *Client) er
 	for token, tokenTransfer := range transaction.tokenTransfers {
 		err = token.ValidateChecksum(client)
 		for _, transfer := range tokenTransfer.Transfers {
+			if transfer.accountID._HasAlias() {
+				continue
+			}
 			err = transfer.accountID.ValidateChecksum(client)
 			if err != nil {
 				return err
@@ -446,6 +450,10 @@ func (transaction *TransferTransaction) _ValidateNetworkOnIDs(client *Client) er
 		}
 	}
 	for _, hbarTransfer := range transaction.hbarTransfers {
+		// transfers to an alias, such as an EVM address, have no checksum to validate
+		if hbarTransfer.accountID._HasAlias() {
+			continue
+		}
 		err = hbarTransfer.accountID.ValidateChecksum(client)
 		if err != nil {
 			return err
@@ -644,9 +652,38 @@ func (transaction *TransferTransaction) SignWith(
 	return transaction
 }
 
+// SignTransaction signs the transaction with privateKey, as Sign does.
+func (transaction *TransferTransaction) SignTransaction(privateKey PrivateKey) SignableTransaction {
+	return transaction.Sign(privateKey)
+}
+
+// SignTransactionWith signs the transaction with signer, as SignWith does.
+func (transaction *TransferTransaction) SignTransactionWith(publicKey PublicKey, signer TransactionSigner) SignableTransaction {
+	return transaction.SignWith(publicKey, signer)
+}
+
+// AddTransactionSignature adds a signature produced elsewhere, as AddSignature does.
+func (transaction *TransferTransaction) AddTransactionSignature(publicKey PublicKey, signature []byte) SignableTransaction {
+	return transaction.AddSignature(publicKey, signature)
+}
+
+// FreezeTransactionWith freezes the transaction, as FreezeWith does.
+func (transaction *TransferTransaction) FreezeTransactionWith(client *Client) (SignableTransaction, error) {
+	return transaction.FreezeWith(client)
+}
+
 // Execute executes the Transaction with the provided client
 func (transaction *TransferTransaction) Execute(
 	client *Client,
+) (TransactionResponse, error) {
+	return transaction.ExecuteWithContext(context.Background(), client)
+}
+
+// ExecuteWithContext executes the Transaction with the provided client, stopping any
+// in-flight request or backoff once ctx is done.
+func (transaction *TransferTransaction) ExecuteWithContext(
+	ctx context.Context,
+	client *Client,
 ) (TransactionResponse, error) {
 	if client == nil {
 		return TransactionResponse{}, errNoClientProvided
@@ -673,6 +710,7 @@ func (transaction *TransferTransaction) Execute(
 	}
 
 	resp, err := _Execute(
+		ctx,
 		client,
 		&transaction.Transaction,
 		_TransactionShouldRetry,
@@ -827,6 +865,11 @@ func (transaction *TransferTransaction) FreezeWith(client *Client) (*TransferTra
 	return transaction, _TransactionFreezeWith(&transaction.Transaction, client, body)
 }
 
+func (transaction *TransferTransaction) _FreezeWith(client *Client) error {
+	_, err := transaction.FreezeWith(client)
+	return err
+}
+
 func (transaction *TransferTransaction) GetMaxTransactionFee() Hbar {
 	return transaction.Transaction.GetMaxTransactionFee()
 }
@@ -896,6 +939,12 @@ func (transaction *TransferTransaction) SetMaxRetry(count int) *TransferTransact
 	return transaction
 }
 
+// SetRetryPolicy sets the RetryPolicy used for this transaction, overriding the Client's.
+func (transaction *TransferTransaction) SetRetryPolicy(policy RetryPolicy) *TransferTransaction {
+	transaction.Transaction.SetRetryPolicy(policy)
+	return transaction
+}
+
 func (transaction *TransferTransaction) SetMaxBackoff(max time.Duration) *TransferTransaction {
 	if max.Nanoseconds() < 0 {
 		panic("maxBackoff must be a positive duration")
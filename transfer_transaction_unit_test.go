@@ -69,6 +69,40 @@ func TestUnitTransferTransactionValidateWrong(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestUnitTransferTransactionEvmAddress(t *testing.T) {
+	client := ClientForTestnet()
+	client.SetAutoValidateChecksums(true)
+	sender, err := AccountIDFromString("0.0.123-esxsf")
+	require.NoError(t, err)
+	receiver, err := AccountIDFromString("0xf39fd6e51aad88f6f4ce6ab8827279cfffb92266")
+	require.NoError(t, err)
+
+	transfer, err := NewTransferTransaction().
+		SetNodeAccountIDs([]AccountID{{Account: 3}}).
+		SetTransactionID(testTransactionID).
+		AddHbarTransfer(sender, HbarFromTinybar(-1)).
+		AddHbarTransfer(receiver, HbarFromTinybar(1)).
+		Freeze()
+	require.NoError(t, err)
+	require.NoError(t, transfer._ValidateNetworkOnIDs(client))
+
+	transactionBytes, err := transfer.ToBytes()
+	require.NoError(t, err)
+
+	transaction, err := TransactionFromBytes(transactionBytes)
+	require.NoError(t, err)
+
+	received := false
+	for accountID, amount := range transaction.(*TransferTransaction).GetHbarTransfers() {
+		if accountID.AliasEvmAddress != nil {
+			received = true
+			require.Equal(t, receiver.String(), accountID.String())
+			require.Equal(t, HbarFromTinybar(1), amount)
+		}
+	}
+	require.True(t, received)
+}
+
 func TestUnitTransferTransactionOrdered(t *testing.T) {
 	tokenID1, err := TokenIDFromString("1.1.1")
 	require.NoError(t, err)
@@ -125,7 +159,7 @@ func TestUnitTransferTransactionOrdered(t *testing.T) {
 	require.NoError(t, err)
 
 	switch tx := transferTransactionFromBytes.(type) {
-	case TransferTransaction:
+	case *TransferTransaction:
 		require.Equal(t, tx.nftTransfers[tokenID1], transferTransaction.nftTransfers[tokenID1])
 	}
 